@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/gardener/gardener-extensions/controllers/extension-certificate-service/pkg/controller/certservice"
+	"github.com/gardener/gardener-extensions/controllers/extension-certificate-service/pkg/controller/healthcheck"
 	"github.com/gardener/gardener-extensions/controllers/extension-certificate-service/pkg/controller/lifecycle"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	controllercmd "github.com/gardener/gardener-extensions/pkg/controller/cmd"
@@ -70,6 +71,8 @@ func (o *Options) run(ctx context.Context) {
 	ctrlConfig.Apply(&lifecycle.ServiceConfig)
 	ctrlConfig.Apply(&certservice.ServiceConfig)
 	o.controllerOptions.Completed().Apply(&certservice.ControllerOptions)
+	o.controllerOptions.Completed().Apply(&healthcheck.ControllerOptions)
+	o.healthCheckOptions.Completed().Apply(&healthcheck.SyncPeriod)
 
 	if err := o.controllerSwitches.Completed().AddToManager(mgr); err != nil {
 		controllercmd.LogErrAndExit(err, "Could not add controllers to manager")
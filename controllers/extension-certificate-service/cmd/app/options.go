@@ -19,6 +19,7 @@ import (
 
 	certificateservicecmd "github.com/gardener/gardener-extensions/controllers/extension-certificate-service/pkg/cmd"
 	controllercmd "github.com/gardener/gardener-extensions/pkg/controller/cmd"
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
 )
 
 // ExtensionName is the name of the extension.
@@ -30,6 +31,7 @@ type Options struct {
 	restOptions        *controllercmd.RESTOptions
 	managerOptions     *controllercmd.ManagerOptions
 	controllerOptions  *controllercmd.ControllerOptions
+	healthCheckOptions *healthcheck.Options
 	controllerSwitches *controllercmd.SwitchOptions
 	optionAggregator   controllercmd.OptionAggregator
 }
@@ -49,6 +51,9 @@ func NewOptions() *Options {
 			// This is a default value.
 			MaxConcurrentReconciles: 5,
 		},
+		healthCheckOptions: &healthcheck.Options{
+			SyncPeriod: healthcheck.DefaultSyncPeriod,
+		},
 		controllerSwitches: certificateservicecmd.ControllerSwitches(),
 	}
 
@@ -56,6 +61,7 @@ func NewOptions() *Options {
 		options.restOptions,
 		options.managerOptions,
 		options.controllerOptions,
+		controllercmd.PrefixOption("healthcheck-", options.healthCheckOptions),
 		options.certOptions,
 		options.controllerSwitches,
 	)
@@ -17,8 +17,10 @@ package cmd
 import (
 	"errors"
 	"github.com/gardener/gardener-extensions/controllers/extension-certificate-service/pkg/controller/certservice"
+	"github.com/gardener/gardener-extensions/controllers/extension-certificate-service/pkg/controller/healthcheck"
 	"github.com/gardener/gardener-extensions/controllers/extension-certificate-service/pkg/controller/lifecycle"
 	"github.com/gardener/gardener-extensions/pkg/controller/cmd"
+	extensionshealthcheckcontroller "github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
 	"io/ioutil"
 
 	"github.com/gardener/gardener-extensions/controllers/extension-certificate-service/pkg/apis/config/v1alpha1"
@@ -104,5 +106,6 @@ func ControllerSwitches() *cmd.SwitchOptions {
 	return cmd.NewSwitchOptions(
 		cmd.Switch(lifecycle.ControllerName, lifecycle.AddToManager),
 		cmd.Switch(certservice.ControllerName, certservice.AddToManager),
+		cmd.Switch(extensionshealthcheckcontroller.ControllerName, healthcheck.AddToManager),
 	)
 }
@@ -126,7 +126,7 @@ func (a *actuator) Delete(ctx context.Context, ex *extensionsv1alpha1.Extension)
 // InjectConfig injects the rest config to this actuator.
 func (a *actuator) InjectConfig(config *rest.Config) error {
 	a.config = config
-	applier, err := kubernetes.NewChartApplierForConfig(a.config)
+	applier, err := util.NewChartApplierForConfig(a.config)
 	if err != nil {
 		return fmt.Errorf("failed to create chart applier: %v", err)
 	}
@@ -234,7 +234,7 @@ func (a *actuator) deleteCertBroker(ctx context.Context, namespace string) error
 }
 
 func (a *actuator) createRBAC(ctx context.Context, config *rest.Config) error {
-	applier, err := kubernetes.NewChartApplierForConfig(config)
+	applier, err := util.NewChartApplierForConfig(config)
 	if err != nil {
 		return fmt.Errorf("failed to create chart applier: %v", err)
 	}
@@ -0,0 +1,59 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"time"
+
+	"github.com/gardener/gardener-extensions/controllers/extension-certificate-service/pkg/controller/certservice"
+	"github.com/gardener/gardener-extensions/controllers/extension-certificate-service/pkg/utils"
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck/general"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var (
+	// ControllerOptions contains options for the controller.
+	ControllerOptions controller.Options
+	// SyncPeriod is the period in which the health checks are executed.
+	SyncPeriod = healthcheck.DefaultSyncPeriod
+)
+
+// AddToManager adds a health check controller with the default Options to the given Controller Manager.
+func AddToManager(mgr manager.Manager) error {
+	return AddToManagerWithOptions(mgr, ControllerOptions, SyncPeriod)
+}
+
+// AddToManagerWithOptions adds a health check controller for the Extension resources of the certificate service
+// with the given Options to the given manager.
+func AddToManagerWithOptions(mgr manager.Manager, opts controller.Options, syncPeriod time.Duration) error {
+	return healthcheck.Add(mgr, healthcheck.AddArgs{
+		Type:                   certservice.Type,
+		GetExtensionObjectFunc: func() runtime.Object { return &extensionsv1alpha1.Extension{} },
+		HealthChecks: []healthcheck.ConditionTypeToHealthCheck{
+			{
+				ConditionType: gardenv1beta1.ShootControlPlaneHealthy,
+				HealthCheck:   general.NewSeedDeploymentHealthChecker(utils.CertBrokerResourceName),
+			},
+		},
+		SyncPeriod:        syncPeriod,
+		ControllerOptions: opts,
+	})
+}
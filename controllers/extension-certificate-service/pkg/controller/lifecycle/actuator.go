@@ -33,6 +33,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 
 	"github.com/gardener/gardener-extensions/controllers/extension-certificate-service/pkg/utils"
+	"github.com/gardener/gardener-extensions/pkg/util"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -112,7 +113,7 @@ func (a *actuator) DeployCertManager(ctx context.Context, config config.Configur
 	// TODO: (timuthy) Make `applier` an Actuator field and instantiate it once in `InjectConfig`.
 	// Can be done as soon as we reference a Kubernetes `memCacheClient` version >= 1.14.0
 	// https://github.com/kubernetes/kubernetes/commit/c94bee0b8b88851e5f5fd6538b99adff8b3a13f0#diff-498e117e58cba7576e99cf7fd3cb023eR129
-	applier, err := kubernetes.NewChartApplierForConfig(a.config)
+	applier, err := util.NewChartApplierForConfig(a.config)
 	if err != nil {
 		return fmt.Errorf("failed to create chart applier: %v", err)
 	}
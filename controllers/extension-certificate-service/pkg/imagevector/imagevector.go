@@ -17,8 +17,11 @@
 package imagevector
 
 import (
+	"path/filepath"
 	"strings"
 
+	"github.com/gardener/gardener-extensions/pkg/util/chart"
+
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gobuffalo/packr/v2"
 
@@ -28,7 +31,8 @@ import (
 var imageVector imagevector.ImageVector
 
 func init() {
-	box := packr.New("charts", "../../charts")
+	box := packr.New("extension-certificate-service-charts", "../../charts")
+	chart.RegisterSource(chart.NewBoxSource(filepath.Join("controllers", "extension-certificate-service", "charts"), box))
 
 	imagesYaml, err := box.FindString("images.yaml")
 	runtime.Must(err)
@@ -0,0 +1,50 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calico
+
+import "path/filepath"
+
+const (
+	// Name is the name of the Calico networking controller.
+	Name = "networking-calico"
+	// Type is the type of Network resources the Calico actuator is built for.
+	Type = "calico"
+
+	// CNIImageName is the name of the Calico CNI plugin image.
+	CNIImageName = "calico-cni"
+	// NodeImageName is the name of the Calico node image.
+	NodeImageName = "calico-node"
+	// KubeControllersImageName is the name of the Calico kube-controllers image.
+	KubeControllersImageName = "calico-kube-controllers"
+
+	// ChartName is the name of the chart containing the Calico components deployed into the shoot cluster.
+	ChartName = "calico"
+	// ReleaseName is the name of the managed resource (and its secret) containing the rendered Calico chart.
+	ReleaseName = "extension-networking-calico-config"
+
+	// NodeName is a constant for the name of the calico-node daemonset in the shoot cluster.
+	NodeName = "calico-node"
+	// KubeControllersName is a constant for the name of the calico-kube-controllers deployment in the shoot cluster.
+	KubeControllersName = "calico-kube-controllers"
+)
+
+var (
+	// ChartsPath is the path to the charts
+	ChartsPath = filepath.Join("controllers", Name, "charts")
+	// InternalChartsPath is the path to the internal charts
+	InternalChartsPath = filepath.Join(ChartsPath, "internal")
+	// ChartPath is the path to the chart containing the Calico components.
+	ChartPath = filepath.Join(InternalChartsPath, ChartName)
+)
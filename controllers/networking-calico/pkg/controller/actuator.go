@@ -0,0 +1,126 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/calico"
+	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/imagevector"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/network"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	"github.com/gardener/gardener-resource-manager/pkg/manager"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/chart"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var calicoChart = &chart.Chart{
+	Name:   calico.ChartName,
+	Path:   calico.ChartPath,
+	Images: []string{calico.CNIImageName, calico.NodeImageName, calico.KubeControllersImageName},
+	Objects: []*chart.Object{
+		{Type: &corev1.ConfigMap{}, Name: "calico-config"},
+		{Type: &appsv1.DaemonSet{}, Name: calico.NodeName},
+		{Type: &appsv1.Deployment{}, Name: calico.KubeControllersName},
+		{Type: &corev1.ServiceAccount{}, Name: calico.NodeName},
+		{Type: &corev1.ServiceAccount{}, Name: calico.KubeControllersName},
+		{Type: &rbacv1.ClusterRole{}, Name: calico.NodeName},
+		{Type: &rbacv1.ClusterRoleBinding{}, Name: calico.NodeName},
+		{Type: &rbacv1.ClusterRole{}, Name: calico.KubeControllersName},
+		{Type: &rbacv1.ClusterRoleBinding{}, Name: calico.KubeControllersName},
+	},
+}
+
+type actuator struct {
+	logger logr.Logger
+	client client.Client
+}
+
+// NewActuator creates a new Actuator that deploys Calico into the shoot cluster by means of a managed resource.
+func NewActuator() network.Actuator {
+	return &actuator{
+		logger: log.Log.WithName("network-calico-actuator"),
+	}
+}
+
+// InjectClient injects the controller runtime client into the actuator.
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+// Reconcile implements network.Actuator.
+func (a *actuator) Reconcile(ctx context.Context, network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster) error {
+	chartRenderer, err := util.NewChartRendererForShoot(cluster.Shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return errors.Wrapf(err, "could not create chart renderer for shoot '%s'", network.Namespace)
+	}
+
+	values := ComputeChartValues(cluster)
+
+	a.logger.Info("Rendering calico chart", "network", util.ObjectName(network))
+	version := cluster.Shoot.Spec.Kubernetes.Version
+	name, data, err := calicoChart.Render(chartRenderer, metav1.NamespaceSystem, imagevector.ImageVector(), version, version, values)
+	if err != nil {
+		return errors.Wrapf(err, "could not render calico chart for network '%s'", util.ObjectName(network))
+	}
+
+	a.logger.Info("Creating secret of managed resource containing calico chart", "network", util.ObjectName(network), "name", calico.ReleaseName)
+	if err := manager.NewSecret(a.client).
+		WithNamespacedName(network.Namespace, calico.ReleaseName).
+		WithKeyValues(map[string][]byte{name: data}).
+		Reconcile(ctx); err != nil {
+		return errors.Wrapf(err, "could not create or update secret '%s/%s' of managed resource containing calico chart for network '%s'", network.Namespace, calico.ReleaseName, util.ObjectName(network))
+	}
+
+	a.logger.Info("Creating managed resource containing calico chart", "network", util.ObjectName(network), "name", calico.ReleaseName)
+	if err := manager.NewManagedResource(a.client).
+		WithNamespacedName(network.Namespace, calico.ReleaseName).
+		WithSecretRef(calico.ReleaseName).
+		Reconcile(ctx); err != nil {
+		return errors.Wrapf(err, "could not create or update managed resource '%s/%s' containing calico chart for network '%s'", network.Namespace, calico.ReleaseName, util.ObjectName(network))
+	}
+
+	return nil
+}
+
+// Delete implements network.Actuator.
+func (a *actuator) Delete(ctx context.Context, network *extensionsv1alpha1.Network, _ *extensionscontroller.Cluster) error {
+	a.logger.Info("Deleting managed resource containing calico chart", "network", util.ObjectName(network), "name", calico.ReleaseName)
+	if err := manager.NewManagedResource(a.client).
+		WithNamespacedName(network.Namespace, calico.ReleaseName).
+		Delete(ctx); err != nil {
+		return errors.Wrapf(err, "could not delete managed resource '%s/%s' containing calico chart for network '%s'", network.Namespace, calico.ReleaseName, util.ObjectName(network))
+	}
+
+	a.logger.Info("Deleting secret of managed resource containing calico chart", "network", util.ObjectName(network), "name", calico.ReleaseName)
+	if err := manager.NewSecret(a.client).
+		WithNamespacedName(network.Namespace, calico.ReleaseName).
+		Delete(ctx); err != nil {
+		return errors.Wrapf(err, "could not delete secret '%s/%s' of managed resource containing calico chart for network '%s'", network.Namespace, calico.ReleaseName, util.ObjectName(network))
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+)
+
+const (
+	// ipamTypeHostLocal is the host-local IPAM plugin of Calico.
+	ipamTypeHostLocal = "host-local"
+	// ipamSubnetUsePodCIDR lets the host-local IPAM plugin allocate addresses from the pod CIDR of the node.
+	ipamSubnetUsePodCIDR = "usePodCidr"
+)
+
+// ComputeChartValues computes the values for the Calico chart out of the given cluster.
+// The IP pool of Calico is configured with the pod network of the shoot.
+func ComputeChartValues(cluster *extensionscontroller.Cluster) map[string]interface{} {
+	return map[string]interface{}{
+		"global": map[string]interface{}{
+			"podCIDR": string(extensionscontroller.GetPodNetwork(cluster.Shoot)),
+		},
+		"config": map[string]interface{}{
+			"ipam": map[string]interface{}{
+				"type":   ipamTypeHostLocal,
+				"subnet": ipamSubnetUsePodCIDR,
+			},
+		},
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	. "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/controller"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("#ComputeChartValues", func() {
+	It("should configure the IPAM with the pod network of the shoot", func() {
+		podCIDR := gardencorev1alpha1.CIDR("100.96.0.0/11")
+		cluster := &extensionscontroller.Cluster{
+			Shoot: &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: gardenv1beta1.Cloud{
+						AWS: &gardenv1beta1.AWSCloud{
+							Networks: gardenv1beta1.AWSNetworks{
+								K8SNetworks: gardencorev1alpha1.K8SNetworks{
+									Pods: &podCIDR,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		Expect(ComputeChartValues(cluster)).To(Equal(map[string]interface{}{
+			"global": map[string]interface{}{
+				"podCIDR": "100.96.0.0/11",
+			},
+			"config": map[string]interface{}{
+				"ipam": map[string]interface{}{
+					"type":   "host-local",
+					"subnet": "usePodCidr",
+				},
+			},
+		}))
+	})
+})
@@ -32,7 +32,7 @@ var cloudInitTemplate *template.Template
 const DefaultUnitsPath = "/etc/systemd/system"
 
 func init() {
-	box := packr.New("templates", "./templates")
+	box := packr.New("os-coreos-alicloud-templates", "./templates")
 
 	cloudInitTemplateString, err := box.FindString("cloud-init.sh.template")
 	runtime.Must(err)
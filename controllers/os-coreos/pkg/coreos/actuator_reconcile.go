@@ -20,16 +20,32 @@ import (
 	"fmt"
 	"strconv"
 
-	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
-
-	corev1 "k8s.io/api/core/v1"
+	"github.com/gardener/gardener-extensions/controllers/os-coreos/pkg/ignition"
+	oscommonactuator "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/actuator"
 
-	"sigs.k8s.io/controller-runtime/pkg/client"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
 var coreOSCloudInitCommand = fmt.Sprintf("/usr/bin/coreos-cloudinit --from-file=")
 
 func (c *actuator) reconcile(ctx context.Context, config *extensionsv1alpha1.OperatingSystemConfig) ([]byte, *string, []string, error) {
+	version, err := ignitionVersion(config)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(version) > 0 {
+		generator, err := ignition.NewGenerator(version)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		ignitionConfig, command, err := oscommonactuator.CloudConfigFromOperatingSystemConfig(ctx, c.client, config, generator)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("could not generate ignition config: %v", err)
+		}
+		return ignitionConfig, command, oscommonactuator.OperatingSystemConfigUnitNames(config), nil
+	}
+
 	cloudConfig, units, err := c.cloudConfigFromOperatingSystemConfig(ctx, config)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("could not generate cloud config: %v", err)
@@ -44,6 +60,25 @@ func (c *actuator) reconcile(ctx context.Context, config *extensionsv1alpha1.Ope
 	return []byte(cloudConfig), command, units, nil
 }
 
+// ignitionVersion returns the Ignition specification version the given OperatingSystemConfig shall be rendered with,
+// or an empty string if it shall be rendered as cloud config.
+func ignitionVersion(config *extensionsv1alpha1.OperatingSystemConfig) (string, error) {
+	if config.Spec.Purpose != extensionsv1alpha1.OperatingSystemConfigPurposeProvision {
+		return "", nil
+	}
+
+	switch format := config.Annotations[FormatAnnotation]; format {
+	case "", FormatCloudConfig:
+		return "", nil
+	case FormatIgnitionV2:
+		return ignition.SpecVersionV2, nil
+	case FormatIgnitionV3:
+		return ignition.SpecVersionV3, nil
+	default:
+		return "", fmt.Errorf("unsupported value %q of annotation %s", format, FormatAnnotation)
+	}
+}
+
 func (c *actuator) cloudConfigFromOperatingSystemConfig(ctx context.Context, config *extensionsv1alpha1.OperatingSystemConfig) (string, []string, error) {
 	cloudConfig := &CloudConfig{
 		CoreOS: Config{
@@ -102,35 +137,23 @@ func (c *actuator) cloudConfigFromOperatingSystemConfig(ctx context.Context, con
 		cloudConfig.CoreOS.Units = append(cloudConfig.CoreOS.Units, u)
 	}
 
-	for _, file := range config.Spec.Files {
-		f := File{
-			Path: file.Path,
-		}
-
-		permissions := extensionsv1alpha1.OperatingSystemConfigDefaultFilePermission
-		if p := file.Permissions; p != nil {
-			permissions = *p
-		}
-		f.RawFilePermissions = strconv.FormatInt(int64(permissions), 8)
+	files, err := oscommonactuator.FilesFromOperatingSystemConfig(ctx, c.client, config)
+	if err != nil {
+		return "", nil, err
+	}
 
-		if file.Content.Inline != nil {
-			f.Encoding = file.Content.Inline.Encoding
-			f.Content = file.Content.Inline.Data
+	for _, file := range files {
+		f := File{
+			Path:               file.Path,
+			Owner:              file.Owner,
+			RawFilePermissions: strconv.FormatInt(int64(*file.Permissions), 8),
+			Encoding:           "b64",
+			Content:            base64.StdEncoding.EncodeToString(file.Content),
 		}
 
-		if file.Content.SecretRef != nil {
-			var secret corev1.Secret
-			if err := c.client.Get(ctx, client.ObjectKey{Name: file.Content.SecretRef.Name, Namespace: config.Namespace}, &secret); err != nil {
-				return "", nil, err
-			}
-
-			data, ok := secret.Data[file.Content.SecretRef.DataKey]
-			if !ok {
-				return "", nil, fmt.Errorf("could not find key %q in data of secret %q", file.Content.SecretRef.DataKey, file.Content.SecretRef.Name)
-			}
-
-			f.Encoding = "b64"
-			f.Content = base64.StdEncoding.EncodeToString(data)
+		if file.TransmitUnencoded {
+			f.Encoding = ""
+			f.Content = string(file.Content)
 		}
 
 		cloudConfig.WriteFiles = append(cloudConfig.WriteFiles, f)
@@ -15,9 +15,17 @@
 package coreos_test
 
 import (
+	"context"
+	"encoding/json"
+	"strings"
+
 	"github.com/gardener/gardener-extensions/controllers/os-coreos/pkg/coreos"
+	"github.com/gardener/gardener-extensions/controllers/os-coreos/pkg/ignition"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("CloudConfig", func() {
@@ -45,3 +53,79 @@ coreos:
 		})
 	})
 })
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctx    = context.TODO()
+		config *extensionsv1alpha1.OperatingSystemConfig
+	)
+
+	BeforeEach(func() {
+		content := "[Unit]"
+		config = &extensionsv1alpha1.OperatingSystemConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pool-01-original",
+				Namespace: "default",
+			},
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: coreos.Type},
+				Purpose:     extensionsv1alpha1.OperatingSystemConfigPurposeProvision,
+				Units: []extensionsv1alpha1.Unit{
+					{Name: "kubelet.service", Content: &content},
+				},
+				Files: []extensionsv1alpha1.File{
+					{
+						Path: "/foo",
+						Content: extensionsv1alpha1.FileContent{
+							Inline: &extensionsv1alpha1.FileContentInline{Data: "bar"},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#Reconcile", func() {
+		It("should render a cloud config by default", func() {
+			data, _, units, err := coreos.NewActuator().Reconcile(ctx, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.HasPrefix(string(data), "#cloud-config")).To(BeTrue())
+			Expect(units).To(ConsistOf("kubelet.service"))
+		})
+
+		It("should render an Ignition config if selected by the format annotation", func() {
+			config.Annotations = map[string]string{coreos.FormatAnnotation: coreos.FormatIgnitionV2}
+
+			data, command, units, err := coreos.NewActuator().Reconcile(ctx, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(command).To(BeNil())
+			Expect(units).To(ConsistOf("kubelet.service"))
+
+			ignitionConfig := &ignition.Config{}
+			Expect(json.Unmarshal(data, ignitionConfig)).To(Succeed())
+			Expect(ignitionConfig.Ignition.Version).To(Equal(ignition.SpecVersionV2))
+			Expect(ignitionConfig.Storage.Files).To(HaveLen(1))
+			Expect(ignitionConfig.Storage.Files[0].Path).To(Equal("/foo"))
+		})
+
+		It("should render a cloud config for reconciling machines regardless of the format annotation", func() {
+			path := "/var/lib/config"
+			config.Annotations = map[string]string{coreos.FormatAnnotation: coreos.FormatIgnitionV3}
+			config.Spec.Purpose = extensionsv1alpha1.OperatingSystemConfigPurposeReconcile
+			config.Spec.ReloadConfigFilePath = &path
+
+			data, command, _, err := coreos.NewActuator().Reconcile(ctx, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.HasPrefix(string(data), "#cloud-config")).To(BeTrue())
+			Expect(command).NotTo(BeNil())
+			Expect(*command).To(Equal("/usr/bin/coreos-cloudinit --from-file=/var/lib/config"))
+		})
+
+		It("should fail for unsupported formats", func() {
+			config.Annotations = map[string]string{coreos.FormatAnnotation: "foo"}
+
+			_, _, _, err := coreos.NewActuator().Reconcile(ctx, config)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -24,6 +24,21 @@ import (
 // Type is the type of OperatingSystemConfigs the coreos actuator / predicate are built for.
 const Type = "coreos"
 
+const (
+	// FormatAnnotation is the annotation of an OperatingSystemConfig that selects the format of the generated
+	// configuration if it is used for provisioning machines. OperatingSystemConfigs that are used for reconciling
+	// machines are always rendered as cloud config, as Ignition configs cannot be applied on running machines.
+	FormatAnnotation = "coreos.os.extensions.gardener.cloud/format"
+	// FormatCloudConfig is the (default) value of the FormatAnnotation that selects a coreos-cloudinit cloud config.
+	FormatCloudConfig = "cloud-config"
+	// FormatIgnitionV2 is the value of the FormatAnnotation that selects an Ignition config of specification
+	// version 2.2.0, e.g. for Flatcar Container Linux.
+	FormatIgnitionV2 = "ignition-v2"
+	// FormatIgnitionV3 is the value of the FormatAnnotation that selects an Ignition config of specification
+	// version 3.0.0.
+	FormatIgnitionV3 = "ignition-v3"
+)
+
 var (
 	// DefaultAddOptions are the default controller.Options for AddToManager.
 	DefaultAddOptions = AddOptions{}
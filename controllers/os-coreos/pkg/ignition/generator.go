@@ -0,0 +1,139 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignition
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+
+	commonosgenerator "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// SpecVersionV2 is the Ignition specification version understood by Ignition v2, e.g. on
+	// CoreOS Container Linux and Flatcar Container Linux.
+	SpecVersionV2 = "2.2.0"
+	// SpecVersionV3 is the Ignition specification version understood by Ignition v3.
+	SpecVersionV3 = "3.0.0"
+)
+
+type generator struct {
+	version string
+}
+
+// NewGenerator creates a new Generator that renders OperatingSystemConfigs into Ignition configs of the
+// given specification version. Ignition configs are only applied on the first boot of a machine, hence
+// the generator never returns a command for reloading the configuration.
+func NewGenerator(version string) (commonosgenerator.Generator, error) {
+	if version != SpecVersionV2 && version != SpecVersionV3 {
+		return nil, fmt.Errorf("unsupported Ignition specification version %q", version)
+	}
+	return &generator{version: version}, nil
+}
+
+// Generate implements commonosgenerator.Generator.
+func (g *generator) Generate(config *commonosgenerator.OperatingSystemConfig) ([]byte, *string, error) {
+	ignitionConfig := &Config{
+		Ignition: Ignition{
+			Version: g.version,
+		},
+		Systemd: Systemd{
+			Units: []Unit{
+				{
+					Name: "update-engine.service",
+					Mask: true,
+				},
+				{
+					Name: "locksmithd.service",
+					Mask: true,
+				},
+			},
+		},
+	}
+
+	// blacklist sctp kernel module
+	if !config.Bootstrap {
+		ignitionConfig.Storage.Files = append(ignitionConfig.Storage.Files, g.file("/etc/modprobe.d/sctp.conf", []byte("install sctp /bin/true"), 0644))
+	}
+
+	for _, file := range config.Files {
+		permissions := extensionsv1alpha1.OperatingSystemConfigDefaultFilePermission
+		if p := file.Permissions; p != nil {
+			permissions = *p
+		}
+		f := g.file(file.Path, file.Content, permissions)
+		if file.TransmitUnencoded {
+			f.Contents.Source = "data:," + url.PathEscape(string(file.Content))
+		}
+		if len(file.Owner) > 0 {
+			owner := strings.SplitN(file.Owner, ":", 2)
+			f.User = &NodeUser{Name: owner[0]}
+			if len(owner) == 2 {
+				f.Group = &NodeGroup{Name: owner[1]}
+			}
+		}
+
+		ignitionConfig.Storage.Files = append(ignitionConfig.Storage.Files, f)
+	}
+
+	for _, unit := range config.Units {
+		enabled := true
+		u := Unit{
+			Name:     unit.Name,
+			Enabled:  &enabled,
+			Contents: string(unit.Content),
+		}
+
+		for _, dropIn := range unit.DropIns {
+			u.Dropins = append(u.Dropins, Dropin{
+				Name:     dropIn.Name,
+				Contents: string(dropIn.Content),
+			})
+		}
+
+		ignitionConfig.Systemd.Units = append(ignitionConfig.Systemd.Units, u)
+	}
+
+	data, err := ignitionConfig.Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, nil, nil
+}
+
+func (g *generator) file(path string, content []byte, permissions int32) File {
+	mode := int(permissions)
+	f := File{
+		Path: path,
+		Contents: FileContents{
+			Source: "data:;base64," + base64.StdEncoding.EncodeToString(content),
+		},
+		Mode: &mode,
+	}
+
+	switch g.version {
+	case SpecVersionV2:
+		f.Filesystem = "root"
+	case SpecVersionV3:
+		overwrite := true
+		f.Overwrite = &overwrite
+	}
+
+	return f
+}
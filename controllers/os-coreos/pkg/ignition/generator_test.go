@@ -0,0 +1,100 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignition_test
+
+import (
+	"encoding/json"
+
+	"github.com/gardener/gardener-extensions/controllers/os-coreos/pkg/ignition"
+	commonosgenerator "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator/test"
+
+	"github.com/gobuffalo/packr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ignition Generator", func() {
+	Describe("Conformance Tests (v2)", func() {
+		generator, err := ignition.NewGenerator(ignition.SpecVersionV2)
+
+		It("should not fail creating generator", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Describe("Conformance", test.DescribeTest(generator, packr.NewBox("./testfiles/v2")))
+	})
+
+	Describe("Conformance Tests (v3)", func() {
+		generator, err := ignition.NewGenerator(ignition.SpecVersionV3)
+
+		It("should not fail creating generator", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Describe("Conformance", test.DescribeTest(generator, packr.NewBox("./testfiles/v3")))
+	})
+
+	Describe("#NewGenerator", func() {
+		It("should reject unsupported specification versions", func() {
+			_, err := ignition.NewGenerator("2.1.0")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Generate", func() {
+		It("should blacklist the sctp kernel module and not return a command if not bootstrapping", func() {
+			generator, err := ignition.NewGenerator(ignition.SpecVersionV2)
+			Expect(err).NotTo(HaveOccurred())
+
+			path := "/var/lib/config"
+			data, command, err := generator.Generate(&commonosgenerator.OperatingSystemConfig{Path: &path})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(command).To(BeNil())
+
+			config := &ignition.Config{}
+			Expect(json.Unmarshal(data, config)).To(Succeed())
+			Expect(config.Storage.Files).To(HaveLen(1))
+			Expect(config.Storage.Files[0].Path).To(Equal("/etc/modprobe.d/sctp.conf"))
+			Expect(config.Storage.Files[0].Contents.Source).To(Equal("data:;base64,aW5zdGFsbCBzY3RwIC9iaW4vdHJ1ZQ=="))
+		})
+
+		It("should set the owner and transmit the content unencoded if requested", func() {
+			generator, err := ignition.NewGenerator(ignition.SpecVersionV3)
+			Expect(err).NotTo(HaveOccurred())
+
+			data, _, err := generator.Generate(&commonosgenerator.OperatingSystemConfig{
+				Files: []*commonosgenerator.File{
+					{
+						Path:              "/foo",
+						Content:           []byte("foo bar"),
+						Owner:             "core:docker",
+						TransmitUnencoded: true,
+					},
+				},
+				Bootstrap: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			config := &ignition.Config{}
+			Expect(json.Unmarshal(data, config)).To(Succeed())
+			Expect(config.Storage.Files).To(HaveLen(1))
+			Expect(config.Storage.Files[0].Contents.Source).To(Equal("data:,foo%20bar"))
+			Expect(*config.Storage.Files[0].Mode).To(Equal(0644))
+			Expect(config.Storage.Files[0].User).To(Equal(&ignition.NodeUser{Name: "core"}))
+			Expect(config.Storage.Files[0].Group).To(Equal(&ignition.NodeGroup{Name: "docker"}))
+		})
+	})
+})
@@ -0,0 +1,109 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignition
+
+import "encoding/json"
+
+// Config is a structure containing the relevant fields of an Ignition config. It covers both the
+// specification versions 2.2.0 and 3.0.0 and can be marshalled to JSON.
+type Config struct {
+	// Ignition contains metadata about the config itself.
+	Ignition Ignition `json:"ignition"`
+	// Storage describes the files that are written to the disk of the machine.
+	Storage Storage `json:"storage,omitempty"`
+	// Systemd describes the systemd units of the machine.
+	Systemd Systemd `json:"systemd,omitempty"`
+}
+
+// Ignition contains metadata about the config itself.
+type Ignition struct {
+	// Version is the specification version of the config.
+	Version string `json:"version"`
+}
+
+// Storage describes the files that are written to the disk of the machine.
+type Storage struct {
+	// Files is a list of files that are written to the disk of the machine.
+	Files []File `json:"files,omitempty"`
+}
+
+// File is a file that gets written to the disk of the machine.
+type File struct {
+	// Filesystem is the name of the filesystem the file is written to. Only used in specification version 2.
+	Filesystem string `json:"filesystem,omitempty"`
+	// Path is the absolute path of the file.
+	Path string `json:"path"`
+	// Overwrite defines whether an existing file is replaced. Only used in specification version 3.
+	Overwrite *bool `json:"overwrite,omitempty"`
+	// Contents describes the contents of the file.
+	Contents FileContents `json:"contents"`
+	// Mode is the file's permission mode as decimal number, e.g. 420 for 0644.
+	Mode *int `json:"mode,omitempty"`
+	// User is the owning user of the file.
+	User *NodeUser `json:"user,omitempty"`
+	// Group is the owning group of the file.
+	Group *NodeGroup `json:"group,omitempty"`
+}
+
+// NodeUser is the owning user of a file.
+type NodeUser struct {
+	// Name is the name of the user.
+	Name string `json:"name,omitempty"`
+}
+
+// NodeGroup is the owning group of a file.
+type NodeGroup struct {
+	// Name is the name of the group.
+	Name string `json:"name,omitempty"`
+}
+
+// FileContents describes the contents of a file.
+type FileContents struct {
+	// Source is the URL of the file contents, usually a data URL.
+	Source string `json:"source"`
+}
+
+// Systemd describes the systemd units of the machine.
+type Systemd struct {
+	// Units is a list of systemd units.
+	Units []Unit `json:"units,omitempty"`
+}
+
+// Unit is a systemd unit.
+type Unit struct {
+	// Name is the name of the unit.
+	Name string `json:"name"`
+	// Enabled defines whether the unit is enabled or not.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Mask defines whether the unit is masked or not.
+	Mask bool `json:"mask,omitempty"`
+	// Contents is the content of the unit.
+	Contents string `json:"contents,omitempty"`
+	// Dropins is a list of drop-ins of the unit.
+	Dropins []Dropin `json:"dropins,omitempty"`
+}
+
+// Dropin is a drop-in of a systemd unit.
+type Dropin struct {
+	// Name is the name of the drop-in.
+	Name string `json:"name"`
+	// Contents is the content of the drop-in.
+	Contents string `json:"contents,omitempty"`
+}
+
+// Marshal returns the JSON representation of the Config structure.
+func (c Config) Marshal() ([]byte, error) {
+	return json.Marshal(c)
+}
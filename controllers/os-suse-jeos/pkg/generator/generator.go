@@ -26,7 +26,7 @@ var cmd = "/usr/bin/cloud-init clean && /usr/bin/cloud-init --file %s init"
 
 // NewCloudInitGenerator creates a new Generator using the template file for suse-jeos
 func NewCloudInitGenerator() (*template_gen.CloudInitGenerator, error) {
-	box := packr.New("templates", "./templates")
+	box := packr.New("os-suse-jeos-templates", "./templates")
 	cloudInitTemplateString, err := box.FindString("cloud-init.template")
 	if err != nil {
 		return nil, err
@@ -0,0 +1,61 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"io/ioutil"
+
+	susegenerator "github.com/gardener/gardener-extensions/controllers/os-suse-jeos/pkg/generator"
+	oscommongenerator "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator/test"
+
+	"github.com/gobuffalo/packr"
+	packrv2 "github.com/gobuffalo/packr/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ubuntu Generator Test", func() {
+	var box = packr.NewBox("./testfiles")
+	generator, err := NewCloudInitGenerator()
+
+	It("should not fail creating generator", func() {
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Conformance Tests", test.DescribeTest(generator, box))
+
+	It("should render its own template if linked together with other operating system generators", func() {
+		// Binaries built with packr2 register the embedded templates of all linked generators by their box names.
+		suseTemplate, err := ioutil.ReadFile("../../../os-suse-jeos/pkg/generator/templates/cloud-init.template")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(packrv2.New("os-suse-jeos-templates", "").AddBytes("cloud-init.template", suseTemplate)).To(Succeed())
+
+		suseGenerator, err := susegenerator.NewCloudInitGenerator()
+		Expect(err).NotTo(HaveOccurred())
+		ubuntuGenerator, err := NewCloudInitGenerator()
+		Expect(err).NotTo(HaveOccurred())
+
+		osc := &oscommongenerator.OperatingSystemConfig{Bootstrap: true}
+
+		suseCloudInit, _, err := suseGenerator.Generate(osc)
+		Expect(err).NotTo(HaveOccurred())
+		ubuntuCloudInit, _, err := ubuntuGenerator.Generate(osc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(string(ubuntuCloudInit)).To(ContainSubstring("apt-get install"))
+		Expect(string(suseCloudInit)).NotTo(ContainSubstring("apt-get install"))
+	})
+})
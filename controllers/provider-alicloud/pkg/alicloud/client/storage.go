@@ -0,0 +1,152 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+// abortIncompleteMultipartUploadsAfterDays is the number of days after which incomplete multipart uploads are aborted.
+const abortIncompleteMultipartUploadsAfterDays = 7
+
+// Storage is the interface for the Alicloud OSS operations needed to manage backups.
+type Storage interface {
+	// CreateBucketIfNotExists creates the bucket with the given name if it does not exist yet.
+	// The region is ignored as the region of a bucket is determined by the storage endpoint.
+	CreateBucketIfNotExists(ctx context.Context, bucket, region string) error
+	// DeleteBucketIfExists deletes the bucket with the given name including all its objects if it exists.
+	DeleteBucketIfExists(ctx context.Context, bucket string) error
+	// DeleteObjectsWithPrefix deletes all objects of the given bucket whose key starts with the given prefix.
+	DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error
+}
+
+// StorageFactory is the factory to instantiate Alicloud OSS clients.
+type StorageFactory interface {
+	// NewStorage creates a new Storage client from the given storage endpoint and credentials.
+	NewStorage(endpoint, accessKeyID, accessKeySecret string) (Storage, error)
+}
+
+// StorageFactoryFunc is a function that implements the StorageFactory interface.
+type StorageFactoryFunc func(endpoint, accessKeyID, accessKeySecret string) (Storage, error)
+
+// NewStorage implements StorageFactory.
+func (f StorageFactoryFunc) NewStorage(endpoint, accessKeyID, accessKeySecret string) (Storage, error) {
+	return f(endpoint, accessKeyID, accessKeySecret)
+}
+
+// DefaultStorageFactory instantiates a default StorageFactory.
+func DefaultStorageFactory() StorageFactory {
+	return StorageFactoryFunc(NewStorage)
+}
+
+// ComputeStorageEndpoint computes the OSS storage endpoint of the given region.
+func ComputeStorageEndpoint(region string) string {
+	return fmt.Sprintf("https://oss-%s.aliyuncs.com", region)
+}
+
+type storage struct {
+	client *oss.Client
+}
+
+// NewStorage creates a new Storage client from the given storage endpoint and credentials.
+func NewStorage(endpoint, accessKeyID, accessKeySecret string) (Storage, error) {
+	client, err := oss.New(endpoint, accessKeyID, accessKeySecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage{client}, nil
+}
+
+// CreateBucketIfNotExists implements Storage.
+// The bucket is configured with default server-side encryption and a lifecycle rule that cleans up
+// incomplete multipart uploads.
+func (s *storage) CreateBucketIfNotExists(_ context.Context, bucket, _ string) error {
+	if err := s.client.CreateBucket(bucket, oss.ACL(oss.ACLPrivate)); err != nil && !hasServiceErrorCode(err, "BucketAlreadyExists") {
+		return err
+	}
+
+	if err := s.client.SetBucketEncryption(bucket, oss.ServerEncryptionRule{
+		SSEDefault: oss.SSEDefaultRule{SSEAlgorithm: "AES256"},
+	}); err != nil {
+		return err
+	}
+
+	return s.client.SetBucketLifecycle(bucket, []oss.LifecycleRule{
+		{
+			ID:     "abort-incomplete-multipart-uploads",
+			Status: "Enabled",
+			AbortMultipartUpload: &oss.LifecycleAbortMultipartUpload{
+				Days: abortIncompleteMultipartUploadsAfterDays,
+			},
+		},
+	})
+}
+
+// DeleteBucketIfExists implements Storage.
+func (s *storage) DeleteBucketIfExists(ctx context.Context, bucket string) error {
+	if err := s.DeleteObjectsWithPrefix(ctx, bucket, ""); err != nil {
+		return err
+	}
+
+	if err := s.client.DeleteBucket(bucket); err != nil && !hasServiceErrorCode(err, "NoSuchBucket") {
+		return err
+	}
+	return nil
+}
+
+// DeleteObjectsWithPrefix implements Storage.
+func (s *storage) DeleteObjectsWithPrefix(_ context.Context, bucket, prefix string) error {
+	b, err := s.client.Bucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	marker := oss.Marker("")
+	for {
+		result, err := b.ListObjects(oss.Prefix(prefix), marker)
+		if err != nil {
+			if hasServiceErrorCode(err, "NoSuchBucket") {
+				return nil
+			}
+			return err
+		}
+
+		if len(result.Objects) > 0 {
+			keys := make([]string, 0, len(result.Objects))
+			for _, object := range result.Objects {
+				keys = append(keys, object.Key)
+			}
+			if _, err := b.DeleteObjects(keys, oss.DeleteObjectsQuiet(true)); err != nil {
+				return err
+			}
+		}
+
+		if !result.IsTruncated {
+			return nil
+		}
+		marker = oss.Marker(result.NextMarker)
+	}
+}
+
+func hasServiceErrorCode(err error, code string) bool {
+	if serr, ok := err.(oss.ServiceError); ok {
+		return serr.Code == code
+	}
+	return false
+}
@@ -49,8 +49,14 @@ const (
 
 	// CloudProviderConfigName is the name of the configmap containing the cloud provider config.
 	CloudProviderConfigName = "cloud-provider-config"
+	// CloudControllerManagerName is a constant for the name of the cloud-controller-manager.
+	CloudControllerManagerName = "cloud-controller-manager"
 	// MachineControllerManagerName is a constant for the name of the machine-controller-manager.
 	MachineControllerManagerName = "machine-controller-manager"
+	// CSIPluginControllerName is a constant for the name of the CSI plugin controller deployment in the seed cluster.
+	CSIPluginControllerName = "csi-plugin-controller"
+	// CSIDiskPluginName is a constant for the name of the CSI disk plugin daemonset in the shoot cluster.
+	CSIDiskPluginName = "csi-disk-plugin-alicloud"
 	// BackupSecretName is the name of the secret containing the credentials for storing the backups of Shoot clusters.
 	BackupSecretName = "etcd-backup"
 )
@@ -0,0 +1,41 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alicloud
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains configuration settings for the machines of a worker pool.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// SpotStrategy is the spot strategy of the machines. Defaults to 'NoSpot'.
+	SpotStrategy *SpotStrategy
+	// AdditionalTags is a map of tags that are added to the machines in addition to the default ones.
+	AdditionalTags map[string]string
+}
+
+// SpotStrategy is a constant for the spot strategies of ECS instances.
+type SpotStrategy string
+
+const (
+	// SpotStrategyNoSpot is a constant for regular pay-as-you-go instances.
+	SpotStrategyNoSpot SpotStrategy = "NoSpot"
+	// SpotStrategySpotAsPriceGo is a constant for spot instances whose price is automatically bid at the market price.
+	SpotStrategySpotAsPriceGo SpotStrategy = "SpotAsPriceGo"
+)
@@ -126,6 +126,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*alicloud.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(a.(*WorkerConfig), b.(*alicloud.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*alicloud.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Zone)(nil), (*alicloud.Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Zone_To_alicloud_Zone(a.(*Zone), b.(*alicloud.Zone), scope)
 	}); err != nil {
@@ -349,6 +359,28 @@ func Convert_alicloud_VSwitch_To_v1alpha1_VSwitch(in *alicloud.VSwitch, out *VSw
 	return autoConvert_alicloud_VSwitch_To_v1alpha1_VSwitch(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(in *WorkerConfig, out *alicloud.WorkerConfig, s conversion.Scope) error {
+	out.SpotStrategy = (*alicloud.SpotStrategy)(unsafe.Pointer(in.SpotStrategy))
+	out.AdditionalTags = *(*map[string]string)(unsafe.Pointer(&in.AdditionalTags))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(in *WorkerConfig, out *alicloud.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_alicloud_WorkerConfig(in, out, s)
+}
+
+func autoConvert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(in *alicloud.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.SpotStrategy = (*SpotStrategy)(unsafe.Pointer(in.SpotStrategy))
+	out.AdditionalTags = *(*map[string]string)(unsafe.Pointer(&in.AdditionalTags))
+	return nil
+}
+
+// Convert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(in *alicloud.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Zone_To_alicloud_Zone(in *Zone, out *alicloud.Zone, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Worker = corev1alpha1.CIDR(in.Worker)
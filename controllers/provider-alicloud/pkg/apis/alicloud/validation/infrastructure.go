@@ -0,0 +1,104 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object against the given pod and service networks of the shoot.
+func ValidateInfrastructureConfig(infra *apisalicloud.InfrastructureConfig, podsCIDR, servicesCIDR string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := field.NewPath("networks")
+	vpcPath := networksPath.Child("vpc")
+
+	var vpcCIDR string
+	switch {
+	case infra.Networks.VPC.ID == nil && infra.Networks.VPC.CIDR == nil:
+		allErrs = append(allErrs, field.Invalid(vpcPath, infra.Networks.VPC, "must specify either a vpc id or a cidr"))
+	case infra.Networks.VPC.ID != nil && infra.Networks.VPC.CIDR != nil:
+		allErrs = append(allErrs, field.Invalid(vpcPath, infra.Networks.VPC, "must not specify both a vpc id and a cidr"))
+	case infra.Networks.VPC.ID != nil && len(*infra.Networks.VPC.ID) == 0:
+		allErrs = append(allErrs, field.Required(vpcPath.Child("id"), "field must not be empty"))
+	case infra.Networks.VPC.CIDR != nil:
+		vpcCIDR = string(*infra.Networks.VPC.CIDR)
+		allErrs = append(allErrs, validateNetwork(vpcCIDR, vpcPath.Child("cidr"), podsCIDR, servicesCIDR)...)
+	}
+
+	zonesPath := networksPath.Child("zones")
+	if len(infra.Networks.Zones) == 0 {
+		allErrs = append(allErrs, field.Required(zonesPath, "must specify at least one zone"))
+	}
+
+	for i, zone := range infra.Networks.Zones {
+		zonePath := zonesPath.Index(i)
+
+		if len(zone.Name) == 0 {
+			allErrs = append(allErrs, field.Required(zonePath.Child("name"), "field is required"))
+		}
+
+		allErrs = append(allErrs, validateZoneNetwork(string(zone.Worker), zonePath.Child("worker"), vpcCIDR, podsCIDR, servicesCIDR)...)
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update.
+// The VPC is immutable, and existing zones must neither be changed nor removed.
+func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisalicloud.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := field.NewPath("networks")
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.VPC, oldConfig.Networks.VPC, networksPath.Child("vpc"))...)
+
+	zonesPath := networksPath.Child("zones")
+	for _, oldZone := range oldConfig.Networks.Zones {
+		found := false
+		for i, newZone := range newConfig.Networks.Zones {
+			if newZone.Name == oldZone.Name {
+				found = true
+				allErrs = append(allErrs, apivalidation.ValidateImmutableField(newZone, oldZone, zonesPath.Index(i))...)
+				break
+			}
+		}
+		if !found {
+			allErrs = append(allErrs, field.Forbidden(zonesPath, fmt.Sprintf("zone %q must not be removed", oldZone.Name)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateZoneNetwork(cidr string, fldPath *field.Path, vpcCIDR, podsCIDR, servicesCIDR string) field.ErrorList {
+	allErrs := validateNetwork(cidr, fldPath, podsCIDR, servicesCIDR)
+	if len(vpcCIDR) > 0 {
+		allErrs = append(allErrs, extensionsvalidation.ValidateCIDRIsSubset(cidr, fldPath, vpcCIDR, "vpc cidr")...)
+	}
+	return allErrs
+}
+
+func validateNetwork(cidr string, fldPath *field.Path, podsCIDR, servicesCIDR string) field.ErrorList {
+	allErrs := extensionsvalidation.ValidateCIDR(cidr, fldPath)
+	allErrs = append(allErrs, extensionsvalidation.ValidateCIDRDisjoint(cidr, fldPath, podsCIDR, "pod network")...)
+	allErrs = append(allErrs, extensionsvalidation.ValidateCIDRDisjoint(cidr, fldPath, servicesCIDR, "service network")...)
+	return allErrs
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// maxTagKeyLength is the maximum length of a tag key of an ECS instance.
+const maxTagKeyLength = 128
+
+var (
+	availableSpotStrategies = sets.NewString(
+		string(apisalicloud.SpotStrategyNoSpot),
+		string(apisalicloud.SpotStrategySpotAsPriceGo),
+	)
+	reservedTagKeyPrefixes = []string{"kubernetes.io", "aliyun", "acs:", "http://", "https://"}
+)
+
+// ValidateWorkerConfig validates a WorkerConfig object of a worker pool.
+func ValidateWorkerConfig(workerConfig *apisalicloud.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig.SpotStrategy != nil && !availableSpotStrategies.Has(string(*workerConfig.SpotStrategy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("spotStrategy"), *workerConfig.SpotStrategy, availableSpotStrategies.List()))
+	}
+
+	tagsPath := fldPath.Child("additionalTags")
+	for key := range workerConfig.AdditionalTags {
+		switch {
+		case len(key) == 0:
+			allErrs = append(allErrs, field.Required(tagsPath, "tag keys must not be empty"))
+		case hasReservedTagKeyPrefix(key):
+			allErrs = append(allErrs, field.Forbidden(tagsPath.Key(key), "must not start with any of "+strings.Join(reservedTagKeyPrefixes, ", ")))
+		case len(key) > maxTagKeyLength:
+			allErrs = append(allErrs, field.TooLong(tagsPath.Key(key), key, maxTagKeyLength))
+		}
+	}
+
+	return allErrs
+}
+
+func hasReservedTagKeyPrefix(key string) bool {
+	for _, prefix := range reservedTagKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
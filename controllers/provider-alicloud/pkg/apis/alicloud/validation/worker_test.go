@@ -0,0 +1,77 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("WorkerConfig validation", func() {
+	var (
+		workerConfig *apisalicloud.WorkerConfig
+		fldPath      = field.NewPath("providerConfig")
+
+		spotAsPriceGo = apisalicloud.SpotStrategySpotAsPriceGo
+	)
+
+	BeforeEach(func() {
+		workerConfig = &apisalicloud.WorkerConfig{
+			SpotStrategy: &spotAsPriceGo,
+			AdditionalTags: map[string]string{
+				"team": "foo",
+			},
+		}
+	})
+
+	Describe("#ValidateWorkerConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an unsupported spot strategy", func() {
+			invalid := apisalicloud.SpotStrategy("foo")
+			workerConfig.SpotStrategy = &invalid
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.spotStrategy"),
+			}))))
+		})
+
+		It("should forbid reserved tags", func() {
+			workerConfig.AdditionalTags = map[string]string{
+				"kubernetes.io/role/worker": "1",
+				"aliyun-foo":                "bar",
+			}
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.additionalTags[kubernetes.io/role/worker]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.additionalTags[aliyun-foo]"),
+				})),
+			))
+		})
+	})
+})
@@ -0,0 +1,41 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket_test
+
+import (
+	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
+	alicloudclient "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud/client"
+	. "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/test"
+	"github.com/gardener/gardener-extensions/pkg/util/test/objectstore"
+
+	. "github.com/onsi/ginkgo"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var _ = Describe("Actuator", test.DescribeActuatorTest(test.ActuatorTestArgs{
+	Region: "cn-beijing",
+	Credentials: map[string][]byte{
+		alicloud.AccessKeyID:     []byte("access-key-id"),
+		alicloud.AccessKeySecret: []byte("access-key-secret"),
+	},
+	IncompleteCredentials: map[string][]byte{alicloud.AccessKeyID: []byte("access-key-id")},
+	NewActuator: func(store *objectstore.Store) backupbucket.Actuator {
+		return NewActuatorWithDeps(log.Log, alicloudclient.StorageFactoryFunc(func(_, _, _ string) (alicloudclient.Storage, error) {
+			return store, nil
+		}))
+	},
+}))
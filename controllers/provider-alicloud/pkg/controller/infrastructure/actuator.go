@@ -183,18 +183,31 @@ func (a *actuator) getInitializerValues(
 }
 
 func (a *actuator) newInitializer(infra *extensionsv1alpha1.Infrastructure, config *alicloudv1alpha1.InfrastructureConfig, values *InitializerValues) (extensionsterraformer.Initializer, error) {
-	chartValues := a.terraformChartOps.ComputeChartValues(infra, config, values)
-	release, err := a.chartRenderer.Render(alicloud.InfraChartPath, alicloud.InfraRelease, infra.Namespace, chartValues)
+	files, err := a.renderTerraformFiles(infra, config, values)
 	if err != nil {
 		return nil, err
 	}
 
-	files, err := chartutil.ExtractTerraformFiles(release)
+	return a.terraformerFactory.DefaultInitializer(a.client, files.Main, files.Variables, files.TFVars), nil
+}
+
+func (a *actuator) newPlanInitializer(infra *extensionsv1alpha1.Infrastructure, config *alicloudv1alpha1.InfrastructureConfig, values *InitializerValues) (extensionsterraformer.Initializer, error) {
+	files, err := a.renderTerraformFiles(infra, config, values)
 	if err != nil {
 		return nil, err
 	}
 
-	return a.terraformerFactory.DefaultInitializer(a.client, files.Main, files.Variables, files.TFVars), nil
+	return a.terraformerFactory.PlanInitializer(a.client, files.Main, files.Variables, files.TFVars), nil
+}
+
+func (a *actuator) renderTerraformFiles(infra *extensionsv1alpha1.Infrastructure, config *alicloudv1alpha1.InfrastructureConfig, values *InitializerValues) (*chartutil.TerraformFiles, error) {
+	chartValues := a.terraformChartOps.ComputeChartValues(infra, config, values)
+	release, err := a.chartRenderer.Render(alicloud.InfraChartPath, alicloud.InfraRelease, infra.Namespace, chartValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return chartutil.ExtractTerraformFiles(release)
 }
 
 func (a *actuator) newTerraformer(infra *extensionsv1alpha1.Infrastructure, credentials *alicloud.Credentials) (extensionsterraformer.Interface, error) {
@@ -312,6 +325,36 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 	})
 }
 
+// CanPlan implements infrastructure.Planner.
+func (a *actuator) CanPlan(_ *extensionsv1alpha1.Infrastructure) bool {
+	return true
+}
+
+// Plan implements infrastructure.Planner.
+func (a *actuator) Plan(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) (*extensionsterraformer.Plan, error) {
+	config, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := a.newTerraformer(infra, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	initializerValues, err := a.getInitializerValues(tf, infra, config, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	initializer, err := a.newPlanInitializer(infra, config, initializerValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return tf.InitializeWith(initializer).Plan()
+}
+
 // Delete implements infrastructure.Actuator.
 func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
 	_, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
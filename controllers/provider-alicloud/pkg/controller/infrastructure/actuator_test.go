@@ -29,6 +29,8 @@ import (
 	mockalicloudclient "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/mock/provider-alicloud/alicloud/client"
 	mockinfrastructure "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/mock/provider-alicloud/controller/infrastructure"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsinfrastructure "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
 	mockchartrenderer "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/gardener/chartrenderer"
 	mockterraformer "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/gardener/terraformer"
@@ -244,5 +246,139 @@ var _ = Describe("Actuator", func() {
 				}))
 			})
 		})
+
+		Describe("#Plan", func() {
+			It("should plan the infrastructure without applying it", func() {
+				var (
+					ctx                   = context.TODO()
+					logger                = logr.NewMockLogger(ctrl)
+					alicloudClientFactory = mockalicloudclient.NewMockFactory(ctrl)
+					vpcClient             = mockalicloudclient.NewMockVPC(ctrl)
+					terraformerFactory    = mockterraformer.NewMockFactory(ctrl)
+					terraformer           = mockterraformer.NewMockInterface(ctrl)
+					chartRendererFactory  = mockchartrenderer.NewMockFactory(ctrl)
+					terraformChartOps     = mockinfrastructure.NewMockTerraformChartOps(ctrl)
+					actuator              = NewActuatorWithDeps(logger, alicloudClientFactory, terraformerFactory, chartRendererFactory, terraformChartOps)
+					c                     = mockclient.NewMockClient(ctrl)
+					initializer           = mockterraformer.NewMockInitializer(ctrl)
+					restConfig            rest.Config
+
+					chartRenderer = mockgardenerchartrenderer.NewMockInterface(ctrl)
+
+					gardenCoreCIDR = gardencorev1alpha1.CIDR("192.168.0.0/16")
+					config         = alicloudv1alpha1.InfrastructureConfig{
+						Networks: alicloudv1alpha1.Networks{
+							VPC: alicloudv1alpha1.VPC{
+								CIDR: &gardenCoreCIDR,
+							},
+						},
+					}
+					configYAML      = ExpectEncode(runtime.Encode(serializer, &config))
+					secretNamespace = "secretns"
+					secretName      = "secret"
+					region          = "region"
+					infra           = extensionsv1alpha1.Infrastructure{
+						Spec: extensionsv1alpha1.InfrastructureSpec{
+							ProviderConfig: &runtime.RawExtension{
+								Raw: configYAML,
+							},
+							Region: region,
+							SecretRef: corev1.SecretReference{
+								Namespace: secretNamespace,
+								Name:      secretName,
+							},
+						},
+					}
+					accessKeyID     = "accessKeyID"
+					accessKeySecret = "accessKeySecret"
+					cluster         = controller.Cluster{}
+
+					initializerValues = InitializerValues{}
+					chartValues       = map[string]interface{}{}
+
+					mainContent      = "main"
+					variablesContent = "variables"
+					tfVarsContent    = "tfVars"
+
+					vpcID        = "vpcID"
+					natGatewayID = "natGatewayID"
+					plan         = &extensionsterraformer.Plan{
+						ToAdd: 1,
+						ResourceChanges: []extensionsterraformer.ResourceChange{
+							{Address: "alicloud_vpc.vpc", Action: extensionsterraformer.ChangeActionCreate},
+						},
+					}
+				)
+
+				describeNATGatewaysReq := vpc.CreateDescribeNatGatewaysRequest()
+				describeNATGatewaysReq.VpcId = vpcID
+
+				gomock.InOrder(
+					chartRendererFactory.EXPECT().NewForConfig(&restConfig).Return(chartRenderer, nil),
+
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: secretNamespace, Name: secretName}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+						SetArg(2, corev1.Secret{
+							Data: map[string][]byte{
+								alicloud.AccessKeyID:     []byte(accessKeyID),
+								alicloud.AccessKeySecret: []byte(accessKeySecret),
+							},
+						}),
+
+					terraformerFactory.EXPECT().NewForConfig(gomock.Any(), &restConfig, TerraformerPurpose, infra.Namespace, infra.Name, imagevector.TerraformerImage()).
+						Return(terraformer, nil),
+
+					terraformer.EXPECT().SetVariablesEnvironment(map[string]string{
+						common.TerraformVarAccessKeyID:     accessKeyID,
+						common.TerraformVarAccessKeySecret: accessKeySecret,
+					}).Return(terraformer),
+
+					alicloudClientFactory.EXPECT().NewVPC(region, accessKeyID, accessKeySecret).Return(vpcClient, nil),
+
+					terraformer.EXPECT().GetStateOutputVariables(TerraformerOutputKeyVPCID).
+						Return(map[string]string{
+							TerraformerOutputKeyVPCID: vpcID,
+						}, nil),
+
+					vpcClient.EXPECT().DescribeNatGateways(describeNATGatewaysReq).Return(&vpc.DescribeNatGatewaysResponse{
+						NatGateways: vpc.NatGateways{
+							NatGateway: []vpc.NatGateway{
+								{
+									NatGatewayId: natGatewayID,
+								},
+							},
+						},
+					}, nil),
+
+					terraformChartOps.EXPECT().ComputeCreateVPCInitializerValues(&config, alicloudclient.DefaultInternetChargeType).Return(&initializerValues),
+					terraformChartOps.EXPECT().ComputeChartValues(&infra, &config, &initializerValues).Return(chartValues),
+
+					chartRenderer.EXPECT().Render(
+						alicloud.InfraChartPath,
+						alicloud.InfraRelease,
+						infra.Namespace,
+						chartValues,
+					).Return(&chartrenderer.RenderedChart{
+						Manifests: []manifest.Manifest{
+							mkManifest(chart.TerraformMainTFFilename, mainContent),
+							mkManifest(chart.TerraformVariablesTFFilename, variablesContent),
+							mkManifest(chart.TerraformTFVarsFilename, tfVarsContent),
+						},
+					}, nil),
+
+					terraformerFactory.EXPECT().PlanInitializer(c, mainContent, variablesContent, []byte(tfVarsContent)).Return(initializer),
+
+					terraformer.EXPECT().InitializeWith(initializer).Return(terraformer),
+
+					terraformer.EXPECT().Plan().Return(plan, nil),
+				)
+
+				ExpectInject(inject.ClientInto(c, actuator))
+				ExpectInject(inject.SchemeInto(scheme, actuator))
+				ExpectInject(inject.ConfigInto(&restConfig, actuator))
+
+				Expect(actuator.(extensionsinfrastructure.Planner).Plan(ctx, &infra, &cluster)).To(Equal(plan))
+				Expect(infra.Status.ProviderStatus).To(BeNil())
+			})
+		})
 	})
 })
@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -103,8 +104,16 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	deploymentNames, err := worker.NewDeploymentNames(ctx, w.client, w.worker.Namespace)
+	if err != nil {
+		return err
+	}
+
 	for _, pool := range w.worker.Spec.Pools {
-		zoneLen := len(pool.Zones)
+		zoneDistribution, err := worker.NewZoneDistribution(pool.Zones, pool.Annotations)
+		if err != nil {
+			return errors.Wrapf(err, "invalid zone weights of worker pool '%s'", pool.Name)
+		}
 
 		machineImage, err := confighelper.FindImage(w.machineImages, pool.MachineImage.Name, pool.MachineImage.Version)
 		if err != nil {
@@ -116,7 +125,27 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			return err
 		}
 
-		for zoneIndex, zone := range pool.Zones {
+		workerConfig := &alicloudapi.WorkerConfig{}
+		if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
+			if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+				return errors.Wrapf(err, "could not decode provider config of worker pool '%s'", pool.Name)
+			}
+		}
+
+		spotStrategy := alicloudapi.SpotStrategyNoSpot
+		if workerConfig.SpotStrategy != nil {
+			spotStrategy = *workerConfig.SpotStrategy
+		}
+
+		tags := map[string]string{
+			fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace):     "1",
+			fmt.Sprintf("kubernetes.io/role/worker/%s", w.worker.Namespace): "1",
+		}
+		for key, value := range workerConfig.AdditionalTags {
+			tags[key] = value
+		}
+
+		for _, zone := range pool.Zones {
 			nodesVSwitch, err := alicloudapihelper.FindVSwitchForPurposeAndZone(infrastructureStatus.VPC.VSwitches, alicloudapi.PurposeNodes, zone)
 			if err != nil {
 				return err
@@ -137,11 +166,8 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				"internetChargeType":      "PayByTraffic",
 				"internetMaxBandwidthIn":  5,
 				"internetMaxBandwidthOut": 5,
-				"spotStrategy":            "NoSpot",
-				"tags": map[string]string{
-					fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace):     "1",
-					fmt.Sprintf("kubernetes.io/role/worker/%s", w.worker.Namespace): "1",
-				},
+				"spotStrategy":            string(spotStrategy),
+				"tags":                    tags,
 				"secret": map[string]interface{}{
 					"userData": string(pool.UserData),
 				},
@@ -150,18 +176,20 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 			var (
 				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-				deploymentName       = fmt.Sprintf("%s-%s-%s", w.worker.Namespace, pool.Name, zone)
+				deploymentName       = deploymentNames.Name(pool.Name, pool.Zones, zone)
 				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
 				Name:           deploymentName,
+				PoolName:       pool.Name,
+				Zone:           zone,
 				ClassName:      className,
 				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
+				Minimum:        zoneDistribution.Distribute(zone, pool.Minimum),
+				Maximum:        zoneDistribution.Distribute(zone, pool.Maximum),
+				MaxSurge:       zoneDistribution.DistributePositiveIntOrPercent(zone, pool.MaxSurge, pool.Maximum),
+				MaxUnavailable: zoneDistribution.DistributePositiveIntOrPercent(zone, pool.MaxUnavailable, pool.Minimum),
 				Labels:         pool.Labels,
 				Annotations:    pool.Annotations,
 				Taints:         pool.Taints,
@@ -54,6 +54,8 @@ var _ = Describe("Machines", func() {
 		ctrl = gomock.NewController(GinkgoT())
 
 		c = mockclient.NewMockClient(ctrl)
+		// There are no existing machine deployments whose names must be kept.
+		c.EXPECT().List(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{})).AnyTimes()
 		chartApplier = mockkubernetes.NewMockChartApplier(ctrl)
 	})
 
@@ -359,6 +361,8 @@ var _ = Describe("Machines", func() {
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1Zone1,
+						PoolName:       namePool1,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool1Zone1,
 						SecretName:     machineClassWithHashPool1Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool1, 2),
@@ -368,6 +372,8 @@ var _ = Describe("Machines", func() {
 					},
 					{
 						Name:           machineClassNamePool1Zone2,
+						PoolName:       namePool1,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool1Zone2,
 						SecretName:     machineClassWithHashPool1Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool1, 2),
@@ -377,6 +383,8 @@ var _ = Describe("Machines", func() {
 					},
 					{
 						Name:           machineClassNamePool2Zone1,
+						PoolName:       namePool2,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool2Zone1,
 						SecretName:     machineClassWithHashPool2Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool2, 2),
@@ -386,6 +394,8 @@ var _ = Describe("Machines", func() {
 					},
 					{
 						Name:           machineClassNamePool2Zone2,
+						PoolName:       namePool2,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool2Zone2,
 						SecretName:     machineClassWithHashPool2Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool2, 2),
@@ -400,6 +410,84 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should return the expected machine classes for a pool with provider config", func() {
+				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+
+				spotAsPriceGo := apisalicloud.SpotStrategySpotAsPriceGo
+
+				w.Spec.Pools = w.Spec.Pools[:1]
+				w.Spec.Pools[0].Zones = []string{zone1}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisalicloud.WorkerConfig{
+						SpotStrategy: &spotAsPriceGo,
+						AdditionalTags: map[string]string{
+							"team": "foo",
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				var (
+					machineClass = map[string]interface{}{
+						"imageID":         machineImageID,
+						"instanceType":    machineType,
+						"region":          region,
+						"zoneID":          zone1,
+						"securityGroupID": securityGroupID,
+						"vSwitchID":       vswitchZone1,
+						"systemDisk": map[string]interface{}{
+							"category": volumeType,
+							"size":     volumeSize,
+						},
+						"instanceChargeType":      instanceChargeType,
+						"internetChargeType":      internetChargeType,
+						"internetMaxBandwidthIn":  internetMaxBandwidthIn,
+						"internetMaxBandwidthOut": internetMaxBandwidthOut,
+						"spotStrategy":            string(spotAsPriceGo),
+						"tags": map[string]string{
+							fmt.Sprintf("kubernetes.io/cluster/%s", namespace):     "1",
+							fmt.Sprintf("kubernetes.io/role/worker/%s", namespace): "1",
+							"team": "foo",
+						},
+						"secret": map[string]interface{}{
+							"userData": string(userData),
+						},
+						"keyPairName": keyName,
+					}
+
+					machineClassName     = fmt.Sprintf("%s-%s-%s", namespace, namePool1, zone1)
+					machineClassWithHash = fmt.Sprintf("%s-%s", machineClassName, worker.MachineClassHash(machineClass, shootVersionMajorMinor))
+				)
+
+				addNameAndSecretToMachineClass(machineClass, alicloudAccessKeyID, alicloudAccessKeySecret, machineClassWithHash)
+
+				chartApplier.
+					EXPECT().
+					ApplyChart(
+						context.TODO(),
+						filepath.Join(alicloud.InternalChartsPath, "machineclass"),
+						namespace,
+						"machineclass",
+						map[string]interface{}{"machineClasses": []map[string]interface{}{machineClass}},
+						nil,
+					).
+					Return(nil)
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+			})
+
+			It("should fail because the provider config cannot be decoded", func() {
+				expectGetSecretCallToWork(c, alicloudAccessKeyID, alicloudAccessKeySecret)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte("not-decodeable")}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -19,6 +19,9 @@ package imagevector
 import (
 	"strings"
 
+	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
+	"github.com/gardener/gardener-extensions/pkg/util/chart"
+
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gobuffalo/packr/v2"
 	"k8s.io/apimachinery/pkg/util/runtime"
@@ -32,7 +35,8 @@ const (
 var imageVector imagevector.ImageVector
 
 func init() {
-	box := packr.New("charts", "../../charts")
+	box := packr.New("provider-alicloud-charts", "../../charts")
+	chart.RegisterSource(chart.NewBoxSource(alicloud.ChartsPath, box))
 
 	imagesYaml, err := box.FindString("images.yaml")
 	runtime.Must(err)
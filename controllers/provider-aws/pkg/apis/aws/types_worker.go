@@ -0,0 +1,64 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains configuration settings for the machines of a worker pool.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// Volume contains additional settings for the root volume of the machines.
+	Volume *Volume
+	// DataVolumes is a list of additional EBS volumes attached to the machines.
+	DataVolumes []DataVolume
+	// SpotPrice is the maximum price per hour for a spot instance. If set, spot instances are requested
+	// for the machines. An empty string means the on-demand price is used as maximum price.
+	SpotPrice *string
+	// AdditionalSecurityGroupIDs is a list of security group ids that are attached to the machines in
+	// addition to the nodes security group.
+	AdditionalSecurityGroupIDs []string
+}
+
+// Volume contains additional settings for the root volume.
+type Volume struct {
+	// IOPS is the number of I/O operations per second provisioned for the volume.
+	// It is only supported for volumes of type 'io1'.
+	IOPS *int64
+	// Encrypted indicates whether the volume is encrypted.
+	Encrypted *bool
+}
+
+// DataVolume contains the settings of an additional EBS volume.
+type DataVolume struct {
+	// Name is the name of the data volume.
+	Name string
+	// Size is the size of the data volume, e.g. 50Gi.
+	Size string
+	// Type is the EBS volume type. Defaults to the volume type of the worker pool.
+	Type *string
+	// IOPS is the number of I/O operations per second provisioned for the volume.
+	// It is only supported for volumes of type 'io1'.
+	IOPS *int64
+	// Encrypted indicates whether the volume is encrypted.
+	Encrypted *bool
+}
+
+// VolumeTypeIO1 is the EBS volume type that supports provisioned IOPS.
+const VolumeTypeIO1 = "io1"
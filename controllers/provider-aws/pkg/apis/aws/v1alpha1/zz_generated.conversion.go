@@ -57,6 +57,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*aws.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataVolume_To_aws_DataVolume(a.(*DataVolume), b.(*aws.DataVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.DataVolume)(nil), (*DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_DataVolume_To_v1alpha1_DataVolume(a.(*aws.DataVolume), b.(*DataVolume), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EC2)(nil), (*aws.EC2)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_EC2_To_aws_EC2(a.(*EC2), b.(*aws.EC2), scope)
 	}); err != nil {
@@ -167,6 +177,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Volume)(nil), (*aws.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Volume_To_aws_Volume(a.(*Volume), b.(*aws.Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Volume_To_v1alpha1_Volume(a.(*aws.Volume), b.(*Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*aws.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(a.(*WorkerConfig), b.(*aws.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*aws.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Zone)(nil), (*aws.Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Zone_To_aws_Zone(a.(*Zone), b.(*aws.Zone), scope)
 	}); err != nil {
@@ -220,6 +250,34 @@ func Convert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *aws.Contr
 	return autoConvert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_DataVolume_To_aws_DataVolume(in *DataVolume, out *aws.DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Size = in.Size
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	return nil
+}
+
+// Convert_v1alpha1_DataVolume_To_aws_DataVolume is an autogenerated conversion function.
+func Convert_v1alpha1_DataVolume_To_aws_DataVolume(in *DataVolume, out *aws.DataVolume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataVolume_To_aws_DataVolume(in, out, s)
+}
+
+func autoConvert_aws_DataVolume_To_v1alpha1_DataVolume(in *aws.DataVolume, out *DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Size = in.Size
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	return nil
+}
+
+// Convert_aws_DataVolume_To_v1alpha1_DataVolume is an autogenerated conversion function.
+func Convert_aws_DataVolume_To_v1alpha1_DataVolume(in *aws.DataVolume, out *DataVolume, s conversion.Scope) error {
+	return autoConvert_aws_DataVolume_To_v1alpha1_DataVolume(in, out, s)
+}
+
 func autoConvert_v1alpha1_EC2_To_aws_EC2(in *EC2, out *aws.EC2, s conversion.Scope) error {
 	out.KeyName = in.KeyName
 	return nil
@@ -484,6 +542,54 @@ func Convert_aws_VPCStatus_To_v1alpha1_VPCStatus(in *aws.VPCStatus, out *VPCStat
 	return autoConvert_aws_VPCStatus_To_v1alpha1_VPCStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_Volume_To_aws_Volume(in *Volume, out *aws.Volume, s conversion.Scope) error {
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	return nil
+}
+
+// Convert_v1alpha1_Volume_To_aws_Volume is an autogenerated conversion function.
+func Convert_v1alpha1_Volume_To_aws_Volume(in *Volume, out *aws.Volume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Volume_To_aws_Volume(in, out, s)
+}
+
+func autoConvert_aws_Volume_To_v1alpha1_Volume(in *aws.Volume, out *Volume, s conversion.Scope) error {
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	return nil
+}
+
+// Convert_aws_Volume_To_v1alpha1_Volume is an autogenerated conversion function.
+func Convert_aws_Volume_To_v1alpha1_Volume(in *aws.Volume, out *Volume, s conversion.Scope) error {
+	return autoConvert_aws_Volume_To_v1alpha1_Volume(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(in *WorkerConfig, out *aws.WorkerConfig, s conversion.Scope) error {
+	out.Volume = (*aws.Volume)(unsafe.Pointer(in.Volume))
+	out.DataVolumes = *(*[]aws.DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.SpotPrice = (*string)(unsafe.Pointer(in.SpotPrice))
+	out.AdditionalSecurityGroupIDs = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroupIDs))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_aws_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(in *WorkerConfig, out *aws.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(in, out, s)
+}
+
+func autoConvert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(in *aws.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.Volume = (*Volume)(unsafe.Pointer(in.Volume))
+	out.DataVolumes = *(*[]DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.SpotPrice = (*string)(unsafe.Pointer(in.SpotPrice))
+	out.AdditionalSecurityGroupIDs = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroupIDs))
+	return nil
+}
+
+// Convert_aws_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(in *aws.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Zone_To_aws_Zone(in *Zone, out *aws.Zone, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Internal = core.CIDR(in.Internal)
@@ -0,0 +1,201 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	const (
+		podsCIDR     = "100.96.0.0/11"
+		servicesCIDR = "100.64.0.0/13"
+	)
+
+	var (
+		infrastructureConfig *apisaws.InfrastructureConfig
+
+		vpcID   = "vpc-123456"
+		vpcCIDR = gardencore.CIDR("10.0.0.0/8")
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisaws.InfrastructureConfig{
+			Networks: apisaws.Networks{
+				VPC: apisaws.VPC{
+					CIDR: &vpcCIDR,
+				},
+				Zones: []apisaws.Zone{
+					{
+						Name:     "eu-west-1a",
+						Internal: "10.250.112.0/22",
+						Public:   "10.250.96.0/22",
+						Workers:  "10.250.0.0/19",
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(BeEmpty())
+		})
+
+		It("should allow an existing vpc", func() {
+			infrastructureConfig.Networks.VPC = apisaws.VPC{ID: &vpcID}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(BeEmpty())
+		})
+
+		It("should forbid specifying neither a vpc id nor a cidr", func() {
+			infrastructureConfig.Networks.VPC = apisaws.VPC{}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc"),
+			}))))
+		})
+
+		It("should forbid specifying both a vpc id and a cidr", func() {
+			infrastructureConfig.Networks.VPC.ID = &vpcID
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc"),
+			}))))
+		})
+
+		It("should forbid an empty list of zones", func() {
+			infrastructureConfig.Networks.Zones = nil
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.zones"),
+			}))))
+		})
+
+		It("should forbid invalid zone CIDRs", func() {
+			infrastructureConfig.Networks.Zones[0].Name = ""
+			infrastructureConfig.Networks.Zones[0].Workers = "not-a-cidr"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.zones[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].workers"),
+				})),
+			))
+		})
+
+		It("should forbid zone CIDRs outside of the vpc CIDR", func() {
+			infrastructureConfig.Networks.Zones[0].Public = "192.168.0.0/24"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].public"),
+			}))))
+		})
+
+		It("should forbid CIDRs overlapping with the pod and service networks", func() {
+			overlappingCIDR := gardencore.CIDR("100.64.0.0/10")
+			infrastructureConfig.Networks.VPC.CIDR = &overlappingCIDR
+			infrastructureConfig.Networks.Zones[0].Internal = "100.96.0.0/22"
+			infrastructureConfig.Networks.Zones[0].Public = "100.64.0.0/22"
+			infrastructureConfig.Networks.Zones[0].Workers = "100.65.0.0/19"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpc.cidr"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpc.cidr"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].internal"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].public"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].workers"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should allow an unchanged configuration", func() {
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, infrastructureConfig.DeepCopy())).To(BeEmpty())
+		})
+
+		It("should allow adding a zone", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = append(newInfrastructureConfig.Networks.Zones, apisaws.Zone{
+				Name:     "eu-west-1b",
+				Internal: "10.250.116.0/22",
+				Public:   "10.250.100.0/22",
+				Workers:  "10.250.32.0/19",
+			})
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid changing the vpc", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC = apisaws.VPC{ID: &vpcID}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc"),
+			}))))
+		})
+
+		It("should forbid changing an existing zone", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[0].Workers = "10.250.0.0/20"
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0]"),
+			}))))
+		})
+
+		It("should forbid removing an existing zone", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = nil
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.zones"),
+			}))))
+		})
+	})
+})
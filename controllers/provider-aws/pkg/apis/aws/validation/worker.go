@@ -0,0 +1,101 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"strconv"
+
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// MaxDataVolumes is the maximum number of data volumes per worker pool (one per device name /dev/sd[f-p]).
+const MaxDataVolumes = 11
+
+// ValidateWorkerConfig validates a WorkerConfig object of a worker pool with the given volume type.
+func ValidateWorkerConfig(workerConfig *apisaws.WorkerConfig, volumeType string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig.Volume != nil {
+		allErrs = append(allErrs, validateIOPS(workerConfig.Volume.IOPS, volumeType, fldPath.Child("volume", "iops"))...)
+	}
+
+	dataVolumesPath := fldPath.Child("dataVolumes")
+	if len(workerConfig.DataVolumes) > MaxDataVolumes {
+		allErrs = append(allErrs, field.Invalid(dataVolumesPath, len(workerConfig.DataVolumes), fmt.Sprintf("must not contain more than %d data volumes", MaxDataVolumes)))
+	}
+
+	names := sets.NewString()
+	for i, dataVolume := range workerConfig.DataVolumes {
+		dataVolumePath := dataVolumesPath.Index(i)
+
+		if len(dataVolume.Name) == 0 {
+			allErrs = append(allErrs, field.Required(dataVolumePath.Child("name"), "field is required"))
+		} else if names.Has(dataVolume.Name) {
+			allErrs = append(allErrs, field.Duplicate(dataVolumePath.Child("name"), dataVolume.Name))
+		} else {
+			names.Insert(dataVolume.Name)
+		}
+
+		if size, err := resource.ParseQuantity(dataVolume.Size); err != nil {
+			allErrs = append(allErrs, field.Invalid(dataVolumePath.Child("size"), dataVolume.Size, fmt.Sprintf("must be a valid quantity: %v", err)))
+		} else if size.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(dataVolumePath.Child("size"), dataVolume.Size, "must be greater than 0"))
+		}
+
+		dataVolumeType := volumeType
+		if dataVolume.Type != nil {
+			dataVolumeType = *dataVolume.Type
+			if len(dataVolumeType) == 0 {
+				allErrs = append(allErrs, field.Required(dataVolumePath.Child("type"), "must not be empty if set"))
+			}
+		}
+		allErrs = append(allErrs, validateIOPS(dataVolume.IOPS, dataVolumeType, dataVolumePath.Child("iops"))...)
+	}
+
+	if workerConfig.SpotPrice != nil && len(*workerConfig.SpotPrice) > 0 {
+		if price, err := strconv.ParseFloat(*workerConfig.SpotPrice, 64); err != nil || price <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("spotPrice"), *workerConfig.SpotPrice, "must be a positive decimal number"))
+		}
+	}
+
+	for i, id := range workerConfig.AdditionalSecurityGroupIDs {
+		if len(id) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("additionalSecurityGroupIDs").Index(i), "must not be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateIOPS(iops *int64, volumeType string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if iops == nil {
+		return allErrs
+	}
+	if volumeType != apisaws.VolumeTypeIO1 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("is only supported for volumes of type %q", apisaws.VolumeTypeIO1)))
+	}
+	if *iops <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *iops, "must be greater than 0"))
+	}
+
+	return allErrs
+}
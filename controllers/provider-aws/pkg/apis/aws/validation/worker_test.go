@@ -0,0 +1,129 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("WorkerConfig validation", func() {
+	var (
+		workerConfig *apisaws.WorkerConfig
+		fldPath      = field.NewPath("providerConfig")
+
+		iops      = int64(1000)
+		encrypted = true
+		gp2       = "gp2"
+		io1       = apisaws.VolumeTypeIO1
+		spotPrice = "0.05"
+	)
+
+	BeforeEach(func() {
+		workerConfig = &apisaws.WorkerConfig{
+			Volume: &apisaws.Volume{
+				IOPS:      &iops,
+				Encrypted: &encrypted,
+			},
+			DataVolumes: []apisaws.DataVolume{
+				{
+					Name:      "data",
+					Size:      "50Gi",
+					Type:      &gp2,
+					Encrypted: &encrypted,
+				},
+			},
+			SpotPrice:                  &spotPrice,
+			AdditionalSecurityGroupIDs: []string{"sg-123456"},
+		}
+	})
+
+	Describe("#ValidateWorkerConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateWorkerConfig(workerConfig, io1, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow an empty spot price", func() {
+			empty := ""
+			workerConfig.SpotPrice = &empty
+
+			Expect(ValidateWorkerConfig(workerConfig, io1, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid iops for volume types other than io1", func() {
+			workerConfig.DataVolumes[0].IOPS = &iops
+
+			Expect(ValidateWorkerConfig(workerConfig, gp2, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.volume.iops"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.dataVolumes[0].iops"),
+				})),
+			))
+		})
+
+		It("should forbid invalid data volumes", func() {
+			workerConfig.DataVolumes = append(workerConfig.DataVolumes, apisaws.DataVolume{Name: "data", Size: "not-a-size"})
+
+			Expect(ValidateWorkerConfig(workerConfig, io1, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("providerConfig.dataVolumes[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.dataVolumes[1].size"),
+				})),
+			))
+		})
+
+		It("should forbid too many data volumes", func() {
+			workerConfig.DataVolumes = nil
+			for i := 0; i <= MaxDataVolumes; i++ {
+				workerConfig.DataVolumes = append(workerConfig.DataVolumes, apisaws.DataVolume{Name: string(rune('a' + i)), Size: "10Gi"})
+			}
+
+			Expect(ValidateWorkerConfig(workerConfig, io1, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.dataVolumes"),
+			}))))
+		})
+
+		It("should forbid an invalid spot price and empty security group ids", func() {
+			invalidSpotPrice := "-1"
+			workerConfig.SpotPrice = &invalidSpotPrice
+			workerConfig.AdditionalSecurityGroupIDs = []string{""}
+
+			Expect(ValidateWorkerConfig(workerConfig, io1, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.spotPrice"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("providerConfig.additionalSecurityGroupIDs[0]"),
+				})),
+			))
+		})
+	})
+})
@@ -24,12 +24,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
 
 // NewClient creates a new Client for the given AWS credentials <accessKeyID>, <secretAccessKey>, and
 // the AWS region <region>.
-// It initializes the clients for the various services like EC2, ELB, etc.
+// It initializes the clients for the various services like EC2, ELB, S3, etc.
 func NewClient(accessKeyID, secretAccessKey, region string) (Interface, error) {
 	var (
 		awsConfig = &aws.Config{
@@ -46,10 +48,17 @@ func NewClient(accessKeyID, secretAccessKey, region string) (Interface, error) {
 	return &Client{
 		EC2: ec2.New(s, config),
 		ELB: elb.New(s, config),
+		IAM: iam.New(s, config),
+		S3:  s3.New(s, config),
 		STS: sts.New(s, config),
 	}, nil
 }
 
+// DefaultFactory returns the default Factory that creates Clients with NewClient.
+func DefaultFactory() Factory {
+	return FactoryFunc(NewClient)
+}
+
 // GetAccountID returns the ID of the AWS account the Client is interacting with.
 func (c *Client) GetAccountID(ctx context.Context) (string, error) {
 	getCallerIdentityOutput, err := c.STS.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
@@ -172,3 +181,118 @@ func (c *Client) DeleteSecurityGroup(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+// CreateBucketIfNotExists creates the s3 bucket with name <bucket> in <region>. If it already exists,
+// no error is returned. The bucket is configured with default server-side encryption and a lifecycle
+// rule that aborts incomplete multipart uploads. Snapshots are deliberately not expired by a lifecycle
+// rule: their retention is up to the garbage collection of the etcd backup sidecar, and an expiration
+// could delete the full snapshot that newer delta snapshots are based on.
+func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket, region string) error {
+	createBucketInput := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		ACL:    aws.String(s3.BucketCannedACLPrivate),
+	}
+	// The us-east-1 region is the default location of buckets and must not be given as location constraint.
+	if region != "us-east-1" {
+		createBucketInput.CreateBucketConfiguration = &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String(region),
+		}
+	}
+
+	if _, err := c.S3.CreateBucketWithContext(ctx, createBucketInput); err != nil {
+		if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != s3.ErrCodeBucketAlreadyOwnedByYou {
+			return err
+		}
+	}
+
+	if _, err := c.S3.PutBucketEncryptionWithContext(ctx, &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: []*s3.ServerSideEncryptionRule{
+				{
+					ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
+						SSEAlgorithm: aws.String(s3.ServerSideEncryptionAes256),
+					},
+				},
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	_, err := c.S3.PutBucketLifecycleConfigurationWithContext(ctx, &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: []*s3.LifecycleRule{
+				{
+					ID:     aws.String("abort-incomplete-multipart-uploads"),
+					Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+					Status: aws.String(s3.ExpirationStatusEnabled),
+					AbortIncompleteMultipartUpload: &s3.AbortIncompleteMultipartUpload{
+						DaysAfterInitiation: aws.Int64(7),
+					},
+				},
+			},
+		},
+	})
+	return err
+}
+
+// DeleteBucketIfExists deletes the s3 bucket with name <bucket> including all objects stored in it.
+// If it does not exist, no error is returned.
+func (c *Client) DeleteBucketIfExists(ctx context.Context, bucket string) error {
+	if err := c.DeleteObjectsWithPrefix(ctx, bucket, ""); err != nil {
+		return err
+	}
+
+	if _, err := c.S3.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)}); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteObjectsWithPrefix deletes all objects in the s3 bucket <bucket> whose key starts with <prefix>.
+// If the bucket does not exist, no error is returned.
+func (c *Client) DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error {
+	var deleteErr error
+	if err := c.S3.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if len(page.Contents) == 0 {
+			return !lastPage
+		}
+
+		objectIDs := make([]*s3.ObjectIdentifier, 0, len(page.Contents))
+		for _, object := range page.Contents {
+			objectIDs = append(objectIDs, &s3.ObjectIdentifier{Key: object.Key})
+		}
+
+		var output *s3.DeleteObjectsOutput
+		if output, deleteErr = c.S3.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objectIDs,
+				Quiet:   aws.Bool(true),
+			},
+		}); deleteErr != nil {
+			return false
+		}
+		// S3 reports objects that could not be deleted only in the output, not as error of the request.
+		if len(output.Errors) > 0 {
+			deleteErr = fmt.Errorf("could not delete %d objects of bucket %s, first error for object %s: %s",
+				len(output.Errors), bucket, aws.StringValue(output.Errors[0].Key), aws.StringValue(output.Errors[0].Message))
+			return false
+		}
+		return !lastPage
+	}); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket {
+			return nil
+		}
+		return err
+	}
+	return deleteErr
+}
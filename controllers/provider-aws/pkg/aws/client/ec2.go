@@ -0,0 +1,755 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"golang.org/x/crypto/ssh"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// CreateDhcpOptions creates the given DHCP options and tags them with their tags.
+func (c *Client) CreateDhcpOptions(ctx context.Context, options *DhcpOptions) (*DhcpOptions, error) {
+	var keys []string
+	for key := range options.DhcpConfigurations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var configurations []*ec2.NewDhcpConfiguration
+	for _, key := range keys {
+		configurations = append(configurations, &ec2.NewDhcpConfiguration{
+			Key:    aws.String(key),
+			Values: aws.StringSlice(options.DhcpConfigurations[key]),
+		})
+	}
+
+	output, err := c.EC2.CreateDhcpOptionsWithContext(ctx, &ec2.CreateDhcpOptionsInput{DhcpConfigurations: configurations})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.createEC2Tags(ctx, *output.DhcpOptions.DhcpOptionsId, options.Tags); err != nil {
+		return nil, err
+	}
+
+	created := fromDhcpOptions(output.DhcpOptions)
+	created.Tags = options.Tags.Clone()
+	return created, nil
+}
+
+// FindDhcpOptionsByTags returns all DHCP options that are tagged with all of the given <tags>.
+func (c *Client) FindDhcpOptionsByTags(ctx context.Context, tags Tags) ([]*DhcpOptions, error) {
+	output, err := c.EC2.DescribeDhcpOptionsWithContext(ctx, &ec2.DescribeDhcpOptionsInput{Filters: tagFilters(tags)})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*DhcpOptions
+	for _, options := range output.DhcpOptions {
+		results = append(results, fromDhcpOptions(options))
+	}
+	return results, nil
+}
+
+// DeleteDhcpOptions deletes the DHCP options with the given <id>. If they do not exist, no error is returned.
+func (c *Client) DeleteDhcpOptions(ctx context.Context, id string) error {
+	_, err := c.EC2.DeleteDhcpOptionsWithContext(ctx, &ec2.DeleteDhcpOptionsInput{DhcpOptionsId: aws.String(id)})
+	return ignoreNotFound(err)
+}
+
+// CreateVpc creates the given VPC and tags it with its tags.
+func (c *Client) CreateVpc(ctx context.Context, vpc *VPC) (*VPC, error) {
+	output, err := c.EC2.CreateVpcWithContext(ctx, &ec2.CreateVpcInput{CidrBlock: aws.String(vpc.CidrBlock)})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.createEC2Tags(ctx, *output.Vpc.VpcId, vpc.Tags); err != nil {
+		return nil, err
+	}
+
+	created := fromVpc(output.Vpc)
+	created.Tags = vpc.Tags.Clone()
+	return created, nil
+}
+
+// FindVpcsByTags returns all VPCs that are tagged with all of the given <tags>.
+func (c *Client) FindVpcsByTags(ctx context.Context, tags Tags) ([]*VPC, error) {
+	output, err := c.EC2.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{Filters: tagFilters(tags)})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*VPC
+	for _, vpc := range output.Vpcs {
+		results = append(results, fromVpc(vpc))
+	}
+	return results, nil
+}
+
+// UpdateVpcAttribute sets the boolean attribute <attributeName> of the VPC with the given <vpcID> to <value>.
+// Supported attributes are ec2.VpcAttributeNameEnableDnsSupport and ec2.VpcAttributeNameEnableDnsHostnames.
+func (c *Client) UpdateVpcAttribute(ctx context.Context, vpcID, attributeName string, value bool) error {
+	input := &ec2.ModifyVpcAttributeInput{VpcId: aws.String(vpcID)}
+	switch attributeName {
+	case ec2.VpcAttributeNameEnableDnsSupport:
+		input.EnableDnsSupport = &ec2.AttributeBooleanValue{Value: aws.Bool(value)}
+	case ec2.VpcAttributeNameEnableDnsHostnames:
+		input.EnableDnsHostnames = &ec2.AttributeBooleanValue{Value: aws.Bool(value)}
+	default:
+		return fmt.Errorf("unsupported VPC attribute %q", attributeName)
+	}
+
+	_, err := c.EC2.ModifyVpcAttributeWithContext(ctx, input)
+	return err
+}
+
+// AssociateDhcpOptions associates the DHCP options with the given <dhcpOptionsID> with the VPC with the given <vpcID>.
+func (c *Client) AssociateDhcpOptions(ctx context.Context, vpcID, dhcpOptionsID string) error {
+	_, err := c.EC2.AssociateDhcpOptionsWithContext(ctx, &ec2.AssociateDhcpOptionsInput{
+		VpcId:         aws.String(vpcID),
+		DhcpOptionsId: aws.String(dhcpOptionsID),
+	})
+	return err
+}
+
+// DeleteVpc deletes the VPC with the given <id>. If it does not exist, no error is returned.
+func (c *Client) DeleteVpc(ctx context.Context, id string) error {
+	_, err := c.EC2.DeleteVpcWithContext(ctx, &ec2.DeleteVpcInput{VpcId: aws.String(id)})
+	return ignoreNotFound(err)
+}
+
+// CreateInternetGateway creates the given internet gateway and tags it with its tags.
+// The gateway is not attached to any VPC.
+func (c *Client) CreateInternetGateway(ctx context.Context, gateway *InternetGateway) (*InternetGateway, error) {
+	output, err := c.EC2.CreateInternetGatewayWithContext(ctx, &ec2.CreateInternetGatewayInput{})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.createEC2Tags(ctx, *output.InternetGateway.InternetGatewayId, gateway.Tags); err != nil {
+		return nil, err
+	}
+
+	created := fromInternetGateway(output.InternetGateway)
+	created.Tags = gateway.Tags.Clone()
+	return created, nil
+}
+
+// FindInternetGatewaysByTags returns all internet gateways that are tagged with all of the given <tags>.
+func (c *Client) FindInternetGatewaysByTags(ctx context.Context, tags Tags) ([]*InternetGateway, error) {
+	output, err := c.EC2.DescribeInternetGatewaysWithContext(ctx, &ec2.DescribeInternetGatewaysInput{Filters: tagFilters(tags)})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*InternetGateway
+	for _, gateway := range output.InternetGateways {
+		results = append(results, fromInternetGateway(gateway))
+	}
+	return results, nil
+}
+
+// AttachInternetGateway attaches the internet gateway with the given <internetGatewayID> to the VPC with the given <vpcID>.
+func (c *Client) AttachInternetGateway(ctx context.Context, vpcID, internetGatewayID string) error {
+	_, err := c.EC2.AttachInternetGatewayWithContext(ctx, &ec2.AttachInternetGatewayInput{
+		VpcId:             aws.String(vpcID),
+		InternetGatewayId: aws.String(internetGatewayID),
+	})
+	return err
+}
+
+// DetachInternetGateway detaches the internet gateway with the given <internetGatewayID> from the VPC with
+// the given <vpcID>. If the gateway is not attached, no error is returned.
+func (c *Client) DetachInternetGateway(ctx context.Context, vpcID, internetGatewayID string) error {
+	_, err := c.EC2.DetachInternetGatewayWithContext(ctx, &ec2.DetachInternetGatewayInput{
+		VpcId:             aws.String(vpcID),
+		InternetGatewayId: aws.String(internetGatewayID),
+	})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "Gateway.NotAttached" {
+		return nil
+	}
+	return ignoreNotFound(err)
+}
+
+// DeleteInternetGateway deletes the internet gateway with the given <id>. If it does not exist, no error is returned.
+func (c *Client) DeleteInternetGateway(ctx context.Context, id string) error {
+	_, err := c.EC2.DeleteInternetGatewayWithContext(ctx, &ec2.DeleteInternetGatewayInput{InternetGatewayId: aws.String(id)})
+	return ignoreNotFound(err)
+}
+
+// CreateSubnet creates the given subnet and tags it with its tags.
+func (c *Client) CreateSubnet(ctx context.Context, subnet *Subnet) (*Subnet, error) {
+	output, err := c.EC2.CreateSubnetWithContext(ctx, &ec2.CreateSubnetInput{
+		VpcId:            aws.String(subnet.VpcID),
+		CidrBlock:        aws.String(subnet.CidrBlock),
+		AvailabilityZone: aws.String(subnet.AvailabilityZone),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.createEC2Tags(ctx, *output.Subnet.SubnetId, subnet.Tags); err != nil {
+		return nil, err
+	}
+
+	created := fromSubnet(output.Subnet)
+	created.Tags = subnet.Tags.Clone()
+	return created, nil
+}
+
+// FindSubnetsByTags returns all subnets that are tagged with all of the given <tags>.
+func (c *Client) FindSubnetsByTags(ctx context.Context, tags Tags) ([]*Subnet, error) {
+	output, err := c.EC2.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{Filters: tagFilters(tags)})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*Subnet
+	for _, subnet := range output.Subnets {
+		results = append(results, fromSubnet(subnet))
+	}
+	return results, nil
+}
+
+// DeleteSubnet deletes the subnet with the given <id>. If it does not exist, no error is returned.
+func (c *Client) DeleteSubnet(ctx context.Context, id string) error {
+	_, err := c.EC2.DeleteSubnetWithContext(ctx, &ec2.DeleteSubnetInput{SubnetId: aws.String(id)})
+	return ignoreNotFound(err)
+}
+
+// CreateRouteTable creates the given route table and tags it with its tags.
+func (c *Client) CreateRouteTable(ctx context.Context, routeTable *RouteTable) (*RouteTable, error) {
+	output, err := c.EC2.CreateRouteTableWithContext(ctx, &ec2.CreateRouteTableInput{VpcId: aws.String(routeTable.VpcID)})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.createEC2Tags(ctx, *output.RouteTable.RouteTableId, routeTable.Tags); err != nil {
+		return nil, err
+	}
+
+	created := fromRouteTable(output.RouteTable)
+	created.Tags = routeTable.Tags.Clone()
+	return created, nil
+}
+
+// FindRouteTablesByTags returns all route tables that are tagged with all of the given <tags>.
+func (c *Client) FindRouteTablesByTags(ctx context.Context, tags Tags) ([]*RouteTable, error) {
+	output, err := c.EC2.DescribeRouteTablesWithContext(ctx, &ec2.DescribeRouteTablesInput{Filters: tagFilters(tags)})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*RouteTable
+	for _, routeTable := range output.RouteTables {
+		results = append(results, fromRouteTable(routeTable))
+	}
+	return results, nil
+}
+
+// CreateRoute creates the given <route> in the route table with the given <routeTableID>.
+func (c *Client) CreateRoute(ctx context.Context, routeTableID string, route *Route) error {
+	input := &ec2.CreateRouteInput{
+		RouteTableId:         aws.String(routeTableID),
+		DestinationCidrBlock: aws.String(route.DestinationCidrBlock),
+	}
+	if route.GatewayID != "" {
+		input.GatewayId = aws.String(route.GatewayID)
+	}
+	if route.NatGatewayID != "" {
+		input.NatGatewayId = aws.String(route.NatGatewayID)
+	}
+
+	_, err := c.EC2.CreateRouteWithContext(ctx, input)
+	return err
+}
+
+// DeleteRoute deletes the route to the destination of the given <route> from the route table with the
+// given <routeTableID>. If it does not exist, no error is returned.
+func (c *Client) DeleteRoute(ctx context.Context, routeTableID string, route *Route) error {
+	_, err := c.EC2.DeleteRouteWithContext(ctx, &ec2.DeleteRouteInput{
+		RouteTableId:         aws.String(routeTableID),
+		DestinationCidrBlock: aws.String(route.DestinationCidrBlock),
+	})
+	return ignoreNotFound(err)
+}
+
+// AssociateRouteTable associates the route table with the given <routeTableID> with the subnet with the given
+// <subnetID>. It returns the id of the association.
+func (c *Client) AssociateRouteTable(ctx context.Context, routeTableID, subnetID string) (string, error) {
+	output, err := c.EC2.AssociateRouteTableWithContext(ctx, &ec2.AssociateRouteTableInput{
+		RouteTableId: aws.String(routeTableID),
+		SubnetId:     aws.String(subnetID),
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(output.AssociationId), nil
+}
+
+// DisassociateRouteTable deletes the route table association with the given <associationID>. If it does not
+// exist, no error is returned.
+func (c *Client) DisassociateRouteTable(ctx context.Context, associationID string) error {
+	_, err := c.EC2.DisassociateRouteTableWithContext(ctx, &ec2.DisassociateRouteTableInput{AssociationId: aws.String(associationID)})
+	return ignoreNotFound(err)
+}
+
+// DeleteRouteTable deletes the route table with the given <id>. If it does not exist, no error is returned.
+func (c *Client) DeleteRouteTable(ctx context.Context, id string) error {
+	_, err := c.EC2.DeleteRouteTableWithContext(ctx, &ec2.DeleteRouteTableInput{RouteTableId: aws.String(id)})
+	return ignoreNotFound(err)
+}
+
+// CreateSecurityGroup creates the given security group and tags it with its tags. The rules of the given
+// group are ignored, they have to be added with AuthorizeSecurityGroupRules.
+func (c *Client) CreateSecurityGroup(ctx context.Context, group *SecurityGroup) (*SecurityGroup, error) {
+	output, err := c.EC2.CreateSecurityGroupWithContext(ctx, &ec2.CreateSecurityGroupInput{
+		GroupName:   aws.String(group.GroupName),
+		Description: aws.String(group.Description),
+		VpcId:       aws.String(group.VpcID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.createEC2Tags(ctx, *output.GroupId, group.Tags); err != nil {
+		return nil, err
+	}
+
+	groups, err := c.EC2.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{GroupIds: []*string{output.GroupId}})
+	if err != nil {
+		return nil, err
+	}
+	if len(groups.SecurityGroups) == 0 {
+		return nil, fmt.Errorf("security group %s not found after creation", *output.GroupId)
+	}
+	return fromSecurityGroup(groups.SecurityGroups[0]), nil
+}
+
+// FindSecurityGroupsByTags returns all security groups including their rules that are tagged with all of the given <tags>.
+func (c *Client) FindSecurityGroupsByTags(ctx context.Context, tags Tags) ([]*SecurityGroup, error) {
+	output, err := c.EC2.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{Filters: tagFilters(tags)})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*SecurityGroup
+	for _, group := range output.SecurityGroups {
+		results = append(results, fromSecurityGroup(group))
+	}
+	return results, nil
+}
+
+// AuthorizeSecurityGroupRules adds the given <rules> to the security group with the given <id>.
+func (c *Client) AuthorizeSecurityGroupRules(ctx context.Context, id string, rules []*SecurityGroupRule) error {
+	ingress, egress := toIPPermissions(rules)
+	if len(ingress) > 0 {
+		if _, err := c.EC2.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{GroupId: aws.String(id), IpPermissions: ingress}); err != nil {
+			return err
+		}
+	}
+	if len(egress) > 0 {
+		if _, err := c.EC2.AuthorizeSecurityGroupEgressWithContext(ctx, &ec2.AuthorizeSecurityGroupEgressInput{GroupId: aws.String(id), IpPermissions: egress}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RevokeSecurityGroupRules removes the given <rules> from the security group with the given <id>.
+func (c *Client) RevokeSecurityGroupRules(ctx context.Context, id string, rules []*SecurityGroupRule) error {
+	ingress, egress := toIPPermissions(rules)
+	if len(ingress) > 0 {
+		if _, err := c.EC2.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{GroupId: aws.String(id), IpPermissions: ingress}); err != nil {
+			return err
+		}
+	}
+	if len(egress) > 0 {
+		if _, err := c.EC2.RevokeSecurityGroupEgressWithContext(ctx, &ec2.RevokeSecurityGroupEgressInput{GroupId: aws.String(id), IpPermissions: egress}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateElasticIP allocates a new elastic IP address for use in a VPC and tags it with the tags of the given <eip>.
+func (c *Client) CreateElasticIP(ctx context.Context, eip *ElasticIP) (*ElasticIP, error) {
+	output, err := c.EC2.AllocateAddressWithContext(ctx, &ec2.AllocateAddressInput{Domain: aws.String(ec2.DomainTypeVpc)})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.createEC2Tags(ctx, *output.AllocationId, eip.Tags); err != nil {
+		return nil, err
+	}
+
+	return &ElasticIP{
+		AllocationID: aws.StringValue(output.AllocationId),
+		PublicIP:     aws.StringValue(output.PublicIp),
+		Tags:         eip.Tags.Clone(),
+	}, nil
+}
+
+// FindElasticIPsByTags returns all elastic IP addresses that are tagged with all of the given <tags>.
+func (c *Client) FindElasticIPsByTags(ctx context.Context, tags Tags) ([]*ElasticIP, error) {
+	output, err := c.EC2.DescribeAddressesWithContext(ctx, &ec2.DescribeAddressesInput{Filters: tagFilters(tags)})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*ElasticIP
+	for _, address := range output.Addresses {
+		results = append(results, &ElasticIP{
+			AllocationID: aws.StringValue(address.AllocationId),
+			PublicIP:     aws.StringValue(address.PublicIp),
+			Tags:         fromEC2Tags(address.Tags),
+		})
+	}
+	return results, nil
+}
+
+// DeleteElasticIP releases the elastic IP address with the given <allocationID>. If it does not exist,
+// no error is returned.
+func (c *Client) DeleteElasticIP(ctx context.Context, allocationID string) error {
+	_, err := c.EC2.ReleaseAddressWithContext(ctx, &ec2.ReleaseAddressInput{AllocationId: aws.String(allocationID)})
+	return ignoreNotFound(err)
+}
+
+// CreateNATGateway creates the given NAT gateway and tags it with its tags. It does not wait until the
+// gateway is available.
+func (c *Client) CreateNATGateway(ctx context.Context, gateway *NATGateway) (*NATGateway, error) {
+	output, err := c.EC2.CreateNatGatewayWithContext(ctx, &ec2.CreateNatGatewayInput{
+		AllocationId: aws.String(gateway.EIPAllocationID),
+		SubnetId:     aws.String(gateway.SubnetID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.createEC2Tags(ctx, *output.NatGateway.NatGatewayId, gateway.Tags); err != nil {
+		return nil, err
+	}
+
+	created := fromNATGateway(output.NatGateway)
+	created.Tags = gateway.Tags.Clone()
+	return created, nil
+}
+
+// FindNATGatewaysByTags returns all NAT gateways that are tagged with all of the given <tags> and that are
+// neither deleted nor failed.
+func (c *Client) FindNATGatewaysByTags(ctx context.Context, tags Tags) ([]*NATGateway, error) {
+	filters := append(tagFilters(tags), &ec2.Filter{
+		Name:   aws.String("state"),
+		Values: aws.StringSlice([]string{ec2.NatGatewayStatePending, ec2.NatGatewayStateAvailable}),
+	})
+
+	var results []*NATGateway
+	if err := c.EC2.DescribeNatGatewaysPagesWithContext(ctx, &ec2.DescribeNatGatewaysInput{Filter: filters}, func(page *ec2.DescribeNatGatewaysOutput, _ bool) bool {
+		for _, gateway := range page.NatGateways {
+			results = append(results, fromNATGateway(gateway))
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// WaitForNATGatewayAvailable waits until the NAT gateway with the given <id> is available.
+func (c *Client) WaitForNATGatewayAvailable(ctx context.Context, id string) error {
+	return c.EC2.WaitUntilNatGatewayAvailableWithContext(ctx, &ec2.DescribeNatGatewaysInput{NatGatewayIds: []*string{aws.String(id)}})
+}
+
+// DeleteNATGateway deletes the NAT gateway with the given <id> and waits until it is deleted. If it does
+// not exist, no error is returned.
+func (c *Client) DeleteNATGateway(ctx context.Context, id string) error {
+	if _, err := c.EC2.DeleteNatGatewayWithContext(ctx, &ec2.DeleteNatGatewayInput{NatGatewayId: aws.String(id)}); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NatGatewayNotFound" {
+			return nil
+		}
+		return err
+	}
+
+	return wait.PollImmediateUntil(5*time.Second, func() (bool, error) {
+		output, err := c.EC2.DescribeNatGatewaysWithContext(ctx, &ec2.DescribeNatGatewaysInput{NatGatewayIds: []*string{aws.String(id)}})
+		if err != nil {
+			return false, err
+		}
+		for _, gateway := range output.NatGateways {
+			if aws.StringValue(gateway.State) != ec2.NatGatewayStateDeleted {
+				return false, nil
+			}
+		}
+		return true, nil
+	}, ctx.Done())
+}
+
+// ImportKeyPair imports the given <publicKey> as EC2 key pair with the given <keyName>.
+func (c *Client) ImportKeyPair(ctx context.Context, keyName, publicKey string) (*KeyPair, error) {
+	output, err := c.EC2.ImportKeyPairWithContext(ctx, &ec2.ImportKeyPairInput{
+		KeyName:           aws.String(keyName),
+		PublicKeyMaterial: []byte(publicKey),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPair{
+		KeyName:        aws.StringValue(output.KeyName),
+		KeyFingerprint: aws.StringValue(output.KeyFingerprint),
+	}, nil
+}
+
+// GetKeyPair returns the EC2 key pair with the given <keyName>.
+func (c *Client) GetKeyPair(ctx context.Context, keyName string) (*KeyPair, error) {
+	output, err := c.EC2.DescribeKeyPairsWithContext(ctx, &ec2.DescribeKeyPairsInput{KeyNames: []*string{aws.String(keyName)}})
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	if len(output.KeyPairs) == 0 {
+		return nil, nil
+	}
+	return &KeyPair{
+		KeyName:        aws.StringValue(output.KeyPairs[0].KeyName),
+		KeyFingerprint: aws.StringValue(output.KeyPairs[0].KeyFingerprint),
+	}, nil
+}
+
+// DeleteKeyPair deletes the EC2 key pair with the given <keyName>. If it does not exist, no error is returned.
+func (c *Client) DeleteKeyPair(ctx context.Context, keyName string) error {
+	_, err := c.EC2.DeleteKeyPairWithContext(ctx, &ec2.DeleteKeyPairInput{KeyName: aws.String(keyName)})
+	return ignoreNotFound(err)
+}
+
+// KeyPairFingerprint computes the fingerprint EC2 reports for a key pair imported from the given SSH
+// <publicKey> in authorized_keys format, i.e. the colon-separated MD5 hash of the DER encoded public key.
+func KeyPairFingerprint(publicKey string) (string, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", err
+	}
+	cryptoKey, ok := key.(ssh.CryptoPublicKey)
+	if !ok {
+		return "", fmt.Errorf("unsupported SSH public key type %s", key.Type())
+	}
+	der, err := x509.MarshalPKIXPublicKey(cryptoKey.CryptoPublicKey())
+	if err != nil {
+		return "", err
+	}
+
+	var parts []string
+	for _, b := range md5.Sum(der) {
+		parts = append(parts, fmt.Sprintf("%02x", b))
+	}
+	return strings.Join(parts, ":"), nil
+}
+
+func (c *Client) createEC2Tags(ctx context.Context, id string, tags Tags) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	var ec2Tags []*ec2.Tag
+	for key, value := range tags {
+		ec2Tags = append(ec2Tags, &ec2.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+
+	_, err := c.EC2.CreateTagsWithContext(ctx, &ec2.CreateTagsInput{
+		Resources: []*string{aws.String(id)},
+		Tags:      ec2Tags,
+	})
+	return err
+}
+
+func tagFilters(tags Tags) []*ec2.Filter {
+	var filters []*ec2.Filter
+	for key, value := range tags {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String(fmt.Sprintf("tag:%s", key)),
+			Values: []*string{aws.String(value)},
+		})
+	}
+	return filters
+}
+
+func fromEC2Tags(ec2Tags []*ec2.Tag) Tags {
+	tags := Tags{}
+	for _, tag := range ec2Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tags
+}
+
+func fromDhcpOptions(options *ec2.DhcpOptions) *DhcpOptions {
+	configurations := map[string][]string{}
+	for _, configuration := range options.DhcpConfigurations {
+		var values []string
+		for _, value := range configuration.Values {
+			values = append(values, aws.StringValue(value.Value))
+		}
+		configurations[aws.StringValue(configuration.Key)] = values
+	}
+
+	return &DhcpOptions{
+		DhcpOptionsID:      aws.StringValue(options.DhcpOptionsId),
+		DhcpConfigurations: configurations,
+		Tags:               fromEC2Tags(options.Tags),
+	}
+}
+
+func fromVpc(vpc *ec2.Vpc) *VPC {
+	return &VPC{
+		VpcID:         aws.StringValue(vpc.VpcId),
+		CidrBlock:     aws.StringValue(vpc.CidrBlock),
+		DhcpOptionsID: aws.StringValue(vpc.DhcpOptionsId),
+		Tags:          fromEC2Tags(vpc.Tags),
+	}
+}
+
+func fromInternetGateway(gateway *ec2.InternetGateway) *InternetGateway {
+	result := &InternetGateway{
+		InternetGatewayID: aws.StringValue(gateway.InternetGatewayId),
+		Tags:              fromEC2Tags(gateway.Tags),
+	}
+	for _, attachment := range gateway.Attachments {
+		result.VpcID = aws.StringValue(attachment.VpcId)
+	}
+	return result
+}
+
+func fromSubnet(subnet *ec2.Subnet) *Subnet {
+	return &Subnet{
+		SubnetID:         aws.StringValue(subnet.SubnetId),
+		VpcID:            aws.StringValue(subnet.VpcId),
+		CidrBlock:        aws.StringValue(subnet.CidrBlock),
+		AvailabilityZone: aws.StringValue(subnet.AvailabilityZone),
+		Tags:             fromEC2Tags(subnet.Tags),
+	}
+}
+
+func fromRouteTable(routeTable *ec2.RouteTable) *RouteTable {
+	result := &RouteTable{
+		RouteTableID: aws.StringValue(routeTable.RouteTableId),
+		VpcID:        aws.StringValue(routeTable.VpcId),
+		Tags:         fromEC2Tags(routeTable.Tags),
+	}
+	for _, route := range routeTable.Routes {
+		if route.DestinationCidrBlock == nil {
+			continue
+		}
+		result.Routes = append(result.Routes, &Route{
+			DestinationCidrBlock: aws.StringValue(route.DestinationCidrBlock),
+			GatewayID:            aws.StringValue(route.GatewayId),
+			NatGatewayID:         aws.StringValue(route.NatGatewayId),
+		})
+	}
+	for _, association := range routeTable.Associations {
+		if association.SubnetId == nil {
+			continue
+		}
+		result.Associations = append(result.Associations, &RouteTableAssociation{
+			RouteTableAssociationID: aws.StringValue(association.RouteTableAssociationId),
+			SubnetID:                aws.StringValue(association.SubnetId),
+		})
+	}
+	return result
+}
+
+func fromSecurityGroup(group *ec2.SecurityGroup) *SecurityGroup {
+	result := &SecurityGroup{
+		GroupID:     aws.StringValue(group.GroupId),
+		GroupName:   aws.StringValue(group.GroupName),
+		VpcID:       aws.StringValue(group.VpcId),
+		Description: aws.StringValue(group.Description),
+		Tags:        fromEC2Tags(group.Tags),
+	}
+	result.Rules = append(result.Rules, fromIPPermissions(SecurityGroupRuleTypeIngress, group.IpPermissions)...)
+	result.Rules = append(result.Rules, fromIPPermissions(SecurityGroupRuleTypeEgress, group.IpPermissionsEgress)...)
+	return result
+}
+
+func fromIPPermissions(ruleType SecurityGroupRuleType, permissions []*ec2.IpPermission) []*SecurityGroupRule {
+	var rules []*SecurityGroupRule
+	for _, permission := range permissions {
+		newRule := func() *SecurityGroupRule {
+			return &SecurityGroupRule{
+				Type:     ruleType,
+				Protocol: aws.StringValue(permission.IpProtocol),
+				FromPort: aws.Int64Value(permission.FromPort),
+				ToPort:   aws.Int64Value(permission.ToPort),
+			}
+		}
+		for _, ipRange := range permission.IpRanges {
+			rule := newRule()
+			rule.CidrBlock = aws.StringValue(ipRange.CidrIp)
+			rules = append(rules, rule)
+		}
+		for _, pair := range permission.UserIdGroupPairs {
+			rule := newRule()
+			rule.SourceSecurityGroupID = aws.StringValue(pair.GroupId)
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func toIPPermissions(rules []*SecurityGroupRule) (ingress, egress []*ec2.IpPermission) {
+	for _, rule := range rules {
+		permission := &ec2.IpPermission{
+			IpProtocol: aws.String(rule.Protocol),
+			FromPort:   aws.Int64(rule.FromPort),
+			ToPort:     aws.Int64(rule.ToPort),
+		}
+		if rule.CidrBlock != "" {
+			permission.IpRanges = []*ec2.IpRange{{CidrIp: aws.String(rule.CidrBlock)}}
+		}
+		if rule.SourceSecurityGroupID != "" {
+			permission.UserIdGroupPairs = []*ec2.UserIdGroupPair{{GroupId: aws.String(rule.SourceSecurityGroupID)}}
+		}
+
+		if rule.Type == SecurityGroupRuleTypeEgress {
+			egress = append(egress, permission)
+		} else {
+			ingress = append(ingress, permission)
+		}
+	}
+	return ingress, egress
+}
+
+func fromNATGateway(gateway *ec2.NatGateway) *NATGateway {
+	result := &NATGateway{
+		NATGatewayID: aws.StringValue(gateway.NatGatewayId),
+		SubnetID:     aws.StringValue(gateway.SubnetId),
+		State:        aws.StringValue(gateway.State),
+		Tags:         fromEC2Tags(gateway.Tags),
+	}
+	for _, address := range gateway.NatGatewayAddresses {
+		result.EIPAllocationID = aws.StringValue(address.AllocationId)
+	}
+	return result
+}
+
+// ignoreNotFound returns nil if the given error is an AWS error with a "NotFound" error code.
+func ignoreNotFound(err error) error {
+	if aerr, ok := err.(awserr.Error); ok && strings.HasSuffix(aerr.Code(), ".NotFound") {
+		return nil
+	}
+	return err
+}
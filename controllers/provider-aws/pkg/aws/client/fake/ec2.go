@@ -0,0 +1,783 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+)
+
+// EC2 is an in-memory store of EC2 resources. It mimics the behaviour of EC2 as far as it is relevant
+// for callers, e.g. deleting a VPC fails as long as it contains subnets.
+type EC2 struct {
+	lock   sync.Mutex
+	nextID int
+
+	DhcpOptions      map[string]*awsclient.DhcpOptions
+	Vpcs             map[string]*awsclient.VPC
+	InternetGateways map[string]*awsclient.InternetGateway
+	Subnets          map[string]*awsclient.Subnet
+	RouteTables      map[string]*awsclient.RouteTable
+	SecurityGroups   map[string]*awsclient.SecurityGroup
+	ElasticIPs       map[string]*awsclient.ElasticIP
+	NATGateways      map[string]*awsclient.NATGateway
+	KeyPairs         map[string]*awsclient.KeyPair
+
+	// VpcAttributes contains the boolean attributes of the VPCs by VPC id and attribute name.
+	VpcAttributes map[string]map[string]bool
+}
+
+// NewEC2 creates a new empty EC2 store.
+func NewEC2() *EC2 {
+	return &EC2{
+		DhcpOptions:      map[string]*awsclient.DhcpOptions{},
+		Vpcs:             map[string]*awsclient.VPC{},
+		InternetGateways: map[string]*awsclient.InternetGateway{},
+		Subnets:          map[string]*awsclient.Subnet{},
+		RouteTables:      map[string]*awsclient.RouteTable{},
+		SecurityGroups:   map[string]*awsclient.SecurityGroup{},
+		ElasticIPs:       map[string]*awsclient.ElasticIP{},
+		NATGateways:      map[string]*awsclient.NATGateway{},
+		KeyPairs:         map[string]*awsclient.KeyPair{},
+		VpcAttributes:    map[string]map[string]bool{},
+	}
+}
+
+func (e *EC2) newID(prefix string) string {
+	e.nextID++
+	return fmt.Sprintf("%s-%d", prefix, e.nextID)
+}
+
+// CreateDhcpOptions implements awsclient.Interface.
+func (c *Client) CreateDhcpOptions(_ context.Context, options *awsclient.DhcpOptions) (*awsclient.DhcpOptions, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	created := copyDhcpOptions(options)
+	created.DhcpOptionsID = c.EC2.newID("dopt")
+	c.EC2.DhcpOptions[created.DhcpOptionsID] = created
+	return copyDhcpOptions(created), nil
+}
+
+// FindDhcpOptionsByTags implements awsclient.Interface.
+func (c *Client) FindDhcpOptionsByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.DhcpOptions, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	var results []*awsclient.DhcpOptions
+	for _, options := range c.EC2.DhcpOptions {
+		if options.Tags.Contains(tags) {
+			results = append(results, copyDhcpOptions(options))
+		}
+	}
+	return results, nil
+}
+
+// DeleteDhcpOptions implements awsclient.Interface.
+func (c *Client) DeleteDhcpOptions(_ context.Context, id string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	for _, vpc := range c.EC2.Vpcs {
+		if vpc.DhcpOptionsID == id {
+			return dependencyViolation("DHCP options %s are associated with VPC %s", id, vpc.VpcID)
+		}
+	}
+	delete(c.EC2.DhcpOptions, id)
+	return nil
+}
+
+// CreateVpc implements awsclient.Interface.
+func (c *Client) CreateVpc(_ context.Context, vpc *awsclient.VPC) (*awsclient.VPC, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	created := copyVpc(vpc)
+	created.VpcID = c.EC2.newID("vpc")
+	created.DhcpOptionsID = "default"
+	c.EC2.Vpcs[created.VpcID] = created
+	c.EC2.VpcAttributes[created.VpcID] = map[string]bool{}
+	return copyVpc(created), nil
+}
+
+// FindVpcsByTags implements awsclient.Interface.
+func (c *Client) FindVpcsByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.VPC, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	var results []*awsclient.VPC
+	for _, vpc := range c.EC2.Vpcs {
+		if vpc.Tags.Contains(tags) {
+			results = append(results, copyVpc(vpc))
+		}
+	}
+	return results, nil
+}
+
+// UpdateVpcAttribute implements awsclient.Interface.
+func (c *Client) UpdateVpcAttribute(_ context.Context, vpcID, attributeName string, value bool) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	attributes, ok := c.EC2.VpcAttributes[vpcID]
+	if !ok {
+		return notFound("VPC %s", vpcID)
+	}
+	attributes[attributeName] = value
+	return nil
+}
+
+// AssociateDhcpOptions implements awsclient.Interface.
+func (c *Client) AssociateDhcpOptions(_ context.Context, vpcID, dhcpOptionsID string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	vpc, ok := c.EC2.Vpcs[vpcID]
+	if !ok {
+		return notFound("VPC %s", vpcID)
+	}
+	if _, ok := c.EC2.DhcpOptions[dhcpOptionsID]; !ok && dhcpOptionsID != "default" {
+		return notFound("DHCP options %s", dhcpOptionsID)
+	}
+	vpc.DhcpOptionsID = dhcpOptionsID
+	return nil
+}
+
+// DeleteVpc implements awsclient.Interface.
+func (c *Client) DeleteVpc(_ context.Context, id string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	for _, subnet := range c.EC2.Subnets {
+		if subnet.VpcID == id {
+			return dependencyViolation("VPC %s contains subnet %s", id, subnet.SubnetID)
+		}
+	}
+	for _, gateway := range c.EC2.InternetGateways {
+		if gateway.VpcID == id {
+			return dependencyViolation("VPC %s has internet gateway %s attached", id, gateway.InternetGatewayID)
+		}
+	}
+	for _, routeTable := range c.EC2.RouteTables {
+		if routeTable.VpcID == id {
+			return dependencyViolation("VPC %s contains route table %s", id, routeTable.RouteTableID)
+		}
+	}
+	for _, group := range c.EC2.SecurityGroups {
+		if group.VpcID == id {
+			return dependencyViolation("VPC %s contains security group %s", id, group.GroupID)
+		}
+	}
+	delete(c.EC2.Vpcs, id)
+	delete(c.EC2.VpcAttributes, id)
+	return nil
+}
+
+// GetInternetGateway implements awsclient.Interface.
+func (c *Client) GetInternetGateway(_ context.Context, vpcID string) (string, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	for _, gateway := range c.EC2.InternetGateways {
+		if gateway.VpcID == vpcID {
+			return gateway.InternetGatewayID, nil
+		}
+	}
+	return "", nil
+}
+
+// CreateInternetGateway implements awsclient.Interface.
+func (c *Client) CreateInternetGateway(_ context.Context, gateway *awsclient.InternetGateway) (*awsclient.InternetGateway, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	created := copyInternetGateway(gateway)
+	created.InternetGatewayID = c.EC2.newID("igw")
+	created.VpcID = ""
+	c.EC2.InternetGateways[created.InternetGatewayID] = created
+	return copyInternetGateway(created), nil
+}
+
+// FindInternetGatewaysByTags implements awsclient.Interface.
+func (c *Client) FindInternetGatewaysByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.InternetGateway, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	var results []*awsclient.InternetGateway
+	for _, gateway := range c.EC2.InternetGateways {
+		if gateway.Tags.Contains(tags) {
+			results = append(results, copyInternetGateway(gateway))
+		}
+	}
+	return results, nil
+}
+
+// AttachInternetGateway implements awsclient.Interface.
+func (c *Client) AttachInternetGateway(_ context.Context, vpcID, internetGatewayID string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	gateway, ok := c.EC2.InternetGateways[internetGatewayID]
+	if !ok {
+		return notFound("internet gateway %s", internetGatewayID)
+	}
+	if _, ok := c.EC2.Vpcs[vpcID]; !ok {
+		return notFound("VPC %s", vpcID)
+	}
+	if gateway.VpcID != "" {
+		return fmt.Errorf("internet gateway %s is already attached to VPC %s", internetGatewayID, gateway.VpcID)
+	}
+	gateway.VpcID = vpcID
+	return nil
+}
+
+// DetachInternetGateway implements awsclient.Interface.
+func (c *Client) DetachInternetGateway(_ context.Context, vpcID, internetGatewayID string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	if gateway, ok := c.EC2.InternetGateways[internetGatewayID]; ok && gateway.VpcID == vpcID {
+		gateway.VpcID = ""
+	}
+	return nil
+}
+
+// DeleteInternetGateway implements awsclient.Interface.
+func (c *Client) DeleteInternetGateway(_ context.Context, id string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	if gateway, ok := c.EC2.InternetGateways[id]; ok && gateway.VpcID != "" {
+		return dependencyViolation("internet gateway %s is attached to VPC %s", id, gateway.VpcID)
+	}
+	delete(c.EC2.InternetGateways, id)
+	return nil
+}
+
+// CreateSubnet implements awsclient.Interface.
+func (c *Client) CreateSubnet(_ context.Context, subnet *awsclient.Subnet) (*awsclient.Subnet, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	if _, ok := c.EC2.Vpcs[subnet.VpcID]; !ok {
+		return nil, notFound("VPC %s", subnet.VpcID)
+	}
+	created := copySubnet(subnet)
+	created.SubnetID = c.EC2.newID("subnet")
+	c.EC2.Subnets[created.SubnetID] = created
+	return copySubnet(created), nil
+}
+
+// FindSubnetsByTags implements awsclient.Interface.
+func (c *Client) FindSubnetsByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.Subnet, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	var results []*awsclient.Subnet
+	for _, subnet := range c.EC2.Subnets {
+		if subnet.Tags.Contains(tags) {
+			results = append(results, copySubnet(subnet))
+		}
+	}
+	return results, nil
+}
+
+// DeleteSubnet implements awsclient.Interface.
+func (c *Client) DeleteSubnet(_ context.Context, id string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	for _, gateway := range c.EC2.NATGateways {
+		if gateway.SubnetID == id {
+			return dependencyViolation("subnet %s contains NAT gateway %s", id, gateway.NATGatewayID)
+		}
+	}
+	for _, routeTable := range c.EC2.RouteTables {
+		for _, association := range routeTable.Associations {
+			if association.SubnetID == id {
+				return dependencyViolation("subnet %s is associated with route table %s", id, routeTable.RouteTableID)
+			}
+		}
+	}
+	delete(c.EC2.Subnets, id)
+	return nil
+}
+
+// CreateRouteTable implements awsclient.Interface.
+func (c *Client) CreateRouteTable(_ context.Context, routeTable *awsclient.RouteTable) (*awsclient.RouteTable, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	vpc, ok := c.EC2.Vpcs[routeTable.VpcID]
+	if !ok {
+		return nil, notFound("VPC %s", routeTable.VpcID)
+	}
+	created := copyRouteTable(routeTable)
+	created.RouteTableID = c.EC2.newID("rtb")
+	created.Routes = []*awsclient.Route{{DestinationCidrBlock: vpc.CidrBlock, GatewayID: "local"}}
+	created.Associations = nil
+	c.EC2.RouteTables[created.RouteTableID] = created
+	return copyRouteTable(created), nil
+}
+
+// FindRouteTablesByTags implements awsclient.Interface.
+func (c *Client) FindRouteTablesByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.RouteTable, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	var results []*awsclient.RouteTable
+	for _, routeTable := range c.EC2.RouteTables {
+		if routeTable.Tags.Contains(tags) {
+			results = append(results, copyRouteTable(routeTable))
+		}
+	}
+	return results, nil
+}
+
+// CreateRoute implements awsclient.Interface.
+func (c *Client) CreateRoute(_ context.Context, routeTableID string, route *awsclient.Route) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	routeTable, ok := c.EC2.RouteTables[routeTableID]
+	if !ok {
+		return notFound("route table %s", routeTableID)
+	}
+	for _, existing := range routeTable.Routes {
+		if existing.DestinationCidrBlock == route.DestinationCidrBlock {
+			return fmt.Errorf("route to %s already exists in route table %s", route.DestinationCidrBlock, routeTableID)
+		}
+	}
+	if route.GatewayID != "" {
+		if _, ok := c.EC2.InternetGateways[route.GatewayID]; !ok {
+			return notFound("internet gateway %s", route.GatewayID)
+		}
+	}
+	if route.NatGatewayID != "" {
+		if _, ok := c.EC2.NATGateways[route.NatGatewayID]; !ok {
+			return notFound("NAT gateway %s", route.NatGatewayID)
+		}
+	}
+	copied := *route
+	routeTable.Routes = append(routeTable.Routes, &copied)
+	return nil
+}
+
+// DeleteRoute implements awsclient.Interface.
+func (c *Client) DeleteRoute(_ context.Context, routeTableID string, route *awsclient.Route) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	routeTable, ok := c.EC2.RouteTables[routeTableID]
+	if !ok {
+		return nil
+	}
+	var routes []*awsclient.Route
+	for _, existing := range routeTable.Routes {
+		if existing.DestinationCidrBlock != route.DestinationCidrBlock {
+			routes = append(routes, existing)
+		}
+	}
+	routeTable.Routes = routes
+	return nil
+}
+
+// AssociateRouteTable implements awsclient.Interface.
+func (c *Client) AssociateRouteTable(_ context.Context, routeTableID, subnetID string) (string, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	routeTable, ok := c.EC2.RouteTables[routeTableID]
+	if !ok {
+		return "", notFound("route table %s", routeTableID)
+	}
+	if _, ok := c.EC2.Subnets[subnetID]; !ok {
+		return "", notFound("subnet %s", subnetID)
+	}
+	for _, other := range c.EC2.RouteTables {
+		for _, association := range other.Associations {
+			if association.SubnetID == subnetID {
+				return "", fmt.Errorf("subnet %s is already associated with route table %s", subnetID, other.RouteTableID)
+			}
+		}
+	}
+	association := &awsclient.RouteTableAssociation{
+		RouteTableAssociationID: c.EC2.newID("rtbassoc"),
+		SubnetID:                subnetID,
+	}
+	routeTable.Associations = append(routeTable.Associations, association)
+	return association.RouteTableAssociationID, nil
+}
+
+// DisassociateRouteTable implements awsclient.Interface.
+func (c *Client) DisassociateRouteTable(_ context.Context, associationID string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	for _, routeTable := range c.EC2.RouteTables {
+		var associations []*awsclient.RouteTableAssociation
+		for _, association := range routeTable.Associations {
+			if association.RouteTableAssociationID != associationID {
+				associations = append(associations, association)
+			}
+		}
+		routeTable.Associations = associations
+	}
+	return nil
+}
+
+// DeleteRouteTable implements awsclient.Interface.
+func (c *Client) DeleteRouteTable(_ context.Context, id string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	if routeTable, ok := c.EC2.RouteTables[id]; ok && len(routeTable.Associations) > 0 {
+		return dependencyViolation("route table %s has associations", id)
+	}
+	delete(c.EC2.RouteTables, id)
+	return nil
+}
+
+// CreateSecurityGroup implements awsclient.Interface.
+func (c *Client) CreateSecurityGroup(_ context.Context, group *awsclient.SecurityGroup) (*awsclient.SecurityGroup, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	if _, ok := c.EC2.Vpcs[group.VpcID]; !ok {
+		return nil, notFound("VPC %s", group.VpcID)
+	}
+	for _, existing := range c.EC2.SecurityGroups {
+		if existing.VpcID == group.VpcID && existing.GroupName == group.GroupName {
+			return nil, fmt.Errorf("security group %s already exists in VPC %s", group.GroupName, group.VpcID)
+		}
+	}
+	created := copySecurityGroup(group)
+	created.GroupID = c.EC2.newID("sg")
+	// Like EC2, new security groups allow all outbound traffic.
+	created.Rules = []*awsclient.SecurityGroupRule{{Type: awsclient.SecurityGroupRuleTypeEgress, Protocol: "-1", CidrBlock: "0.0.0.0/0"}}
+	c.EC2.SecurityGroups[created.GroupID] = created
+	return copySecurityGroup(created), nil
+}
+
+// FindSecurityGroupsByTags implements awsclient.Interface.
+func (c *Client) FindSecurityGroupsByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.SecurityGroup, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	var results []*awsclient.SecurityGroup
+	for _, group := range c.EC2.SecurityGroups {
+		if group.Tags.Contains(tags) {
+			results = append(results, copySecurityGroup(group))
+		}
+	}
+	return results, nil
+}
+
+// AuthorizeSecurityGroupRules implements awsclient.Interface.
+func (c *Client) AuthorizeSecurityGroupRules(_ context.Context, id string, rules []*awsclient.SecurityGroupRule) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	group, ok := c.EC2.SecurityGroups[id]
+	if !ok {
+		return notFound("security group %s", id)
+	}
+	for _, rule := range rules {
+		for _, existing := range group.Rules {
+			if *existing == *rule {
+				return fmt.Errorf("rule %+v already exists in security group %s", *rule, id)
+			}
+		}
+		copied := *rule
+		group.Rules = append(group.Rules, &copied)
+	}
+	return nil
+}
+
+// RevokeSecurityGroupRules implements awsclient.Interface.
+func (c *Client) RevokeSecurityGroupRules(_ context.Context, id string, rules []*awsclient.SecurityGroupRule) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	group, ok := c.EC2.SecurityGroups[id]
+	if !ok {
+		return notFound("security group %s", id)
+	}
+	var remaining []*awsclient.SecurityGroupRule
+outer:
+	for _, existing := range group.Rules {
+		for _, rule := range rules {
+			if *existing == *rule {
+				continue outer
+			}
+		}
+		remaining = append(remaining, existing)
+	}
+	group.Rules = remaining
+	return nil
+}
+
+// ListKubernetesSecurityGroups implements awsclient.Interface.
+func (c *Client) ListKubernetesSecurityGroups(_ context.Context, vpcID, clusterName string) ([]string, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	var results []string
+	for _, group := range c.EC2.SecurityGroups {
+		if group.VpcID == vpcID && group.Tags[fmt.Sprintf("kubernetes.io/cluster/%s", clusterName)] == "owned" {
+			results = append(results, group.GroupID)
+		}
+	}
+	return results, nil
+}
+
+// ListKubernetesELBs implements awsclient.Interface. The fake does not support load balancers.
+func (c *Client) ListKubernetesELBs(_ context.Context, _, _ string) ([]string, error) {
+	return nil, nil
+}
+
+// DeleteELB implements awsclient.Interface. The fake does not support load balancers.
+func (c *Client) DeleteELB(_ context.Context, _ string) error {
+	return nil
+}
+
+// DeleteSecurityGroup implements awsclient.Interface.
+func (c *Client) DeleteSecurityGroup(_ context.Context, id string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	for _, group := range c.EC2.SecurityGroups {
+		if group.GroupID == id {
+			continue
+		}
+		for _, rule := range group.Rules {
+			if rule.SourceSecurityGroupID == id {
+				return dependencyViolation("security group %s is referenced by security group %s", id, group.GroupID)
+			}
+		}
+	}
+	delete(c.EC2.SecurityGroups, id)
+	return nil
+}
+
+// CreateElasticIP implements awsclient.Interface.
+func (c *Client) CreateElasticIP(_ context.Context, eip *awsclient.ElasticIP) (*awsclient.ElasticIP, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	created := copyElasticIP(eip)
+	created.AllocationID = c.EC2.newID("eipalloc")
+	created.PublicIP = fmt.Sprintf("192.0.2.%d", c.EC2.nextID%256)
+	c.EC2.ElasticIPs[created.AllocationID] = created
+	return copyElasticIP(created), nil
+}
+
+// FindElasticIPsByTags implements awsclient.Interface.
+func (c *Client) FindElasticIPsByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.ElasticIP, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	var results []*awsclient.ElasticIP
+	for _, eip := range c.EC2.ElasticIPs {
+		if eip.Tags.Contains(tags) {
+			results = append(results, copyElasticIP(eip))
+		}
+	}
+	return results, nil
+}
+
+// DeleteElasticIP implements awsclient.Interface.
+func (c *Client) DeleteElasticIP(_ context.Context, allocationID string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	for _, gateway := range c.EC2.NATGateways {
+		if gateway.EIPAllocationID == allocationID {
+			return fmt.Errorf("elastic IP %s is in use by NAT gateway %s", allocationID, gateway.NATGatewayID)
+		}
+	}
+	delete(c.EC2.ElasticIPs, allocationID)
+	return nil
+}
+
+// CreateNATGateway implements awsclient.Interface. NAT gateways are immediately available.
+func (c *Client) CreateNATGateway(_ context.Context, gateway *awsclient.NATGateway) (*awsclient.NATGateway, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	if _, ok := c.EC2.Subnets[gateway.SubnetID]; !ok {
+		return nil, notFound("subnet %s", gateway.SubnetID)
+	}
+	if _, ok := c.EC2.ElasticIPs[gateway.EIPAllocationID]; !ok {
+		return nil, notFound("elastic IP %s", gateway.EIPAllocationID)
+	}
+	created := copyNATGateway(gateway)
+	created.NATGatewayID = c.EC2.newID("nat")
+	created.State = "available"
+	c.EC2.NATGateways[created.NATGatewayID] = created
+	return copyNATGateway(created), nil
+}
+
+// FindNATGatewaysByTags implements awsclient.Interface.
+func (c *Client) FindNATGatewaysByTags(_ context.Context, tags awsclient.Tags) ([]*awsclient.NATGateway, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	var results []*awsclient.NATGateway
+	for _, gateway := range c.EC2.NATGateways {
+		if gateway.Tags.Contains(tags) {
+			results = append(results, copyNATGateway(gateway))
+		}
+	}
+	return results, nil
+}
+
+// WaitForNATGatewayAvailable implements awsclient.Interface.
+func (c *Client) WaitForNATGatewayAvailable(_ context.Context, id string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	if _, ok := c.EC2.NATGateways[id]; !ok {
+		return notFound("NAT gateway %s", id)
+	}
+	return nil
+}
+
+// DeleteNATGateway implements awsclient.Interface.
+func (c *Client) DeleteNATGateway(_ context.Context, id string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	delete(c.EC2.NATGateways, id)
+	return nil
+}
+
+// ImportKeyPair implements awsclient.Interface.
+func (c *Client) ImportKeyPair(_ context.Context, keyName, publicKey string) (*awsclient.KeyPair, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	if _, ok := c.EC2.KeyPairs[keyName]; ok {
+		return nil, fmt.Errorf("key pair %s already exists", keyName)
+	}
+	fingerprint, err := awsclient.KeyPairFingerprint(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	keyPair := &awsclient.KeyPair{KeyName: keyName, KeyFingerprint: fingerprint}
+	c.EC2.KeyPairs[keyName] = keyPair
+	copied := *keyPair
+	return &copied, nil
+}
+
+// GetKeyPair implements awsclient.Interface.
+func (c *Client) GetKeyPair(_ context.Context, keyName string) (*awsclient.KeyPair, error) {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	keyPair, ok := c.EC2.KeyPairs[keyName]
+	if !ok {
+		return nil, nil
+	}
+	copied := *keyPair
+	return &copied, nil
+}
+
+// DeleteKeyPair implements awsclient.Interface.
+func (c *Client) DeleteKeyPair(_ context.Context, keyName string) error {
+	c.EC2.lock.Lock()
+	defer c.EC2.lock.Unlock()
+
+	delete(c.EC2.KeyPairs, keyName)
+	return nil
+}
+
+func notFound(format string, args ...interface{}) error {
+	return fmt.Errorf("%s not found", fmt.Sprintf(format, args...))
+}
+
+func dependencyViolation(format string, args ...interface{}) error {
+	return fmt.Errorf("dependency violation: %s", fmt.Sprintf(format, args...))
+}
+
+func copyDhcpOptions(options *awsclient.DhcpOptions) *awsclient.DhcpOptions {
+	copied := *options
+	copied.DhcpConfigurations = map[string][]string{}
+	for key, values := range options.DhcpConfigurations {
+		copied.DhcpConfigurations[key] = append([]string(nil), values...)
+	}
+	copied.Tags = options.Tags.Clone()
+	return &copied
+}
+
+func copyVpc(vpc *awsclient.VPC) *awsclient.VPC {
+	copied := *vpc
+	copied.Tags = vpc.Tags.Clone()
+	return &copied
+}
+
+func copyInternetGateway(gateway *awsclient.InternetGateway) *awsclient.InternetGateway {
+	copied := *gateway
+	copied.Tags = gateway.Tags.Clone()
+	return &copied
+}
+
+func copySubnet(subnet *awsclient.Subnet) *awsclient.Subnet {
+	copied := *subnet
+	copied.Tags = subnet.Tags.Clone()
+	return &copied
+}
+
+func copyRouteTable(routeTable *awsclient.RouteTable) *awsclient.RouteTable {
+	copied := *routeTable
+	copied.Routes = nil
+	for _, route := range routeTable.Routes {
+		r := *route
+		copied.Routes = append(copied.Routes, &r)
+	}
+	copied.Associations = nil
+	for _, association := range routeTable.Associations {
+		a := *association
+		copied.Associations = append(copied.Associations, &a)
+	}
+	copied.Tags = routeTable.Tags.Clone()
+	return &copied
+}
+
+func copySecurityGroup(group *awsclient.SecurityGroup) *awsclient.SecurityGroup {
+	copied := *group
+	copied.Rules = nil
+	for _, rule := range group.Rules {
+		r := *rule
+		copied.Rules = append(copied.Rules, &r)
+	}
+	copied.Tags = group.Tags.Clone()
+	return &copied
+}
+
+func copyElasticIP(eip *awsclient.ElasticIP) *awsclient.ElasticIP {
+	copied := *eip
+	copied.Tags = eip.Tags.Clone()
+	return &copied
+}
+
+func copyNATGateway(gateway *awsclient.NATGateway) *awsclient.NATGateway {
+	copied := *gateway
+	copied.Tags = gateway.Tags.Clone()
+	return &copied
+}
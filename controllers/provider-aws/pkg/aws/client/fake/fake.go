@@ -0,0 +1,64 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extensions/pkg/util/test/objectstore"
+)
+
+// Client is an implementation of awsclient.Interface whose S3 methods are backed by an in-memory
+// object store and whose EC2 and IAM methods are backed by in-memory stores of EC2 and IAM resources.
+// All other methods of awsclient.Interface panic unless Interface is set.
+type Client struct {
+	awsclient.Interface
+	*objectstore.Store
+
+	EC2 *EC2
+	IAM *IAM
+}
+
+// NewClient creates a new Client with an empty in-memory object store and empty EC2 and IAM stores.
+func NewClient() *Client {
+	return &Client{
+		Store: objectstore.New(),
+		EC2:   NewEC2(),
+		IAM:   NewIAM(),
+	}
+}
+
+// Factory returns an awsclient.Factory that always returns this Client.
+func (c *Client) Factory() awsclient.Factory {
+	return awsclient.FactoryFunc(func(_, _, _ string) (awsclient.Interface, error) {
+		return c, nil
+	})
+}
+
+// CreateBucketIfNotExists implements awsclient.Interface.
+func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket, region string) error {
+	return c.Store.CreateBucketIfNotExists(ctx, bucket, region)
+}
+
+// DeleteBucketIfExists implements awsclient.Interface.
+func (c *Client) DeleteBucketIfExists(ctx context.Context, bucket string) error {
+	return c.Store.DeleteBucketIfExists(ctx, bucket)
+}
+
+// DeleteObjectsWithPrefix implements awsclient.Interface.
+func (c *Client) DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error {
+	return c.Store.DeleteObjectsWithPrefix(ctx, bucket, prefix)
+}
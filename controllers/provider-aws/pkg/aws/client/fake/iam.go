@@ -0,0 +1,212 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+)
+
+// IAM is an in-memory store of IAM resources. It mimics the behaviour of IAM as far as it is relevant
+// for callers, e.g. deleting a role fails as long as it has inline policies.
+type IAM struct {
+	lock sync.Mutex
+
+	Roles            map[string]*awsclient.IAMRole
+	InstanceProfiles map[string]*awsclient.IAMInstanceProfile
+	// RolePolicies contains the inline policies of the roles by role name and policy name.
+	RolePolicies map[string]map[string]*awsclient.IAMRolePolicy
+}
+
+// NewIAM creates a new empty IAM store.
+func NewIAM() *IAM {
+	return &IAM{
+		Roles:            map[string]*awsclient.IAMRole{},
+		InstanceProfiles: map[string]*awsclient.IAMInstanceProfile{},
+		RolePolicies:     map[string]map[string]*awsclient.IAMRolePolicy{},
+	}
+}
+
+// CreateIAMRole implements awsclient.Interface.
+func (c *Client) CreateIAMRole(_ context.Context, role *awsclient.IAMRole) (*awsclient.IAMRole, error) {
+	c.IAM.lock.Lock()
+	defer c.IAM.lock.Unlock()
+
+	if _, ok := c.IAM.Roles[role.RoleName]; ok {
+		return nil, fmt.Errorf("role %s already exists", role.RoleName)
+	}
+	created := *role
+	created.ARN = fmt.Sprintf("arn:aws:iam::123456789012:role%s%s", role.Path, role.RoleName)
+	c.IAM.Roles[role.RoleName] = &created
+	copied := created
+	return &copied, nil
+}
+
+// GetIAMRole implements awsclient.Interface.
+func (c *Client) GetIAMRole(_ context.Context, roleName string) (*awsclient.IAMRole, error) {
+	c.IAM.lock.Lock()
+	defer c.IAM.lock.Unlock()
+
+	role, ok := c.IAM.Roles[roleName]
+	if !ok {
+		return nil, nil
+	}
+	copied := *role
+	return &copied, nil
+}
+
+// UpdateAssumeRolePolicy implements awsclient.Interface.
+func (c *Client) UpdateAssumeRolePolicy(_ context.Context, roleName, assumeRolePolicy string) error {
+	c.IAM.lock.Lock()
+	defer c.IAM.lock.Unlock()
+
+	role, ok := c.IAM.Roles[roleName]
+	if !ok {
+		return notFound("role %s", roleName)
+	}
+	role.AssumeRolePolicyDocument = assumeRolePolicy
+	return nil
+}
+
+// DeleteIAMRole implements awsclient.Interface.
+func (c *Client) DeleteIAMRole(_ context.Context, roleName string) error {
+	c.IAM.lock.Lock()
+	defer c.IAM.lock.Unlock()
+
+	if len(c.IAM.RolePolicies[roleName]) > 0 {
+		return dependencyViolation("role %s has inline policies", roleName)
+	}
+	for _, profile := range c.IAM.InstanceProfiles {
+		if profile.RoleName == roleName {
+			return dependencyViolation("role %s is contained in instance profile %s", roleName, profile.InstanceProfileName)
+		}
+	}
+	delete(c.IAM.Roles, roleName)
+	return nil
+}
+
+// CreateIAMInstanceProfile implements awsclient.Interface.
+func (c *Client) CreateIAMInstanceProfile(_ context.Context, profile *awsclient.IAMInstanceProfile) (*awsclient.IAMInstanceProfile, error) {
+	c.IAM.lock.Lock()
+	defer c.IAM.lock.Unlock()
+
+	if _, ok := c.IAM.InstanceProfiles[profile.InstanceProfileName]; ok {
+		return nil, fmt.Errorf("instance profile %s already exists", profile.InstanceProfileName)
+	}
+	created := *profile
+	created.RoleName = ""
+	c.IAM.InstanceProfiles[profile.InstanceProfileName] = &created
+	copied := created
+	return &copied, nil
+}
+
+// GetIAMInstanceProfile implements awsclient.Interface.
+func (c *Client) GetIAMInstanceProfile(_ context.Context, profileName string) (*awsclient.IAMInstanceProfile, error) {
+	c.IAM.lock.Lock()
+	defer c.IAM.lock.Unlock()
+
+	profile, ok := c.IAM.InstanceProfiles[profileName]
+	if !ok {
+		return nil, nil
+	}
+	copied := *profile
+	return &copied, nil
+}
+
+// AddRoleToIAMInstanceProfile implements awsclient.Interface.
+func (c *Client) AddRoleToIAMInstanceProfile(_ context.Context, profileName, roleName string) error {
+	c.IAM.lock.Lock()
+	defer c.IAM.lock.Unlock()
+
+	profile, ok := c.IAM.InstanceProfiles[profileName]
+	if !ok {
+		return notFound("instance profile %s", profileName)
+	}
+	if _, ok := c.IAM.Roles[roleName]; !ok {
+		return notFound("role %s", roleName)
+	}
+	if profile.RoleName != "" {
+		return fmt.Errorf("instance profile %s already contains role %s", profileName, profile.RoleName)
+	}
+	profile.RoleName = roleName
+	return nil
+}
+
+// RemoveRoleFromIAMInstanceProfile implements awsclient.Interface.
+func (c *Client) RemoveRoleFromIAMInstanceProfile(_ context.Context, profileName, roleName string) error {
+	c.IAM.lock.Lock()
+	defer c.IAM.lock.Unlock()
+
+	if profile, ok := c.IAM.InstanceProfiles[profileName]; ok && profile.RoleName == roleName {
+		profile.RoleName = ""
+	}
+	return nil
+}
+
+// DeleteIAMInstanceProfile implements awsclient.Interface.
+func (c *Client) DeleteIAMInstanceProfile(_ context.Context, profileName string) error {
+	c.IAM.lock.Lock()
+	defer c.IAM.lock.Unlock()
+
+	if profile, ok := c.IAM.InstanceProfiles[profileName]; ok && profile.RoleName != "" {
+		return dependencyViolation("instance profile %s contains role %s", profileName, profile.RoleName)
+	}
+	delete(c.IAM.InstanceProfiles, profileName)
+	return nil
+}
+
+// PutIAMRolePolicy implements awsclient.Interface.
+func (c *Client) PutIAMRolePolicy(_ context.Context, policy *awsclient.IAMRolePolicy) error {
+	c.IAM.lock.Lock()
+	defer c.IAM.lock.Unlock()
+
+	if _, ok := c.IAM.Roles[policy.RoleName]; !ok {
+		return notFound("role %s", policy.RoleName)
+	}
+	if c.IAM.RolePolicies[policy.RoleName] == nil {
+		c.IAM.RolePolicies[policy.RoleName] = map[string]*awsclient.IAMRolePolicy{}
+	}
+	copied := *policy
+	c.IAM.RolePolicies[policy.RoleName][policy.PolicyName] = &copied
+	return nil
+}
+
+// GetIAMRolePolicy implements awsclient.Interface.
+func (c *Client) GetIAMRolePolicy(_ context.Context, roleName, policyName string) (*awsclient.IAMRolePolicy, error) {
+	c.IAM.lock.Lock()
+	defer c.IAM.lock.Unlock()
+
+	policy, ok := c.IAM.RolePolicies[roleName][policyName]
+	if !ok {
+		return nil, nil
+	}
+	copied := *policy
+	return &copied, nil
+}
+
+// DeleteIAMRolePolicy implements awsclient.Interface.
+func (c *Client) DeleteIAMRolePolicy(_ context.Context, roleName, policyName string) error {
+	c.IAM.lock.Lock()
+	defer c.IAM.lock.Unlock()
+
+	delete(c.IAM.RolePolicies[roleName], policyName)
+	if len(c.IAM.RolePolicies[roleName]) == 0 {
+		delete(c.IAM.RolePolicies, roleName)
+	}
+	return nil
+}
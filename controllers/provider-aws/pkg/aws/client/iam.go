@@ -0,0 +1,185 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+// CreateIAMRole creates the given IAM role.
+func (c *Client) CreateIAMRole(ctx context.Context, role *IAMRole) (*IAMRole, error) {
+	output, err := c.IAM.CreateRoleWithContext(ctx, &iam.CreateRoleInput{
+		RoleName:                 aws.String(role.RoleName),
+		Path:                     aws.String(role.Path),
+		AssumeRolePolicyDocument: aws.String(role.AssumeRolePolicyDocument),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromIAMRole(output.Role)
+}
+
+// GetIAMRole returns the IAM role with the given <roleName>.
+func (c *Client) GetIAMRole(ctx context.Context, roleName string) (*IAMRole, error) {
+	output, err := c.IAM.GetRoleWithContext(ctx, &iam.GetRoleInput{RoleName: aws.String(roleName)})
+	if err != nil {
+		return nil, ignoreNoSuchEntity(err)
+	}
+	return fromIAMRole(output.Role)
+}
+
+// UpdateAssumeRolePolicy updates the assume role policy of the IAM role with the given <roleName>.
+func (c *Client) UpdateAssumeRolePolicy(ctx context.Context, roleName, assumeRolePolicy string) error {
+	_, err := c.IAM.UpdateAssumeRolePolicyWithContext(ctx, &iam.UpdateAssumeRolePolicyInput{
+		RoleName:       aws.String(roleName),
+		PolicyDocument: aws.String(assumeRolePolicy),
+	})
+	return err
+}
+
+// DeleteIAMRole deletes the IAM role with the given <roleName>. If it does not exist, no error is returned.
+func (c *Client) DeleteIAMRole(ctx context.Context, roleName string) error {
+	_, err := c.IAM.DeleteRoleWithContext(ctx, &iam.DeleteRoleInput{RoleName: aws.String(roleName)})
+	return ignoreNoSuchEntity(err)
+}
+
+// CreateIAMInstanceProfile creates the given IAM instance profile. The role of the given profile is ignored,
+// it has to be added with AddRoleToIAMInstanceProfile.
+func (c *Client) CreateIAMInstanceProfile(ctx context.Context, profile *IAMInstanceProfile) (*IAMInstanceProfile, error) {
+	output, err := c.IAM.CreateInstanceProfileWithContext(ctx, &iam.CreateInstanceProfileInput{
+		InstanceProfileName: aws.String(profile.InstanceProfileName),
+		Path:                aws.String(profile.Path),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromIAMInstanceProfile(output.InstanceProfile), nil
+}
+
+// GetIAMInstanceProfile returns the IAM instance profile with the given <profileName>.
+func (c *Client) GetIAMInstanceProfile(ctx context.Context, profileName string) (*IAMInstanceProfile, error) {
+	output, err := c.IAM.GetInstanceProfileWithContext(ctx, &iam.GetInstanceProfileInput{InstanceProfileName: aws.String(profileName)})
+	if err != nil {
+		return nil, ignoreNoSuchEntity(err)
+	}
+	return fromIAMInstanceProfile(output.InstanceProfile), nil
+}
+
+// AddRoleToIAMInstanceProfile adds the IAM role with the given <roleName> to the IAM instance profile with
+// the given <profileName>.
+func (c *Client) AddRoleToIAMInstanceProfile(ctx context.Context, profileName, roleName string) error {
+	_, err := c.IAM.AddRoleToInstanceProfileWithContext(ctx, &iam.AddRoleToInstanceProfileInput{
+		InstanceProfileName: aws.String(profileName),
+		RoleName:            aws.String(roleName),
+	})
+	return err
+}
+
+// RemoveRoleFromIAMInstanceProfile removes the IAM role with the given <roleName> from the IAM instance profile
+// with the given <profileName>. If the profile or role does not exist, no error is returned.
+func (c *Client) RemoveRoleFromIAMInstanceProfile(ctx context.Context, profileName, roleName string) error {
+	_, err := c.IAM.RemoveRoleFromInstanceProfileWithContext(ctx, &iam.RemoveRoleFromInstanceProfileInput{
+		InstanceProfileName: aws.String(profileName),
+		RoleName:            aws.String(roleName),
+	})
+	return ignoreNoSuchEntity(err)
+}
+
+// DeleteIAMInstanceProfile deletes the IAM instance profile with the given <profileName>. If it does not exist,
+// no error is returned.
+func (c *Client) DeleteIAMInstanceProfile(ctx context.Context, profileName string) error {
+	_, err := c.IAM.DeleteInstanceProfileWithContext(ctx, &iam.DeleteInstanceProfileInput{InstanceProfileName: aws.String(profileName)})
+	return ignoreNoSuchEntity(err)
+}
+
+// PutIAMRolePolicy creates or updates the given inline policy of an IAM role.
+func (c *Client) PutIAMRolePolicy(ctx context.Context, policy *IAMRolePolicy) error {
+	_, err := c.IAM.PutRolePolicyWithContext(ctx, &iam.PutRolePolicyInput{
+		RoleName:       aws.String(policy.RoleName),
+		PolicyName:     aws.String(policy.PolicyName),
+		PolicyDocument: aws.String(policy.PolicyDocument),
+	})
+	return err
+}
+
+// GetIAMRolePolicy returns the inline policy with the given <policyName> of the IAM role with the given <roleName>.
+func (c *Client) GetIAMRolePolicy(ctx context.Context, roleName, policyName string) (*IAMRolePolicy, error) {
+	output, err := c.IAM.GetRolePolicyWithContext(ctx, &iam.GetRolePolicyInput{
+		RoleName:   aws.String(roleName),
+		PolicyName: aws.String(policyName),
+	})
+	if err != nil {
+		return nil, ignoreNoSuchEntity(err)
+	}
+
+	// IAM returns policy documents URL-encoded.
+	document, err := url.QueryUnescape(aws.StringValue(output.PolicyDocument))
+	if err != nil {
+		return nil, err
+	}
+	return &IAMRolePolicy{
+		RoleName:       aws.StringValue(output.RoleName),
+		PolicyName:     aws.StringValue(output.PolicyName),
+		PolicyDocument: document,
+	}, nil
+}
+
+// DeleteIAMRolePolicy deletes the inline policy with the given <policyName> of the IAM role with the given
+// <roleName>. If it does not exist, no error is returned.
+func (c *Client) DeleteIAMRolePolicy(ctx context.Context, roleName, policyName string) error {
+	_, err := c.IAM.DeleteRolePolicyWithContext(ctx, &iam.DeleteRolePolicyInput{
+		RoleName:   aws.String(roleName),
+		PolicyName: aws.String(policyName),
+	})
+	return ignoreNoSuchEntity(err)
+}
+
+func fromIAMRole(role *iam.Role) (*IAMRole, error) {
+	// IAM returns policy documents URL-encoded.
+	document, err := url.QueryUnescape(aws.StringValue(role.AssumeRolePolicyDocument))
+	if err != nil {
+		return nil, err
+	}
+	return &IAMRole{
+		RoleName:                 aws.StringValue(role.RoleName),
+		Path:                     aws.StringValue(role.Path),
+		AssumeRolePolicyDocument: document,
+		ARN:                      aws.StringValue(role.Arn),
+	}, nil
+}
+
+func fromIAMInstanceProfile(profile *iam.InstanceProfile) *IAMInstanceProfile {
+	result := &IAMInstanceProfile{
+		InstanceProfileName: aws.StringValue(profile.InstanceProfileName),
+		Path:                aws.StringValue(profile.Path),
+	}
+	for _, role := range profile.Roles {
+		result.RoleName = aws.StringValue(role.RoleName)
+	}
+	return result
+}
+
+// ignoreNoSuchEntity returns nil if the given error is an IAM error indicating that the entity does not exist.
+func ignoreNoSuchEntity(err error) error {
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == iam.ErrCodeNoSuchEntityException {
+		return nil
+	}
+	return err
+}
@@ -19,6 +19,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
 
@@ -32,14 +34,243 @@ type Interface interface {
 	ListKubernetesSecurityGroups(ctx context.Context, vpcID, clusterName string) ([]string, error)
 	DeleteELB(ctx context.Context, name string) error
 	DeleteSecurityGroup(ctx context.Context, id string) error
+
+	// S3 wrappers
+	CreateBucketIfNotExists(ctx context.Context, bucket, region string) error
+	DeleteBucketIfExists(ctx context.Context, bucket string) error
+	DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error
+
+	// EC2 wrappers
+	// The Get* functions return nil if the requested resource does not exist.
+	CreateDhcpOptions(ctx context.Context, options *DhcpOptions) (*DhcpOptions, error)
+	FindDhcpOptionsByTags(ctx context.Context, tags Tags) ([]*DhcpOptions, error)
+	DeleteDhcpOptions(ctx context.Context, id string) error
+	CreateVpc(ctx context.Context, vpc *VPC) (*VPC, error)
+	FindVpcsByTags(ctx context.Context, tags Tags) ([]*VPC, error)
+	UpdateVpcAttribute(ctx context.Context, vpcID, attributeName string, value bool) error
+	AssociateDhcpOptions(ctx context.Context, vpcID, dhcpOptionsID string) error
+	DeleteVpc(ctx context.Context, id string) error
+	CreateInternetGateway(ctx context.Context, gateway *InternetGateway) (*InternetGateway, error)
+	FindInternetGatewaysByTags(ctx context.Context, tags Tags) ([]*InternetGateway, error)
+	AttachInternetGateway(ctx context.Context, vpcID, internetGatewayID string) error
+	DetachInternetGateway(ctx context.Context, vpcID, internetGatewayID string) error
+	DeleteInternetGateway(ctx context.Context, id string) error
+	CreateSubnet(ctx context.Context, subnet *Subnet) (*Subnet, error)
+	FindSubnetsByTags(ctx context.Context, tags Tags) ([]*Subnet, error)
+	DeleteSubnet(ctx context.Context, id string) error
+	CreateRouteTable(ctx context.Context, routeTable *RouteTable) (*RouteTable, error)
+	FindRouteTablesByTags(ctx context.Context, tags Tags) ([]*RouteTable, error)
+	CreateRoute(ctx context.Context, routeTableID string, route *Route) error
+	DeleteRoute(ctx context.Context, routeTableID string, route *Route) error
+	AssociateRouteTable(ctx context.Context, routeTableID, subnetID string) (string, error)
+	DisassociateRouteTable(ctx context.Context, associationID string) error
+	DeleteRouteTable(ctx context.Context, id string) error
+	CreateSecurityGroup(ctx context.Context, group *SecurityGroup) (*SecurityGroup, error)
+	FindSecurityGroupsByTags(ctx context.Context, tags Tags) ([]*SecurityGroup, error)
+	AuthorizeSecurityGroupRules(ctx context.Context, id string, rules []*SecurityGroupRule) error
+	RevokeSecurityGroupRules(ctx context.Context, id string, rules []*SecurityGroupRule) error
+	CreateElasticIP(ctx context.Context, eip *ElasticIP) (*ElasticIP, error)
+	FindElasticIPsByTags(ctx context.Context, tags Tags) ([]*ElasticIP, error)
+	DeleteElasticIP(ctx context.Context, allocationID string) error
+	CreateNATGateway(ctx context.Context, gateway *NATGateway) (*NATGateway, error)
+	FindNATGatewaysByTags(ctx context.Context, tags Tags) ([]*NATGateway, error)
+	WaitForNATGatewayAvailable(ctx context.Context, id string) error
+	DeleteNATGateway(ctx context.Context, id string) error
+	ImportKeyPair(ctx context.Context, keyName, publicKey string) (*KeyPair, error)
+	GetKeyPair(ctx context.Context, keyName string) (*KeyPair, error)
+	DeleteKeyPair(ctx context.Context, keyName string) error
+
+	// IAM wrappers
+	// The Get* functions return nil if the requested resource does not exist.
+	CreateIAMRole(ctx context.Context, role *IAMRole) (*IAMRole, error)
+	GetIAMRole(ctx context.Context, roleName string) (*IAMRole, error)
+	UpdateAssumeRolePolicy(ctx context.Context, roleName, assumeRolePolicy string) error
+	DeleteIAMRole(ctx context.Context, roleName string) error
+	CreateIAMInstanceProfile(ctx context.Context, profile *IAMInstanceProfile) (*IAMInstanceProfile, error)
+	GetIAMInstanceProfile(ctx context.Context, profileName string) (*IAMInstanceProfile, error)
+	AddRoleToIAMInstanceProfile(ctx context.Context, profileName, roleName string) error
+	RemoveRoleFromIAMInstanceProfile(ctx context.Context, profileName, roleName string) error
+	DeleteIAMInstanceProfile(ctx context.Context, profileName string) error
+	PutIAMRolePolicy(ctx context.Context, policy *IAMRolePolicy) error
+	GetIAMRolePolicy(ctx context.Context, roleName, policyName string) (*IAMRolePolicy, error)
+	DeleteIAMRolePolicy(ctx context.Context, roleName, policyName string) error
+}
+
+// Factory creates instances of Interface.
+type Factory interface {
+	// NewClient creates a new instance of Interface for the given AWS credentials and region.
+	NewClient(accessKeyID, secretAccessKey, region string) (Interface, error)
+}
+
+// FactoryFunc is a function that implements Factory.
+type FactoryFunc func(accessKeyID, secretAccessKey, region string) (Interface, error)
+
+// NewClient implements Factory.
+func (f FactoryFunc) NewClient(accessKeyID, secretAccessKey, region string) (Interface, error) {
+	return f(accessKeyID, secretAccessKey, region)
 }
 
 // Client is a struct containing several clients for the different AWS services it needs to interact with.
 // * EC2 is the standard client for the EC2 service.
 // * ELB is the standard client for the ELB service.
+// * IAM is the standard client for the IAM service.
+// * S3 is the standard client for the S3 service.
 // * STS is the standard client for the STS service.
 type Client struct {
 	EC2 *ec2.EC2
 	ELB *elb.ELB
+	IAM *iam.IAM
+	S3  *s3.S3
 	STS *sts.STS
 }
+
+// Tags are the key/value tags of an AWS resource.
+type Tags map[string]string
+
+// Clone returns a copy of the Tags.
+func (t Tags) Clone() Tags {
+	out := make(Tags, len(t))
+	for k, v := range t {
+		out[k] = v
+	}
+	return out
+}
+
+// Contains returns true if all given tags are contained in these Tags.
+func (t Tags) Contains(tags Tags) bool {
+	for k, v := range tags {
+		if value, ok := t[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// DhcpOptions is a set of DHCP options of a VPC.
+type DhcpOptions struct {
+	DhcpOptionsID      string
+	DhcpConfigurations map[string][]string
+	Tags
+}
+
+// VPC is an AWS virtual private cloud.
+type VPC struct {
+	VpcID         string
+	CidrBlock     string
+	DhcpOptionsID string
+	Tags
+}
+
+// InternetGateway is an AWS internet gateway. VpcID is the id of the VPC the gateway is attached to, if any.
+type InternetGateway struct {
+	InternetGatewayID string
+	VpcID             string
+	Tags
+}
+
+// Subnet is a subnet of a VPC.
+type Subnet struct {
+	SubnetID         string
+	VpcID            string
+	CidrBlock        string
+	AvailabilityZone string
+	Tags
+}
+
+// RouteTable is a route table of a VPC.
+type RouteTable struct {
+	RouteTableID string
+	VpcID        string
+	Routes       []*Route
+	Associations []*RouteTableAssociation
+	Tags
+}
+
+// Route is a route of a route table. Exactly one of GatewayID and NatGatewayID is set.
+type Route struct {
+	DestinationCidrBlock string
+	GatewayID            string
+	NatGatewayID         string
+}
+
+// RouteTableAssociation is the association of a route table with a subnet.
+type RouteTableAssociation struct {
+	RouteTableAssociationID string
+	SubnetID                string
+}
+
+// SecurityGroupRuleType is the type of a security group rule.
+type SecurityGroupRuleType string
+
+const (
+	// SecurityGroupRuleTypeIngress is the type of security group rules for inbound traffic.
+	SecurityGroupRuleTypeIngress SecurityGroupRuleType = "ingress"
+	// SecurityGroupRuleTypeEgress is the type of security group rules for outbound traffic.
+	SecurityGroupRuleTypeEgress SecurityGroupRuleType = "egress"
+)
+
+// SecurityGroup is a security group of a VPC.
+type SecurityGroup struct {
+	GroupID     string
+	GroupName   string
+	VpcID       string
+	Description string
+	Rules       []*SecurityGroupRule
+	Tags
+}
+
+// SecurityGroupRule is a single rule of a security group. Exactly one of CidrBlock and
+// SourceSecurityGroupID is set. A protocol of "-1" means all protocols and ports.
+type SecurityGroupRule struct {
+	Type                  SecurityGroupRuleType
+	Protocol              string
+	FromPort              int64
+	ToPort                int64
+	CidrBlock             string
+	SourceSecurityGroupID string
+}
+
+// ElasticIP is an elastic IP address allocated for use in a VPC.
+type ElasticIP struct {
+	AllocationID string
+	PublicIP     string
+	Tags
+}
+
+// NATGateway is a NAT gateway of a VPC.
+type NATGateway struct {
+	NATGatewayID    string
+	EIPAllocationID string
+	SubnetID        string
+	State           string
+	Tags
+}
+
+// KeyPair is an EC2 key pair.
+type KeyPair struct {
+	KeyName        string
+	KeyFingerprint string
+}
+
+// IAMRole is an IAM role.
+type IAMRole struct {
+	RoleName                 string
+	Path                     string
+	AssumeRolePolicyDocument string
+	ARN                      string
+}
+
+// IAMInstanceProfile is an IAM instance profile. RoleName is the name of the role that is contained
+// in the instance profile, if any.
+type IAMInstanceProfile struct {
+	InstanceProfileName string
+	Path                string
+	RoleName            string
+}
+
+// IAMRolePolicy is an inline policy of an IAM role.
+type IAMRolePolicy struct {
+	RoleName       string
+	PolicyName     string
+	PolicyDocument string
+}
@@ -30,6 +30,16 @@ const (
 	HyperkubeImageName = "hyperkube"
 	// ETCDBackupRestoreImageName is the name of the etcd backup and restore image.
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSINodeDriverRegistrarImageName is the name of the CSI driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSISnapshotterImageName is the name of the CSI snapshotter image.
+	CSISnapshotterImageName = "csi-snapshotter"
+	// CSIPluginImageName is the name of the CSI plugin image.
+	CSIPluginImageName = "aws-ebs-csi-driver"
 
 	// AccessKeyID is a constant for the key in a cloud provider secret and backup secret that holds the AWS access key id.
 	AccessKeyID = "accessKeyID"
@@ -62,12 +72,28 @@ const (
 	// BastionsRole role for bastions
 	BastionsRole = "bastions_role_arn"
 
+	// InfrastructureReconcilerAnnotation is the annotation of an Infrastructure that selects how its AWS resources
+	// are reconciled. It must not be changed for an existing Infrastructure, as the reconcilers do not share state.
+	InfrastructureReconcilerAnnotation = "aws.provider.extensions.gardener.cloud/infrastructure-reconciler"
+	// InfrastructureReconcilerTerraform is the (default) value of the InfrastructureReconcilerAnnotation that
+	// selects reconciling the AWS resources with the Terraformer.
+	InfrastructureReconcilerTerraform = "terraform"
+	// InfrastructureReconcilerNative is the value of the InfrastructureReconcilerAnnotation that selects reconciling
+	// the AWS resources directly via the AWS API.
+	InfrastructureReconcilerNative = "native"
+
 	// CloudProviderConfigName is the name of the configmap containing the cloud provider config.
 	CloudProviderConfigName = "cloud-provider-config"
+	// CloudControllerManagerName is a constant for the name of the cloud-controller-manager.
+	CloudControllerManagerName = "cloud-controller-manager"
 	// MachineControllerManagerName is a constant for the name of the machine-controller-manager.
 	MachineControllerManagerName = "machine-controller-manager"
 	// BackupSecretName is the name of the secret containing the credentials for storing the backups of Shoot clusters.
 	BackupSecretName = "etcd-backup"
+
+	// CSIMinimumKubernetesVersion is the minimum Kubernetes version of Shoot clusters for which the CSI driver is deployed.
+	// Older Shoot clusters still use the in-tree volume plugin.
+	CSIMinimumKubernetesVersion = "1.14"
 )
 
 var (
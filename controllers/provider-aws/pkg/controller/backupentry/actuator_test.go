@@ -0,0 +1,40 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry_test
+
+import (
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client/fake"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/controller/backupentry"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry/test"
+	"github.com/gardener/gardener-extensions/pkg/util/test/objectstore"
+
+	. "github.com/onsi/ginkgo"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var _ = Describe("Actuator", test.DescribeActuatorTest(test.ActuatorTestArgs{
+	Region: "eu-west-1",
+	Credentials: map[string][]byte{
+		aws.AccessKeyID:     []byte("access-key-id"),
+		aws.SecretAccessKey: []byte("secret-access-key"),
+	},
+	NewActuator: func(store *objectstore.Store) backupentry.Actuator {
+		awsClient := fake.NewClient()
+		awsClient.Store = store
+		return NewActuatorWithDeps(log.Log, awsClient.Factory())
+	},
+}))
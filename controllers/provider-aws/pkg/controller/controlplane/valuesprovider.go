@@ -21,6 +21,7 @@ import (
 	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	awsimagevector "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/imagevector"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane/genericactuator"
@@ -38,7 +39,9 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apiserver/pkg/authentication/user"
@@ -46,8 +49,11 @@ import (
 
 // Object names
 const (
-	cloudControllerManagerDeploymentName = "cloud-controller-manager"
+	cloudControllerManagerDeploymentName = aws.CloudControllerManagerName
 	cloudControllerManagerServerName     = "cloud-controller-manager-server"
+	csiAttacherName                      = "csi-attacher"
+	csiProvisionerName                   = "csi-provisioner"
+	csiSnapshotterName                   = "csi-snapshotter"
 )
 
 var controlPlaneSecrets = &secrets.Secrets{
@@ -82,6 +88,45 @@ var controlPlaneSecrets = &secrets.Secrets{
 					SigningCA:  cas[gardencorev1alpha1.SecretNameCACluster],
 				},
 			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiAttacherName,
+					CommonName:   "system:csi-attacher",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[gardencorev1alpha1.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: common.KubeAPIServerDeploymentName,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiProvisionerName,
+					CommonName:   "system:csi-provisioner",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[gardencorev1alpha1.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: common.KubeAPIServerDeploymentName,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiSnapshotterName,
+					CommonName:   "system:csi-snapshotter",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[gardencorev1alpha1.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: common.KubeAPIServerDeploymentName,
+				},
+			},
 		}
 	},
 }
@@ -97,22 +142,62 @@ var configChart = &chart.Chart{
 	},
 }
 
-var ccmChart = &chart.Chart{
-	Name:   "cloud-controller-manager",
-	Path:   filepath.Join(aws.InternalChartsPath, "cloud-controller-manager"),
-	Images: []string{aws.HyperkubeImageName},
-	Objects: []*chart.Object{
-		{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
-		{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
+var controlPlaneChart = &chart.Chart{
+	Name: "seed-controlplane",
+	Path: filepath.Join(aws.InternalChartsPath, "seed-controlplane"),
+	SubCharts: []*chart.Chart{
+		{
+			Name:   "aws-cloud-controller-manager",
+			Images: []string{aws.HyperkubeImageName},
+			Objects: []*chart.Object{
+				{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
+				{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
+			},
+		},
+		{
+			Name: "csi-aws",
+			// The CSI images are injected explicitly by the values provider, see controlplane.GetCSIImages.
+			Objects: []*chart.Object{
+				{Type: &appsv1.Deployment{}, Name: "csi-plugin-controller"},
+			},
+		},
 	},
 }
 
-var ccmShootChart = &chart.Chart{
-	Name: "cloud-controller-manager-shoot",
-	Path: filepath.Join(aws.InternalChartsPath, "cloud-controller-manager-shoot"),
-	Objects: []*chart.Object{
-		{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
-		{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+var controlPlaneShootChart = &chart.Chart{
+	Name: "shoot-system-components",
+	Path: filepath.Join(aws.InternalChartsPath, "shoot-system-components"),
+	SubCharts: []*chart.Chart{
+		{
+			Name: "aws-cloud-controller-manager",
+			Objects: []*chart.Object{
+				{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+			},
+		},
+		{
+			Name: "csi-aws",
+			// The CSI images are injected explicitly by the values provider, see controlplane.GetCSIImages.
+			Objects: []*chart.Object{
+				{Type: &appsv1.DaemonSet{}, Name: "csi-disk-plugin-aws"},
+				{Type: &storagev1.StorageClass{}, Name: "default"},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-disk-plugin-aws"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:psp:kube-system:csi-disk-plugin-aws"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:psp:csi-disk-plugin-aws"},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-attacher"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-attacher"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-attacher"},
+				{Type: &rbacv1.Role{}, Name: "csi-attacher"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-attacher"},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-provisioner"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-provisioner"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-provisioner"},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-snapshotter"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-snapshotter"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-snapshotter"},
+				{Type: &policyv1beta1.PodSecurityPolicy{}, Name: "gardener.kube-system.csi-disk-plugin-aws"},
+			},
+		},
 	},
 }
 
@@ -165,8 +250,8 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
 	}
 
-	// Get CCM chart values
-	return getCCMChartValues(cpConfig, cp, cluster, checksums, scaledDown)
+	// Get control plane chart values
+	return getControlPlaneChartValues(cpConfig, cp, cluster, checksums, scaledDown)
 }
 
 // GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
@@ -175,7 +260,8 @@ func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 ) (map[string]interface{}, error) {
-	return nil, nil
+	// Get control plane shoot chart values
+	return getControlPlaneShootChartValues(cluster)
 }
 
 // getConfigChartValues collects and returns the configuration chart values.
@@ -198,15 +284,15 @@ func getConfigChartValues(
 	}, nil
 }
 
-// getCCMChartValues collects and returns the CCM chart values.
-func getCCMChartValues(
+// getControlPlaneChartValues collects and returns the control plane chart values.
+func getControlPlaneChartValues(
 	cpConfig *apisaws.ControlPlaneConfig,
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	checksums map[string]string,
 	scaledDown bool,
 ) (map[string]interface{}, error) {
-	values := map[string]interface{}{
+	ccmValues := map[string]interface{}{
 		"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster.Shoot, scaledDown, 1),
 		"clusterName":       cp.Namespace,
 		"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
@@ -222,8 +308,63 @@ func getCCMChartValues(
 	}
 
 	if cpConfig.CloudControllerManager != nil {
-		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+		ccmValues["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+	}
+
+	csiEnabled, err := controlplane.IsCSIEnabled(cluster, aws.CSIMinimumKubernetesVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	csiValues := map[string]interface{}{
+		"enabled": csiEnabled,
+	}
+
+	if csiEnabled {
+		images, err := controlplane.GetCSIImages(cluster, awsimagevector.ImageVector(), aws.CSIAttacherImageName, aws.CSIProvisionerImageName, aws.CSISnapshotterImageName, aws.CSIPluginImageName)
+		if err != nil {
+			return nil, err
+		}
+
+		csiValues["replicas"] = extensionscontroller.GetControlPlaneReplicas(cluster.Shoot, scaledDown, 1)
+		csiValues["region"] = cp.Spec.Region
+		csiValues["podAnnotations"] = map[string]interface{}{
+			"checksum/secret-" + csiAttacherName:    checksums[csiAttacherName],
+			"checksum/secret-" + csiProvisionerName: checksums[csiProvisionerName],
+			"checksum/secret-" + csiSnapshotterName: checksums[csiSnapshotterName],
+			"checksum/secret-cloudprovider":         checksums[common.CloudProviderSecretName],
+		}
+		csiValues["images"] = images
+	}
+
+	return map[string]interface{}{
+		"aws-cloud-controller-manager": ccmValues,
+		"csi-aws":                      csiValues,
+	}, nil
+}
+
+// getControlPlaneShootChartValues collects and returns the control plane shoot chart values.
+func getControlPlaneShootChartValues(
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	csiEnabled, err := controlplane.IsCSIEnabled(cluster, aws.CSIMinimumKubernetesVersion)
+	if err != nil {
+		return nil, err
 	}
 
-	return values, nil
+	csiValues := map[string]interface{}{
+		"enabled": csiEnabled,
+	}
+
+	if csiEnabled {
+		images, err := controlplane.GetCSIImages(cluster, awsimagevector.ImageVector(), aws.CSINodeDriverRegistrarImageName, aws.CSIPluginImageName)
+		if err != nil {
+			return nil, err
+		}
+		csiValues["images"] = images
+	}
+
+	return map[string]interface{}{
+		"csi-aws": csiValues,
+	}, nil
 }
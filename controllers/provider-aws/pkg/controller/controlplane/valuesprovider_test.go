@@ -57,6 +57,7 @@ var _ = Describe("ValuesProvider", func() {
 				Namespace: namespace,
 			},
 			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				Region: "eu-west-1",
 				ProviderConfig: &runtime.RawExtension{
 					Raw: encode(&apisaws.ControlPlaneConfig{
 						CloudControllerManager: &apisaws.CloudControllerManagerConfig{
@@ -105,11 +106,25 @@ var _ = Describe("ValuesProvider", func() {
 			},
 		}
 
+		csiCluster = &extensionscontroller.Cluster{
+			Shoot: &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: cluster.Shoot.Spec.Cloud,
+					Kubernetes: gardenv1beta1.Kubernetes{
+						Version: "1.14.3",
+					},
+				},
+			},
+		}
+
 		checksums = map[string]string{
 			common.CloudProviderSecretName:    "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
 			aws.CloudProviderConfigName:       "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
 			"cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
 			"cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
+			"csi-attacher":                    "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+			"csi-provisioner":                 "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
+			"csi-snapshotter":                 "bf417a6c4f8b3c1e7b5c3ed8a2ab1fa1a1ef8dd2d8e65e1b1ac2e8f4a2a0f5c6",
 		}
 
 		configChartValues = map[string]interface{}{
@@ -135,6 +150,19 @@ var _ = Describe("ValuesProvider", func() {
 			},
 		}
 
+		controlPlaneChartValues = map[string]interface{}{
+			"aws-cloud-controller-manager": ccmChartValues,
+			"csi-aws": map[string]interface{}{
+				"enabled": false,
+			},
+		}
+
+		controlPlaneShootChartValues = map[string]interface{}{
+			"csi-aws": map[string]interface{}{
+				"enabled": false,
+			},
+		}
+
 		logger = log.Log.WithName("test")
 	)
 
@@ -170,7 +198,67 @@ var _ = Describe("ValuesProvider", func() {
 			// Call GetControlPlaneChartValues method and check the result
 			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, checksums, false)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(values).To(Equal(ccmChartValues))
+			Expect(values).To(Equal(controlPlaneChartValues))
+		})
+
+		It("should return correct control plane chart values if the CSI driver is enabled", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneChartValues method and check the result
+			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, csiCluster, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("csi-aws", And(
+				HaveKeyWithValue("enabled", true),
+				HaveKeyWithValue("replicas", 1),
+				HaveKeyWithValue("region", "eu-west-1"),
+				HaveKeyWithValue("podAnnotations", map[string]interface{}{
+					"checksum/secret-csi-attacher":    "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+					"checksum/secret-csi-provisioner": "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
+					"checksum/secret-csi-snapshotter": "bf417a6c4f8b3c1e7b5c3ed8a2ab1fa1a1ef8dd2d8e65e1b1ac2e8f4a2a0f5c6",
+					"checksum/secret-cloudprovider":   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
+				}),
+				HaveKeyWithValue("images", And(
+					HaveKey(aws.CSIAttacherImageName),
+					HaveKey(aws.CSIProvisionerImageName),
+					HaveKey(aws.CSISnapshotterImageName),
+					HaveKey(aws.CSIPluginImageName),
+				)),
+			)))
+		})
+	})
+
+	Describe("#GetControlPlaneShootChartValues", func() {
+		It("should return correct control plane shoot chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(controlPlaneShootChartValues))
+		})
+
+		It("should return correct control plane shoot chart values if the CSI driver is enabled", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("csi-aws", And(
+				HaveKeyWithValue("enabled", true),
+				HaveKeyWithValue("images", And(
+					HaveKey(aws.CSINodeDriverRegistrarImageName),
+					HaveKey(aws.CSIPluginImageName),
+				)),
+			)))
 		})
 	})
 })
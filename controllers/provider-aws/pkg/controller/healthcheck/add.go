@@ -0,0 +1,91 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"time"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck/general"
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck/worker"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	machinescheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{
+		SyncPeriod: healthcheck.DefaultSyncPeriod,
+	}
+)
+
+// AddOptions are options to apply when adding the AWS health check controllers to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// SyncPeriod is the period in which the health checks are executed.
+	SyncPeriod time.Duration
+}
+
+// AddToManagerWithOptions adds the health check controllers for ControlPlane and Worker resources with the given
+// Options to the given manager.
+func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
+	if err := machinescheme.AddToScheme(mgr.GetScheme()); err != nil {
+		return err
+	}
+
+	if err := healthcheck.Add(mgr, healthcheck.AddArgs{
+		Type:                   aws.Type,
+		GetExtensionObjectFunc: func() runtime.Object { return &extensionsv1alpha1.ControlPlane{} },
+		HealthChecks: []healthcheck.ConditionTypeToHealthCheck{
+			{
+				ConditionType: gardenv1beta1.ShootControlPlaneHealthy,
+				HealthCheck:   general.NewSeedDeploymentHealthChecker(aws.CloudControllerManagerName),
+			},
+		},
+		SyncPeriod:        opts.SyncPeriod,
+		ControllerOptions: opts.Controller,
+	}); err != nil {
+		return err
+	}
+
+	return healthcheck.Add(mgr, healthcheck.AddArgs{
+		Type:                   aws.Type,
+		GetExtensionObjectFunc: func() runtime.Object { return &extensionsv1alpha1.Worker{} },
+		HealthChecks: []healthcheck.ConditionTypeToHealthCheck{
+			{
+				ConditionType: gardenv1beta1.ShootControlPlaneHealthy,
+				HealthCheck:   general.NewSeedDeploymentHealthChecker(aws.MachineControllerManagerName),
+			},
+			{
+				ConditionType: gardenv1beta1.ShootEveryNodeReady,
+				HealthCheck:   worker.NewNodesChecker(),
+			},
+		},
+		SyncPeriod:        opts.SyncPeriod,
+		ControllerOptions: opts.Controller,
+	})
+}
+
+// AddToManager adds the health check controllers with the default Options.
+func AddToManager(mgr manager.Manager) error {
+	return AddToManagerWithOptions(mgr, DefaultAddOptions)
+}
@@ -16,11 +16,14 @@ package infrastructure
 
 import (
 	"context"
-	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/imagevector"
+	"fmt"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/imagevector"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	glogger "github.com/gardener/gardener/pkg/logger"
@@ -38,7 +41,8 @@ import (
 )
 
 type actuator struct {
-	logger logr.Logger
+	logger           logr.Logger
+	awsClientFactory awsclient.Factory
 
 	restConfig *rest.Config
 
@@ -49,8 +53,15 @@ type actuator struct {
 
 // NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources.
 func NewActuator() infrastructure.Actuator {
+	return NewActuatorWithDeps(log.Log.WithName("infrastructure-actuator"), awsclient.DefaultFactory())
+}
+
+// NewActuatorWithDeps creates a new Actuator with the given dependencies. The AWS client factory is used
+// for Infrastructure resources that are reconciled natively (see aws.InfrastructureReconcilerAnnotation).
+func NewActuatorWithDeps(logger logr.Logger, awsClientFactory awsclient.Factory) infrastructure.Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		logger:           logger,
+		awsClientFactory: awsClientFactory,
 	}
 }
 
@@ -71,19 +82,44 @@ func (a *actuator) InjectConfig(config *rest.Config) error {
 }
 
 func (a *actuator) Reconcile(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	if usesNativeReconciler(config) {
+		return a.reconcileNative(ctx, config)
+	}
 	return a.reconcile(ctx, config, cluster)
 }
 
 func (a *actuator) Delete(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	if usesNativeReconciler(config) {
+		return a.deleteNative(ctx, config)
+	}
 	return a.delete(ctx, config, cluster)
 }
 
+// CanPlan implements infrastructure.Planner.
+//
+// Only infrastructures that are reconciled with the Terraformer can be planned.
+func (a *actuator) CanPlan(config *extensionsv1alpha1.Infrastructure) bool {
+	return !usesNativeReconciler(config)
+}
+
+// Plan implements infrastructure.Planner.
+func (a *actuator) Plan(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (*extensionsterraformer.Plan, error) {
+	if !a.CanPlan(config) {
+		return nil, fmt.Errorf("planning is not supported for natively reconciled infrastructure %s/%s", config.Namespace, config.Name)
+	}
+	return a.plan(ctx, config, cluster)
+}
+
 // Helper functions
 
 func (a *actuator) newTerraformer(purpose, namespace, name string) (*terraformer.Terraformer, error) {
 	return terraformer.NewForConfig(glogger.NewLogger("info"), a.restConfig, purpose, namespace, name, imagevector.TerraformerImage())
 }
 
+func (a *actuator) newPlanTerraformer(purpose, namespace, name string) (extensionsterraformer.Interface, error) {
+	return extensionsterraformer.DefaultFactory().NewForConfig(glogger.NewLogger("info"), a.restConfig, purpose, namespace, name, imagevector.TerraformerImage())
+}
+
 func generateTerraformInfraVariablesEnvironment(secret *corev1.Secret) map[string]string {
 	return terraformer.GenerateVariablesEnvironment(secret, map[string]string{
 		"ACCESS_KEY_ID":     aws.AccessKeyID,
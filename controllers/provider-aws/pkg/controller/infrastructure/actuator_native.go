@@ -0,0 +1,90 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	awsinfrastructure "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/internal/infrastructure"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// usesNativeReconciler returns true if the AWS resources of the given infrastructure shall be reconciled
+// directly via the AWS API instead of with the Terraformer.
+func usesNativeReconciler(infrastructure *extensionsv1alpha1.Infrastructure) bool {
+	return infrastructure.Annotations[aws.InfrastructureReconcilerAnnotation] == aws.InfrastructureReconcilerNative
+}
+
+func (a *actuator) reconcileNative(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) error {
+	infrastructureConfig, reconciler, err := a.newNativeReconciler(ctx, infrastructure)
+	if err != nil {
+		return err
+	}
+
+	output, err := reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+	if err != nil {
+		a.logger.Error(err, "failed to reconcile the AWS resources", "infrastructure", infrastructure.Name)
+		return &controllererrors.RequeueAfterError{
+			Cause:        err,
+			RequeueAfter: 30 * time.Second,
+		}
+	}
+
+	return a.updateProviderStatus(ctx, infrastructure, infrastructureConfig, output)
+}
+
+func (a *actuator) deleteNative(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) error {
+	infrastructureConfig, reconciler, err := a.newNativeReconciler(ctx, infrastructure)
+	if err != nil {
+		return err
+	}
+
+	if err := reconciler.Delete(ctx, infrastructure, infrastructureConfig); err != nil {
+		a.logger.Error(err, "failed to delete the AWS resources", "infrastructure", infrastructure.Name)
+		return &controllererrors.RequeueAfterError{
+			Cause:        err,
+			RequeueAfter: 30 * time.Second,
+		}
+	}
+	return nil
+}
+
+func (a *actuator) newNativeReconciler(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) (*awsapi.InfrastructureConfig, *awsinfrastructure.Reconciler, error) {
+	infrastructureConfig := &awsapi.InfrastructureConfig{}
+	if _, _, err := a.decoder.Decode(infrastructure.Spec.ProviderConfig.Raw, nil, infrastructureConfig); err != nil {
+		return nil, nil, fmt.Errorf("could not decode provider config: %+v", err)
+	}
+
+	providerSecret := &corev1.Secret{}
+	if err := a.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
+		return nil, nil, err
+	}
+
+	awsClient, err := a.awsClientFactory.NewClient(string(providerSecret.Data[aws.AccessKeyID]), string(providerSecret.Data[aws.SecretAccessKey]), infrastructure.Spec.Region)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return infrastructureConfig, awsinfrastructure.NewReconciler(a.logger.WithValues("infrastructure", infrastructure.Name), awsClient), nil
+}
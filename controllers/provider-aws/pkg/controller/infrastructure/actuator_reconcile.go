@@ -28,6 +28,7 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	extensionschartrenderer "github.com/gardener/gardener-extensions/pkg/gardener/chartrenderer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
@@ -41,29 +42,9 @@ import (
 )
 
 func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
-	infrastructureConfig := &awsapi.InfrastructureConfig{}
-	if _, _, err := a.decoder.Decode(infrastructure.Spec.ProviderConfig.Raw, nil, infrastructureConfig); err != nil {
-		return fmt.Errorf("could not decode provider config: %+v", err)
-	}
-
-	providerSecret := &corev1.Secret{}
-	if err := a.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
-		return err
-	}
-
-	terraformConfig, err := generateTerraformInfraConfig(ctx, infrastructure, infrastructureConfig, providerSecret)
-	if err != nil {
-		return fmt.Errorf("failed to generate Terraform config: %+v", err)
-	}
-
-	chartRenderer, err := chartrenderer.NewForConfig(a.restConfig)
-	if err != nil {
-		return fmt.Errorf("could not create chart renderer: %+v", err)
-	}
-
-	release, err := chartRenderer.Render(filepath.Join(aws.InternalChartsPath, "aws-infra"), "aws-infra", infrastructure.Namespace, terraformConfig)
+	infrastructureConfig, providerSecret, release, err := a.renderTerraformChart(ctx, infrastructure)
 	if err != nil {
-		return fmt.Errorf("could not render Terraform chart: %+v", err)
+		return err
 	}
 
 	tf, err := a.newTerraformer(aws.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
@@ -88,7 +69,43 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 		}
 	}
 
-	return a.updateProviderStatus(ctx, tf, infrastructure, infrastructureConfig)
+	output, err := getTerraformOutputVariables(tf, infrastructureConfig)
+	if err != nil {
+		return err
+	}
+
+	return a.updateProviderStatus(ctx, infrastructure, infrastructureConfig, output)
+}
+
+// renderTerraformChart decodes the provider config of the given infrastructure, reads the provider secret and
+// renders the Terraform chart of the infrastructure.
+func (a *actuator) renderTerraformChart(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) (*awsapi.InfrastructureConfig, *corev1.Secret, *chartrenderer.RenderedChart, error) {
+	infrastructureConfig := &awsapi.InfrastructureConfig{}
+	if _, _, err := a.decoder.Decode(infrastructure.Spec.ProviderConfig.Raw, nil, infrastructureConfig); err != nil {
+		return nil, nil, nil, fmt.Errorf("could not decode provider config: %+v", err)
+	}
+
+	providerSecret := &corev1.Secret{}
+	if err := a.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
+		return nil, nil, nil, err
+	}
+
+	terraformConfig, err := generateTerraformInfraConfig(ctx, infrastructure, infrastructureConfig, providerSecret)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate Terraform config: %+v", err)
+	}
+
+	chartRenderer, err := extensionschartrenderer.NewForConfig(a.restConfig)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not create chart renderer: %+v", err)
+	}
+
+	release, err := chartRenderer.Render(filepath.Join(aws.InternalChartsPath, "aws-infra"), "aws-infra", infrastructure.Namespace, terraformConfig)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not render Terraform chart: %+v", err)
+	}
+
+	return infrastructureConfig, providerSecret, release, nil
 }
 
 func generateTerraformInfraConfig(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig, providerSecret *corev1.Secret) (map[string]interface{}, error) {
@@ -162,7 +179,7 @@ func generateTerraformInfraConfig(ctx context.Context, infrastructure *extension
 	}, nil
 }
 
-func (a *actuator) updateProviderStatus(ctx context.Context, tf *terraformer.Terraformer, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig) error {
+func getTerraformOutputVariables(tf *terraformer.Terraformer, infrastructureConfig *awsapi.InfrastructureConfig) (map[string]string, error) {
 	outputVarKeys := []string{
 		aws.VPCIDKey,
 		aws.SSHKeyName,
@@ -176,11 +193,12 @@ func (a *actuator) updateProviderStatus(ctx context.Context, tf *terraformer.Ter
 		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetPublicPrefix, zoneIndex))
 	}
 
-	output, err := tf.GetStateOutputVariables(outputVarKeys...)
-	if err != nil {
-		return err
-	}
+	return tf.GetStateOutputVariables(outputVarKeys...)
+}
 
+// updateProviderStatus updates the provider status of the given infrastructure out of the given output variables
+// of the `aws-infra` Terraform chart or of the native reconciler.
+func (a *actuator) updateProviderStatus(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig, output map[string]string) error {
 	subnets, err := computeProviderStatusSubnets(infrastructureConfig, output)
 	if err != nil {
 		return err
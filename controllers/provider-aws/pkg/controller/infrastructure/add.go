@@ -33,6 +33,8 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// DriftDetection is the configuration of the infrastructure drift detection.
+	DriftDetection infrastructure.DriftDetectionConfig
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -42,6 +44,7 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 		Actuator:          infrastructure.OperationAnnotationWrapper(NewActuator()),
 		ControllerOptions: opts.Controller,
 		Predicates:        infrastructure.DefaultPredicates(mgr.GetClient(), aws.Type, opts.IgnoreOperationAnnotation),
+		DriftDetection:    opts.DriftDetection,
 	})
 }
 
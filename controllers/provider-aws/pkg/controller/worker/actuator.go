@@ -23,6 +23,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker/genericactuator"
+	"github.com/gardener/gardener-extensions/pkg/util"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardener "github.com/gardener/gardener/pkg/client/kubernetes"
@@ -51,7 +52,7 @@ type delegateFactory struct {
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(machineImageToAMIMapping []config.MachineImage) worker.Actuator {
+func NewActuator(machineImageToAMIMapping []config.MachineImage, timeouts worker.TimeoutConfig) worker.Actuator {
 	delegateFactory := &delegateFactory{
 		logger:                   log.Log.WithName("worker-actuator"),
 		machineImageToAMIMapping: machineImageToAMIMapping,
@@ -63,6 +64,7 @@ func NewActuator(machineImageToAMIMapping []config.MachineImage) worker.Actuator
 		mcmChart,
 		mcmShootChart,
 		imagevector.ImageVector(),
+		timeouts,
 	)
 }
 
@@ -93,7 +95,7 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		return nil, err
 	}
 
-	seedChartApplier, err := gardener.NewChartApplierForConfig(d.restConfig)
+	seedChartApplier, err := util.NewChartApplierForConfig(d.restConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -26,7 +26,9 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
 
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -108,20 +110,35 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	deploymentNames, err := worker.NewDeploymentNames(ctx, w.client, w.worker.Namespace)
+	if err != nil {
+		return err
+	}
+
 	for _, pool := range w.worker.Spec.Pools {
-		zoneLen := len(pool.Zones)
+		zoneDistribution, err := worker.NewZoneDistribution(pool.Zones, pool.Annotations)
+		if err != nil {
+			return errors.Wrapf(err, "invalid zone weights of worker pool '%s'", pool.Name)
+		}
 
 		ami, err := confighelper.FindAMIForRegion(w.machineImageToAMIMapping, pool.MachineImage.Name, pool.MachineImage.Version, w.worker.Spec.Region)
 		if err != nil {
 			return err
 		}
 
-		volumeSize, err := worker.DiskSize(pool.Volume.Size)
+		workerConfig := &awsapi.WorkerConfig{}
+		if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
+			if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+				return errors.Wrapf(err, "could not decode provider config of worker pool '%s'", pool.Name)
+			}
+		}
+
+		blockDevices, err := computeBlockDevices(pool, workerConfig)
 		if err != nil {
 			return err
 		}
 
-		for zoneIndex, zone := range pool.Zones {
+		for _, zone := range pool.Zones {
 			nodesSubnet, err := awsapihelper.FindSubnetForPurposeAndZone(infrastructureStatus.VPC.Subnets, awsapi.PurposeNodes, zone)
 			if err != nil {
 				return err
@@ -136,7 +153,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				"networkInterfaces": []map[string]interface{}{
 					{
 						"subnetID":         nodesSubnet.ID,
-						"securityGroupIDs": []string{nodesSecurityGroup.ID},
+						"securityGroupIDs": append([]string{nodesSecurityGroup.ID}, workerConfig.AdditionalSecurityGroupIDs...),
 					},
 				},
 				"tags": map[string]string{
@@ -146,30 +163,29 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				"secret": map[string]interface{}{
 					"cloudConfig": string(pool.UserData),
 				},
-				"blockDevices": []map[string]interface{}{
-					{
-						"ebs": map[string]interface{}{
-							"volumeSize": volumeSize,
-							"volumeType": pool.Volume.Type,
-						},
-					},
-				},
+				"blockDevices": blockDevices,
+			}
+
+			if workerConfig.SpotPrice != nil {
+				machineClassSpec["spotPrice"] = *workerConfig.SpotPrice
 			}
 
 			var (
 				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-				deploymentName       = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
+				deploymentName       = deploymentNames.Name(pool.Name, pool.Zones, zone)
 				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
 				Name:           deploymentName,
+				PoolName:       pool.Name,
+				Zone:           zone,
 				ClassName:      className,
 				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
+				Minimum:        zoneDistribution.Distribute(zone, pool.Minimum),
+				Maximum:        zoneDistribution.Distribute(zone, pool.Maximum),
+				MaxSurge:       zoneDistribution.DistributePositiveIntOrPercent(zone, pool.MaxSurge, pool.Maximum),
+				MaxUnavailable: zoneDistribution.DistributePositiveIntOrPercent(zone, pool.MaxUnavailable, pool.Minimum),
 				Labels:         pool.Labels,
 				Annotations:    pool.Annotations,
 				Taints:         pool.Taints,
@@ -188,3 +204,61 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// computeBlockDevices computes the block device mappings for the machines of the given worker pool. The
+// root volume comes first, the data volumes are mapped to the device names /dev/sdf to /dev/sdp.
+func computeBlockDevices(pool extensionsv1alpha1.WorkerPool, workerConfig *awsapi.WorkerConfig) ([]map[string]interface{}, error) {
+	volumeSize, err := worker.DiskSize(pool.Volume.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	rootEBS := map[string]interface{}{
+		"volumeSize": volumeSize,
+		"volumeType": pool.Volume.Type,
+	}
+	if workerConfig.Volume != nil {
+		addEBSOptions(rootEBS, workerConfig.Volume.IOPS, workerConfig.Volume.Encrypted)
+	}
+
+	blockDevices := []map[string]interface{}{
+		{
+			"ebs": rootEBS,
+		},
+	}
+
+	for i, dataVolume := range workerConfig.DataVolumes {
+		dataVolumeSize, err := worker.DiskSize(dataVolume.Size)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute size of data volume '%s'", dataVolume.Name)
+		}
+
+		dataVolumeType := pool.Volume.Type
+		if dataVolume.Type != nil {
+			dataVolumeType = *dataVolume.Type
+		}
+
+		ebs := map[string]interface{}{
+			"volumeSize":          dataVolumeSize,
+			"volumeType":          dataVolumeType,
+			"deleteOnTermination": true,
+		}
+		addEBSOptions(ebs, dataVolume.IOPS, dataVolume.Encrypted)
+
+		blockDevices = append(blockDevices, map[string]interface{}{
+			"deviceName": fmt.Sprintf("/dev/sd%c", 'f'+i),
+			"ebs":        ebs,
+		})
+	}
+
+	return blockDevices, nil
+}
+
+func addEBSOptions(ebs map[string]interface{}, iops *int64, encrypted *bool) {
+	if iops != nil {
+		ebs["iops"] = int(*iops)
+	}
+	if encrypted != nil {
+		ebs["encrypted"] = *encrypted
+	}
+}
@@ -54,6 +54,8 @@ var _ = Describe("Machines", func() {
 		ctrl = gomock.NewController(GinkgoT())
 
 		c = mockclient.NewMockClient(ctrl)
+		// There are no existing machine deployments whose names must be kept.
+		c.EXPECT().List(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{})).AnyTimes()
 		chartApplier = mockkubernetes.NewMockChartApplier(ctrl)
 	})
 
@@ -341,10 +343,10 @@ var _ = Describe("Machines", func() {
 						},
 					})
 
-					machineClassNamePool1Zone1 = fmt.Sprintf("%s-%s-z1", namespace, namePool1)
-					machineClassNamePool1Zone2 = fmt.Sprintf("%s-%s-z2", namespace, namePool1)
-					machineClassNamePool2Zone1 = fmt.Sprintf("%s-%s-z1", namespace, namePool2)
-					machineClassNamePool2Zone2 = fmt.Sprintf("%s-%s-z2", namespace, namePool2)
+					machineClassNamePool1Zone1 = fmt.Sprintf("%s-%s-%s", namespace, namePool1, zone1)
+					machineClassNamePool1Zone2 = fmt.Sprintf("%s-%s-%s", namespace, namePool1, zone2)
+					machineClassNamePool2Zone1 = fmt.Sprintf("%s-%s-%s", namespace, namePool2, zone1)
+					machineClassNamePool2Zone2 = fmt.Sprintf("%s-%s-%s", namespace, namePool2, zone2)
 
 					machineClassHashPool1Zone1 = worker.MachineClassHash(machineClassPool1Zone1, shootVersionMajorMinor)
 					machineClassHashPool1Zone2 = worker.MachineClassHash(machineClassPool1Zone2, shootVersionMajorMinor)
@@ -386,6 +388,8 @@ var _ = Describe("Machines", func() {
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1Zone1,
+						PoolName:       namePool1,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool1Zone1,
 						SecretName:     machineClassWithHashPool1Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool1, 2),
@@ -395,6 +399,8 @@ var _ = Describe("Machines", func() {
 					},
 					{
 						Name:           machineClassNamePool1Zone2,
+						PoolName:       namePool1,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool1Zone2,
 						SecretName:     machineClassWithHashPool1Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool1, 2),
@@ -404,6 +410,8 @@ var _ = Describe("Machines", func() {
 					},
 					{
 						Name:           machineClassNamePool2Zone1,
+						PoolName:       namePool2,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool2Zone1,
 						SecretName:     machineClassWithHashPool2Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool2, 2),
@@ -413,6 +421,8 @@ var _ = Describe("Machines", func() {
 					},
 					{
 						Name:           machineClassNamePool2Zone2,
+						PoolName:       namePool2,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool2Zone2,
 						SecretName:     machineClassWithHashPool2Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool2, 2),
@@ -427,6 +437,113 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should return the expected machine classes for a pool with provider config", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				var (
+					iops      = int64(3000)
+					encrypted = true
+					dataType  = "gp2"
+					spotPrice = "0.1"
+				)
+
+				w.Spec.Pools = w.Spec.Pools[:1]
+				w.Spec.Pools[0].Zones = []string{zone1}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisaws.WorkerConfig{
+						Volume: &apisaws.Volume{
+							IOPS:      &iops,
+							Encrypted: &encrypted,
+						},
+						DataVolumes: []apisaws.DataVolume{
+							{
+								Name:      "data",
+								Size:      "50Gi",
+								Type:      &dataType,
+								Encrypted: &encrypted,
+							},
+						},
+						SpotPrice:                  &spotPrice,
+						AdditionalSecurityGroupIDs: []string{"sg-additional"},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				var (
+					machineClass = map[string]interface{}{
+						"secret": map[string]interface{}{
+							"cloudConfig": string(userData),
+						},
+						"ami":                machineImageAMI,
+						"region":             region,
+						"machineType":        machineType,
+						"iamInstanceProfile": instanceProfileName,
+						"keyName":            keyName,
+						"networkInterfaces": []map[string]interface{}{
+							{
+								"subnetID":         subnetZone1,
+								"securityGroupIDs": []string{securityGroupID, "sg-additional"},
+							},
+						},
+						"tags": map[string]string{
+							fmt.Sprintf("kubernetes.io/cluster/%s", namespace): "1",
+							"kubernetes.io/role/node":                          "1",
+						},
+						"blockDevices": []map[string]interface{}{
+							{
+								"ebs": map[string]interface{}{
+									"volumeSize": volumeSize,
+									"volumeType": volumeType,
+									"iops":       int(iops),
+									"encrypted":  encrypted,
+								},
+							},
+							{
+								"deviceName": "/dev/sdf",
+								"ebs": map[string]interface{}{
+									"volumeSize":          50,
+									"volumeType":          dataType,
+									"encrypted":           encrypted,
+									"deleteOnTermination": true,
+								},
+							},
+						},
+						"spotPrice": spotPrice,
+					}
+
+					machineClassName     = fmt.Sprintf("%s-%s-%s", namespace, namePool1, zone1)
+					machineClassWithHash = fmt.Sprintf("%s-%s", machineClassName, worker.MachineClassHash(machineClass, shootVersionMajorMinor))
+				)
+
+				addNameAndSecretToMachineClass(machineClass, awsAccessKeyID, awsSecretAccessKey, machineClassWithHash)
+
+				chartApplier.
+					EXPECT().
+					ApplyChart(
+						context.TODO(),
+						filepath.Join(aws.InternalChartsPath, "machineclass"),
+						namespace,
+						"machineclass",
+						map[string]interface{}{"machineClasses": []map[string]interface{}{machineClass}},
+						nil,
+					).
+					Return(nil)
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+			})
+
+			It("should fail because the provider config cannot be decoded", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte("not-decodeable")}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -20,6 +20,8 @@ import (
 	"strings"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	"github.com/gardener/gardener-extensions/pkg/util/chart"
+
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gobuffalo/packr/v2"
 
@@ -29,7 +31,8 @@ import (
 var imageVector imagevector.ImageVector
 
 func init() {
-	box := packr.New("charts", "../../charts")
+	box := packr.New("provider-aws-charts", "../../charts")
+	chart.RegisterSource(chart.NewBoxSource(aws.ChartsPath, box))
 
 	imagesYaml, err := box.FindString("images.yaml")
 	runtime.Must(err)
@@ -0,0 +1,210 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// Delete deletes all AWS resources of the given infrastructure that have been created by Reconcile, including
+// the load balancers and security groups that Kubernetes has created in the VPC for the cluster. Resources
+// that do not exist (anymore) are skipped, hence Delete can be retried safely.
+func (r *Reconciler) Delete(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig) error {
+	var (
+		clusterName = infrastructure.Namespace
+		createdVPC  = infrastructureConfig.Networks.VPC.ID == nil
+		vpcID       string
+	)
+
+	if createdVPC {
+		vpcs, err := r.client.FindVpcsByTags(ctx, tags(clusterName, clusterName))
+		if err != nil {
+			return err
+		}
+		if err := expectAtMostOne("VPC", tags(clusterName, clusterName), len(vpcs)); err != nil {
+			return err
+		}
+		if len(vpcs) == 1 {
+			vpcID = vpcs[0].VpcID
+		}
+	} else {
+		vpcID = *infrastructureConfig.Networks.VPC.ID
+	}
+
+	if vpcID != "" {
+		r.logger.Info("Deleting Kubernetes load balancers and security groups", "infrastructure", infrastructure.Name, "vpc", vpcID)
+		if err := r.deleteKubernetesLoadBalancersAndSecurityGroups(ctx, vpcID, clusterName); err != nil {
+			return err
+		}
+	}
+
+	r.logger.Info("Deleting key pair and IAM resources", "infrastructure", infrastructure.Name)
+	if err := r.client.DeleteKeyPair(ctx, fmt.Sprintf("%s-ssh-publickey", clusterName)); err != nil {
+		return err
+	}
+	for _, name := range []string{fmt.Sprintf("%s-bastions", clusterName), fmt.Sprintf("%s-nodes", clusterName)} {
+		if err := r.deleteIAMRoleWithInstanceProfile(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	r.logger.Info("Deleting route tables, NAT gateways and subnets", "infrastructure", infrastructure.Name)
+	routeTables, err := r.client.FindRouteTablesByTags(ctx, clusterTags(clusterName))
+	if err != nil {
+		return err
+	}
+	for _, routeTable := range routeTables {
+		for _, association := range routeTable.Associations {
+			if err := r.client.DisassociateRouteTable(ctx, association.RouteTableAssociationID); err != nil {
+				return err
+			}
+		}
+		if err := r.client.DeleteRouteTable(ctx, routeTable.RouteTableID); err != nil {
+			return err
+		}
+	}
+
+	natGateways, err := r.client.FindNATGatewaysByTags(ctx, clusterTags(clusterName))
+	if err != nil {
+		return err
+	}
+	for _, natGateway := range natGateways {
+		if err := r.client.DeleteNATGateway(ctx, natGateway.NATGatewayID); err != nil {
+			return err
+		}
+	}
+
+	eips, err := r.client.FindElasticIPsByTags(ctx, clusterTags(clusterName))
+	if err != nil {
+		return err
+	}
+	for _, eip := range eips {
+		if err := r.client.DeleteElasticIP(ctx, eip.AllocationID); err != nil {
+			return err
+		}
+	}
+
+	subnets, err := r.client.FindSubnetsByTags(ctx, clusterTags(clusterName))
+	if err != nil {
+		return err
+	}
+	for _, subnet := range subnets {
+		if err := r.client.DeleteSubnet(ctx, subnet.SubnetID); err != nil {
+			return err
+		}
+	}
+
+	// The nodes security group references the bastions security group, hence it has to be deleted first.
+	for _, name := range []string{fmt.Sprintf("%s-nodes", clusterName), fmt.Sprintf("%s-bastions", clusterName)} {
+		groups, err := r.client.FindSecurityGroupsByTags(ctx, tags(clusterName, name))
+		if err != nil {
+			return err
+		}
+		for _, group := range groups {
+			if err := r.client.DeleteSecurityGroup(ctx, group.GroupID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !createdVPC {
+		return nil
+	}
+
+	r.logger.Info("Deleting VPC", "infrastructure", infrastructure.Name, "vpc", vpcID)
+	internetGateways, err := r.client.FindInternetGatewaysByTags(ctx, tags(clusterName, clusterName))
+	if err != nil {
+		return err
+	}
+	for _, internetGateway := range internetGateways {
+		if internetGateway.VpcID != "" {
+			if err := r.client.DetachInternetGateway(ctx, internetGateway.VpcID, internetGateway.InternetGatewayID); err != nil {
+				return err
+			}
+		}
+		if err := r.client.DeleteInternetGateway(ctx, internetGateway.InternetGatewayID); err != nil {
+			return err
+		}
+	}
+
+	if vpcID != "" {
+		if err := r.client.DeleteVpc(ctx, vpcID); err != nil {
+			return err
+		}
+	}
+
+	dhcpOptions, err := r.client.FindDhcpOptionsByTags(ctx, tags(clusterName, clusterName))
+	if err != nil {
+		return err
+	}
+	for _, options := range dhcpOptions {
+		if err := r.client.DeleteDhcpOptions(ctx, options.DhcpOptionsID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) deleteKubernetesLoadBalancersAndSecurityGroups(ctx context.Context, vpcID, clusterName string) error {
+	loadBalancers, err := r.client.ListKubernetesELBs(ctx, vpcID, clusterName)
+	if err != nil {
+		return err
+	}
+	for _, loadBalancerName := range loadBalancers {
+		if err := r.client.DeleteELB(ctx, loadBalancerName); err != nil {
+			return err
+		}
+	}
+
+	securityGroups, err := r.client.ListKubernetesSecurityGroups(ctx, vpcID, clusterName)
+	if err != nil {
+		return err
+	}
+	for _, securityGroupID := range securityGroups {
+		if err := r.client.DeleteSecurityGroup(ctx, securityGroupID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) deleteIAMRoleWithInstanceProfile(ctx context.Context, name string) error {
+	profile, err := r.client.GetIAMInstanceProfile(ctx, name)
+	if err != nil {
+		return err
+	}
+	if profile != nil {
+		if profile.RoleName != "" {
+			if err := r.client.RemoveRoleFromIAMInstanceProfile(ctx, name, profile.RoleName); err != nil {
+				return err
+			}
+		}
+		if err := r.client.DeleteIAMInstanceProfile(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	if err := r.client.DeleteIAMRolePolicy(ctx, name, name); err != nil {
+		return err
+	}
+	return r.client.DeleteIAMRole(ctx, name)
+}
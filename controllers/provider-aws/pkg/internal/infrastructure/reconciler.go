@@ -0,0 +1,650 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	allIPv4CIDR = "0.0.0.0/0"
+
+	assumeRolePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+	bastionsRolePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:DescribeRegions"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}`
+
+	nodesRolePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:DescribeInstances"
+      ],
+      "Resource": [
+        "*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ecr:GetAuthorizationToken",
+        "ecr:BatchCheckLayerAvailability",
+        "ecr:GetDownloadUrlForLayer",
+        "ecr:GetRepositoryPolicy",
+        "ecr:DescribeRepositories",
+        "ecr:ListImages",
+        "ecr:BatchGetImage"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}`
+)
+
+// Reconciler creates and deletes the AWS infrastructure of a shoot directly via the AWS API. It manages the
+// same resources as the `aws-infra` Terraform chart and identifies them by their `Name` and
+// `kubernetes.io/cluster/<cluster-name>` tags, hence it does not need to keep any state.
+type Reconciler struct {
+	logger logr.Logger
+	client awsclient.Interface
+}
+
+// NewReconciler creates a new Reconciler that uses the given AWS client.
+func NewReconciler(logger logr.Logger, client awsclient.Interface) *Reconciler {
+	return &Reconciler{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Reconcile ensures that all AWS resources of the given infrastructure exist and are configured as desired.
+// It returns the same output variables as the `aws-infra` Terraform chart, keyed by the output keys in the aws package.
+//
+// Rules that have been added to the security groups by others (e.g. by the cloud-controller-manager for load
+// balancers) are kept.
+func (r *Reconciler) Reconcile(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig) (map[string]string, error) {
+	var (
+		clusterName       = infrastructure.Namespace
+		vpcID             string
+		internetGatewayID string
+		err               error
+	)
+
+	switch {
+	case infrastructureConfig.Networks.VPC.ID != nil:
+		vpcID = *infrastructureConfig.Networks.VPC.ID
+		internetGatewayID, err = r.client.GetInternetGateway(ctx, vpcID)
+		if err != nil {
+			return nil, err
+		}
+		if internetGatewayID == "" {
+			return nil, fmt.Errorf("no internet gateway is attached to VPC %s", vpcID)
+		}
+	case infrastructureConfig.Networks.VPC.CIDR != nil:
+		vpcID, internetGatewayID, err = r.ensureVPC(ctx, clusterName, infrastructure.Spec.Region, string(*infrastructureConfig.Networks.VPC.CIDR))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("either the id or the CIDR of the VPC must be specified")
+	}
+
+	r.logger.Info("Ensuring route tables and security groups", "infrastructure", infrastructure.Name, "vpc", vpcID)
+	mainRouteTable, err := r.ensureRouteTable(ctx, &awsclient.RouteTable{VpcID: vpcID, Tags: tags(clusterName, clusterName)})
+	if err != nil {
+		return nil, err
+	}
+	if err := r.ensureRoute(ctx, mainRouteTable, &awsclient.Route{DestinationCidrBlock: allIPv4CIDR, GatewayID: internetGatewayID}); err != nil {
+		return nil, err
+	}
+
+	bastionsSecurityGroup, err := r.ensureSecurityGroup(ctx, &awsclient.SecurityGroup{
+		GroupName:   fmt.Sprintf("%s-bastions", clusterName),
+		Description: "Security group for bastions",
+		VpcID:       vpcID,
+		Tags:        tags(clusterName, fmt.Sprintf("%s-bastions", clusterName)),
+	}, []*awsclient.SecurityGroupRule{
+		{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "tcp", FromPort: 22, ToPort: 22, CidrBlock: allIPv4CIDR},
+		{Type: awsclient.SecurityGroupRuleTypeEgress, Protocol: "-1", CidrBlock: allIPv4CIDR},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nodesSecurityGroup, err := r.ensureSecurityGroup(ctx, &awsclient.SecurityGroup{
+		GroupName:   fmt.Sprintf("%s-nodes", clusterName),
+		Description: "Security group for nodes",
+		VpcID:       vpcID,
+		Tags:        tags(clusterName, fmt.Sprintf("%s-nodes", clusterName)),
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.ensureSecurityGroupRules(ctx, nodesSecurityGroup, nodesSecurityGroupRules(nodesSecurityGroup.GroupID, bastionsSecurityGroup.GroupID, infrastructureConfig.Networks.Zones)); err != nil {
+		return nil, err
+	}
+
+	output := map[string]string{
+		aws.VPCIDKey:            vpcID,
+		aws.SecurityGroupsNodes: nodesSecurityGroup.GroupID,
+	}
+
+	for index, zone := range infrastructureConfig.Networks.Zones {
+		r.logger.Info("Ensuring zone resources", "infrastructure", infrastructure.Name, "zone", zone.Name)
+		nodesSubnetID, publicSubnetID, err := r.ensureZone(ctx, clusterName, vpcID, mainRouteTable.RouteTableID, index, zone)
+		if err != nil {
+			return nil, err
+		}
+		output[fmt.Sprintf("%s%d", aws.SubnetNodesPrefix, index)] = nodesSubnetID
+		output[fmt.Sprintf("%s%d", aws.SubnetPublicPrefix, index)] = publicSubnetID
+	}
+
+	r.logger.Info("Ensuring IAM roles and instance profiles", "infrastructure", infrastructure.Name)
+	if _, err := r.ensureIAMRoleWithInstanceProfile(ctx, fmt.Sprintf("%s-bastions", clusterName), bastionsRolePolicy); err != nil {
+		return nil, err
+	}
+	nodesRole, err := r.ensureIAMRoleWithInstanceProfile(ctx, fmt.Sprintf("%s-nodes", clusterName), nodesRolePolicy)
+	if err != nil {
+		return nil, err
+	}
+	output[aws.IAMInstanceProfileNodes] = nodesRole.RoleName
+	output[aws.NodesRole] = nodesRole.ARN
+
+	r.logger.Info("Ensuring key pair", "infrastructure", infrastructure.Name)
+	keyName := fmt.Sprintf("%s-ssh-publickey", clusterName)
+	if err := r.ensureKeyPair(ctx, keyName, string(infrastructure.Spec.SSHPublicKey)); err != nil {
+		return nil, err
+	}
+	output[aws.SSHKeyName] = keyName
+
+	return output, nil
+}
+
+func (r *Reconciler) ensureVPC(ctx context.Context, clusterName, region, cidr string) (string, string, error) {
+	dhcpDomainName := "ec2.internal"
+	if region != "us-east-1" {
+		dhcpDomainName = fmt.Sprintf("%s.compute.internal", region)
+	}
+
+	r.logger.Info("Ensuring VPC", "cluster", clusterName)
+	dhcpOptions, err := r.findOrCreateDhcpOptions(ctx, &awsclient.DhcpOptions{
+		DhcpConfigurations: map[string][]string{
+			"domain-name":         {dhcpDomainName},
+			"domain-name-servers": {"AmazonProvidedDNS"},
+		},
+		Tags: tags(clusterName, clusterName),
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	vpc, err := r.findOrCreateVpc(ctx, &awsclient.VPC{CidrBlock: cidr, Tags: tags(clusterName, clusterName)})
+	if err != nil {
+		return "", "", err
+	}
+	for _, attribute := range []string{"enableDnsSupport", "enableDnsHostnames"} {
+		if err := r.client.UpdateVpcAttribute(ctx, vpc.VpcID, attribute, true); err != nil {
+			return "", "", err
+		}
+	}
+	if vpc.DhcpOptionsID != dhcpOptions.DhcpOptionsID {
+		if err := r.client.AssociateDhcpOptions(ctx, vpc.VpcID, dhcpOptions.DhcpOptionsID); err != nil {
+			return "", "", err
+		}
+	}
+
+	internetGateway, err := r.findOrCreateInternetGateway(ctx, &awsclient.InternetGateway{Tags: tags(clusterName, clusterName)})
+	if err != nil {
+		return "", "", err
+	}
+	if internetGateway.VpcID != vpc.VpcID {
+		if internetGateway.VpcID != "" {
+			return "", "", fmt.Errorf("internet gateway %s is attached to foreign VPC %s", internetGateway.InternetGatewayID, internetGateway.VpcID)
+		}
+		if err := r.client.AttachInternetGateway(ctx, vpc.VpcID, internetGateway.InternetGatewayID); err != nil {
+			return "", "", err
+		}
+	}
+
+	return vpc.VpcID, internetGateway.InternetGatewayID, nil
+}
+
+func (r *Reconciler) ensureZone(ctx context.Context, clusterName, vpcID, mainRouteTableID string, index int, zone awsapi.Zone) (string, string, error) {
+	nodesSubnet, err := r.ensureSubnet(ctx, &awsclient.Subnet{
+		VpcID:            vpcID,
+		CidrBlock:        string(zone.Workers),
+		AvailabilityZone: zone.Name,
+		Tags:             tags(clusterName, fmt.Sprintf("%s-nodes-z%d", clusterName, index)),
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	privateSubnetTags := tags(clusterName, fmt.Sprintf("%s-private-utility-z%d", clusterName, index))
+	privateSubnetTags["kubernetes.io/role/internal-elb"] = "use"
+	privateSubnet, err := r.ensureSubnet(ctx, &awsclient.Subnet{
+		VpcID:            vpcID,
+		CidrBlock:        string(zone.Internal),
+		AvailabilityZone: zone.Name,
+		Tags:             privateSubnetTags,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	publicSubnetTags := tags(clusterName, fmt.Sprintf("%s-public-utility-z%d", clusterName, index))
+	publicSubnetTags["kubernetes.io/role/elb"] = "use"
+	publicSubnet, err := r.ensureSubnet(ctx, &awsclient.Subnet{
+		VpcID:            vpcID,
+		CidrBlock:        string(zone.Public),
+		AvailabilityZone: zone.Name,
+		Tags:             publicSubnetTags,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	eip, err := r.findOrCreateElasticIP(ctx, &awsclient.ElasticIP{Tags: tags(clusterName, fmt.Sprintf("%s-eip-natgw-z%d", clusterName, index))})
+	if err != nil {
+		return "", "", err
+	}
+	natGateway, err := r.findOrCreateNATGateway(ctx, &awsclient.NATGateway{
+		EIPAllocationID: eip.AllocationID,
+		SubnetID:        publicSubnet.SubnetID,
+		Tags:            tags(clusterName, fmt.Sprintf("%s-natgw-z%d", clusterName, index)),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	if err := r.client.WaitForNATGatewayAvailable(ctx, natGateway.NATGatewayID); err != nil {
+		return "", "", err
+	}
+
+	privateRouteTable, err := r.ensureRouteTable(ctx, &awsclient.RouteTable{VpcID: vpcID, Tags: tags(clusterName, fmt.Sprintf("%s-private-%s", clusterName, zone.Name))})
+	if err != nil {
+		return "", "", err
+	}
+	if err := r.ensureRoute(ctx, privateRouteTable, &awsclient.Route{DestinationCidrBlock: allIPv4CIDR, NatGatewayID: natGateway.NATGatewayID}); err != nil {
+		return "", "", err
+	}
+
+	for subnetID, routeTableID := range map[string]string{
+		privateSubnet.SubnetID: privateRouteTable.RouteTableID,
+		publicSubnet.SubnetID:  mainRouteTableID,
+		nodesSubnet.SubnetID:   privateRouteTable.RouteTableID,
+	} {
+		if err := r.ensureRouteTableAssociation(ctx, clusterName, routeTableID, subnetID); err != nil {
+			return "", "", err
+		}
+	}
+
+	return nodesSubnet.SubnetID, publicSubnet.SubnetID, nil
+}
+
+func nodesSecurityGroupRules(nodesSecurityGroupID, bastionsSecurityGroupID string, zones []awsapi.Zone) []*awsclient.SecurityGroupRule {
+	rules := []*awsclient.SecurityGroupRule{
+		{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "-1", SourceSecurityGroupID: nodesSecurityGroupID},
+		{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "tcp", FromPort: 30000, ToPort: 32767, CidrBlock: allIPv4CIDR},
+		{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "udp", FromPort: 30000, ToPort: 32767, CidrBlock: allIPv4CIDR},
+		{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "tcp", FromPort: 22, ToPort: 22, SourceSecurityGroupID: bastionsSecurityGroupID},
+		{Type: awsclient.SecurityGroupRuleTypeEgress, Protocol: "-1", CidrBlock: allIPv4CIDR},
+	}
+	for _, zone := range zones {
+		for _, cidr := range []string{string(zone.Internal), string(zone.Public)} {
+			for _, protocol := range []string{"tcp", "udp"} {
+				rules = append(rules, &awsclient.SecurityGroupRule{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: protocol, FromPort: 30000, ToPort: 32767, CidrBlock: cidr})
+			}
+		}
+	}
+	return rules
+}
+
+func (r *Reconciler) findOrCreateDhcpOptions(ctx context.Context, desired *awsclient.DhcpOptions) (*awsclient.DhcpOptions, error) {
+	existing, err := r.client.FindDhcpOptionsByTags(ctx, desired.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := expectAtMostOne("DHCP options", desired.Tags, len(existing)); err != nil {
+		return nil, err
+	}
+	if len(existing) == 1 {
+		return existing[0], nil
+	}
+	return r.client.CreateDhcpOptions(ctx, desired)
+}
+
+func (r *Reconciler) findOrCreateVpc(ctx context.Context, desired *awsclient.VPC) (*awsclient.VPC, error) {
+	existing, err := r.client.FindVpcsByTags(ctx, desired.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := expectAtMostOne("VPC", desired.Tags, len(existing)); err != nil {
+		return nil, err
+	}
+	if len(existing) == 1 {
+		if existing[0].CidrBlock != desired.CidrBlock {
+			return nil, fmt.Errorf("VPC %s has CIDR %s instead of %s, changing the CIDR of a VPC is not supported", existing[0].VpcID, existing[0].CidrBlock, desired.CidrBlock)
+		}
+		return existing[0], nil
+	}
+	return r.client.CreateVpc(ctx, desired)
+}
+
+func (r *Reconciler) findOrCreateInternetGateway(ctx context.Context, desired *awsclient.InternetGateway) (*awsclient.InternetGateway, error) {
+	existing, err := r.client.FindInternetGatewaysByTags(ctx, desired.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := expectAtMostOne("internet gateway", desired.Tags, len(existing)); err != nil {
+		return nil, err
+	}
+	if len(existing) == 1 {
+		return existing[0], nil
+	}
+	return r.client.CreateInternetGateway(ctx, desired)
+}
+
+func (r *Reconciler) ensureSubnet(ctx context.Context, desired *awsclient.Subnet) (*awsclient.Subnet, error) {
+	existing, err := r.client.FindSubnetsByTags(ctx, identifyingTags(desired.Tags))
+	if err != nil {
+		return nil, err
+	}
+	if err := expectAtMostOne("subnet", identifyingTags(desired.Tags), len(existing)); err != nil {
+		return nil, err
+	}
+	if len(existing) == 1 {
+		subnet := existing[0]
+		if subnet.VpcID != desired.VpcID || subnet.CidrBlock != desired.CidrBlock || subnet.AvailabilityZone != desired.AvailabilityZone {
+			return nil, fmt.Errorf("subnet %s (%s in %s) does not match the desired subnet %s in %s, changing subnets is not supported",
+				subnet.SubnetID, subnet.CidrBlock, subnet.AvailabilityZone, desired.CidrBlock, desired.AvailabilityZone)
+		}
+		return subnet, nil
+	}
+	return r.client.CreateSubnet(ctx, desired)
+}
+
+func (r *Reconciler) ensureRouteTable(ctx context.Context, desired *awsclient.RouteTable) (*awsclient.RouteTable, error) {
+	existing, err := r.client.FindRouteTablesByTags(ctx, desired.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := expectAtMostOne("route table", desired.Tags, len(existing)); err != nil {
+		return nil, err
+	}
+	if len(existing) == 1 {
+		return existing[0], nil
+	}
+	return r.client.CreateRouteTable(ctx, desired)
+}
+
+func (r *Reconciler) ensureRoute(ctx context.Context, routeTable *awsclient.RouteTable, desired *awsclient.Route) error {
+	for _, route := range routeTable.Routes {
+		if route.DestinationCidrBlock != desired.DestinationCidrBlock {
+			continue
+		}
+		if *route == *desired {
+			return nil
+		}
+		if err := r.client.DeleteRoute(ctx, routeTable.RouteTableID, route); err != nil {
+			return err
+		}
+	}
+	return r.client.CreateRoute(ctx, routeTable.RouteTableID, desired)
+}
+
+func (r *Reconciler) ensureRouteTableAssociation(ctx context.Context, clusterName, routeTableID, subnetID string) error {
+	routeTables, err := r.client.FindRouteTablesByTags(ctx, clusterTags(clusterName))
+	if err != nil {
+		return err
+	}
+
+	for _, routeTable := range routeTables {
+		for _, association := range routeTable.Associations {
+			if association.SubnetID != subnetID {
+				continue
+			}
+			if routeTable.RouteTableID == routeTableID {
+				return nil
+			}
+			if err := r.client.DisassociateRouteTable(ctx, association.RouteTableAssociationID); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = r.client.AssociateRouteTable(ctx, routeTableID, subnetID)
+	return err
+}
+
+func (r *Reconciler) ensureSecurityGroup(ctx context.Context, desired *awsclient.SecurityGroup, rules []*awsclient.SecurityGroupRule) (*awsclient.SecurityGroup, error) {
+	existing, err := r.client.FindSecurityGroupsByTags(ctx, desired.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := expectAtMostOne("security group", desired.Tags, len(existing)); err != nil {
+		return nil, err
+	}
+
+	group := desired
+	if len(existing) == 1 {
+		group = existing[0]
+	} else {
+		if group, err = r.client.CreateSecurityGroup(ctx, desired); err != nil {
+			return nil, err
+		}
+	}
+
+	return group, r.ensureSecurityGroupRules(ctx, group, rules)
+}
+
+func (r *Reconciler) ensureSecurityGroupRules(ctx context.Context, group *awsclient.SecurityGroup, rules []*awsclient.SecurityGroupRule) error {
+	var missing []*awsclient.SecurityGroupRule
+	for _, rule := range rules {
+		if !containsRule(group.Rules, rule) {
+			missing = append(missing, rule)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return r.client.AuthorizeSecurityGroupRules(ctx, group.GroupID, missing)
+}
+
+func (r *Reconciler) findOrCreateElasticIP(ctx context.Context, desired *awsclient.ElasticIP) (*awsclient.ElasticIP, error) {
+	existing, err := r.client.FindElasticIPsByTags(ctx, desired.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := expectAtMostOne("elastic IP", desired.Tags, len(existing)); err != nil {
+		return nil, err
+	}
+	if len(existing) == 1 {
+		return existing[0], nil
+	}
+	return r.client.CreateElasticIP(ctx, desired)
+}
+
+func (r *Reconciler) findOrCreateNATGateway(ctx context.Context, desired *awsclient.NATGateway) (*awsclient.NATGateway, error) {
+	existing, err := r.client.FindNATGatewaysByTags(ctx, desired.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := expectAtMostOne("NAT gateway", desired.Tags, len(existing)); err != nil {
+		return nil, err
+	}
+	if len(existing) == 1 {
+		return existing[0], nil
+	}
+	return r.client.CreateNATGateway(ctx, desired)
+}
+
+func (r *Reconciler) ensureIAMRoleWithInstanceProfile(ctx context.Context, name, policy string) (*awsclient.IAMRole, error) {
+	role, err := r.client.GetIAMRole(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		if role, err = r.client.CreateIAMRole(ctx, &awsclient.IAMRole{RoleName: name, Path: "/", AssumeRolePolicyDocument: assumeRolePolicy}); err != nil {
+			return nil, err
+		}
+	} else if !policiesEqual(role.AssumeRolePolicyDocument, assumeRolePolicy) {
+		if err := r.client.UpdateAssumeRolePolicy(ctx, name, assumeRolePolicy); err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := r.client.GetIAMInstanceProfile(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		if profile, err = r.client.CreateIAMInstanceProfile(ctx, &awsclient.IAMInstanceProfile{InstanceProfileName: name, Path: "/"}); err != nil {
+			return nil, err
+		}
+	}
+	if profile.RoleName != name {
+		if profile.RoleName != "" {
+			if err := r.client.RemoveRoleFromIAMInstanceProfile(ctx, name, profile.RoleName); err != nil {
+				return nil, err
+			}
+		}
+		if err := r.client.AddRoleToIAMInstanceProfile(ctx, name, name); err != nil {
+			return nil, err
+		}
+	}
+
+	rolePolicy, err := r.client.GetIAMRolePolicy(ctx, name, name)
+	if err != nil {
+		return nil, err
+	}
+	if rolePolicy == nil || !policiesEqual(rolePolicy.PolicyDocument, policy) {
+		if err := r.client.PutIAMRolePolicy(ctx, &awsclient.IAMRolePolicy{RoleName: name, PolicyName: name, PolicyDocument: policy}); err != nil {
+			return nil, err
+		}
+	}
+
+	return role, nil
+}
+
+func (r *Reconciler) ensureKeyPair(ctx context.Context, keyName, publicKey string) error {
+	fingerprint, err := awsclient.KeyPairFingerprint(publicKey)
+	if err != nil {
+		return fmt.Errorf("could not compute fingerprint of SSH public key: %+v", err)
+	}
+
+	keyPair, err := r.client.GetKeyPair(ctx, keyName)
+	if err != nil {
+		return err
+	}
+	if keyPair != nil {
+		if keyPair.KeyFingerprint == fingerprint {
+			return nil
+		}
+		if err := r.client.DeleteKeyPair(ctx, keyName); err != nil {
+			return err
+		}
+	}
+
+	_, err = r.client.ImportKeyPair(ctx, keyName, publicKey)
+	return err
+}
+
+func tags(clusterName, name string) awsclient.Tags {
+	t := clusterTags(clusterName)
+	t["Name"] = name
+	return t
+}
+
+func clusterTags(clusterName string) awsclient.Tags {
+	return awsclient.Tags{fmt.Sprintf("kubernetes.io/cluster/%s", clusterName): "1"}
+}
+
+// identifyingTags returns the tags that identify a resource, i.e. its name and cluster tags.
+func identifyingTags(t awsclient.Tags) awsclient.Tags {
+	out := awsclient.Tags{}
+	for key, value := range t {
+		if key == "Name" || strings.HasPrefix(key, "kubernetes.io/cluster/") {
+			out[key] = value
+		}
+	}
+	return out
+}
+
+func expectAtMostOne(kind string, t awsclient.Tags, count int) error {
+	if count > 1 {
+		return fmt.Errorf("found %d %s resources with tags %v, expected at most one", count, kind, t)
+	}
+	return nil
+}
+
+func containsRule(rules []*awsclient.SecurityGroupRule, rule *awsclient.SecurityGroupRule) bool {
+	for _, r := range rules {
+		if *r == *rule {
+			return true
+		}
+	}
+	return false
+}
+
+// policiesEqual returns true if the given JSON policy documents are semantically equal.
+func policiesEqual(a, b string) bool {
+	var aObj, bObj interface{}
+	if err := json.Unmarshal([]byte(a), &aObj); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bObj); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(aObj, bObj)
+}
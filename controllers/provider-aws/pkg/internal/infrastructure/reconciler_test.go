@@ -0,0 +1,391 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client/fake"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/internal/infrastructure"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+const clusterName = "shoot--foo--bar"
+
+func newSSHPublicKey() []byte {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	Expect(err).NotTo(HaveOccurred())
+	publicKey, err := ssh.NewPublicKey(&privateKey.PublicKey)
+	Expect(err).NotTo(HaveOccurred())
+	return ssh.MarshalAuthorizedKey(publicKey)
+}
+
+func cidr(s string) *gardencore.CIDR {
+	c := gardencore.CIDR(s)
+	return &c
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx context.Context
+
+		awsClient  *fake.Client
+		reconciler *Reconciler
+
+		infrastructure       *extensionsv1alpha1.Infrastructure
+		infrastructureConfig *awsapi.InfrastructureConfig
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+
+		awsClient = fake.NewClient()
+		reconciler = NewReconciler(log.Log, awsClient)
+
+		infrastructure = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: clusterName, Name: "infrastructure"},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				Region:       "eu-west-1",
+				SSHPublicKey: newSSHPublicKey(),
+			},
+		}
+		infrastructureConfig = &awsapi.InfrastructureConfig{
+			Networks: awsapi.Networks{
+				VPC: awsapi.VPC{CIDR: cidr("10.250.0.0/16")},
+				Zones: []awsapi.Zone{
+					{Name: "eu-west-1a", Workers: "10.250.0.0/19", Internal: "10.250.112.0/22", Public: "10.250.96.0/22"},
+					{Name: "eu-west-1b", Workers: "10.250.32.0/19", Internal: "10.250.116.0/22", Public: "10.250.100.0/22"},
+				},
+			},
+		}
+	})
+
+	findSubnet := func(name string) *awsclient.Subnet {
+		for _, subnet := range awsClient.EC2.Subnets {
+			if subnet.Tags["Name"] == name {
+				return subnet
+			}
+		}
+		return nil
+	}
+
+	findRouteTable := func(name string) *awsclient.RouteTable {
+		for _, routeTable := range awsClient.EC2.RouteTables {
+			if routeTable.Tags["Name"] == name {
+				return routeTable
+			}
+		}
+		return nil
+	}
+
+	findSecurityGroup := func(name string) *awsclient.SecurityGroup {
+		for _, group := range awsClient.EC2.SecurityGroups {
+			if group.GroupName == name {
+				return group
+			}
+		}
+		return nil
+	}
+
+	associatedSubnets := func(routeTable *awsclient.RouteTable) []string {
+		var subnetIDs []string
+		for _, association := range routeTable.Associations {
+			subnetIDs = append(subnetIDs, association.SubnetID)
+		}
+		return subnetIDs
+	}
+
+	Describe("#Reconcile", func() {
+		It("should create all resources of the infrastructure", func() {
+			output, err := reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(awsClient.EC2.Vpcs).To(HaveLen(1))
+			var vpc *awsclient.VPC
+			for _, v := range awsClient.EC2.Vpcs {
+				vpc = v
+			}
+			Expect(vpc.CidrBlock).To(Equal("10.250.0.0/16"))
+			Expect(vpc.Tags).To(Equal(awsclient.Tags{"Name": clusterName, "kubernetes.io/cluster/" + clusterName: "1"}))
+			Expect(awsClient.EC2.VpcAttributes[vpc.VpcID]).To(Equal(map[string]bool{"enableDnsSupport": true, "enableDnsHostnames": true}))
+
+			Expect(awsClient.EC2.DhcpOptions).To(HaveKey(vpc.DhcpOptionsID))
+			Expect(awsClient.EC2.DhcpOptions[vpc.DhcpOptionsID].DhcpConfigurations).To(Equal(map[string][]string{
+				"domain-name":         {"eu-west-1.compute.internal"},
+				"domain-name-servers": {"AmazonProvidedDNS"},
+			}))
+
+			Expect(awsClient.EC2.InternetGateways).To(HaveLen(1))
+			var internetGatewayID string
+			for id, internetGateway := range awsClient.EC2.InternetGateways {
+				internetGatewayID = id
+				Expect(internetGateway.VpcID).To(Equal(vpc.VpcID))
+			}
+
+			Expect(awsClient.EC2.Subnets).To(HaveLen(6))
+			Expect(awsClient.EC2.NATGateways).To(HaveLen(2))
+			Expect(awsClient.EC2.ElasticIPs).To(HaveLen(2))
+			Expect(awsClient.EC2.RouteTables).To(HaveLen(3))
+			Expect(awsClient.EC2.SecurityGroups).To(HaveLen(2))
+
+			mainRouteTable := findRouteTable(clusterName)
+			Expect(mainRouteTable).NotTo(BeNil())
+			Expect(mainRouteTable.Routes).To(ContainElement(&awsclient.Route{DestinationCidrBlock: "0.0.0.0/0", GatewayID: internetGatewayID}))
+
+			for index, zone := range infrastructureConfig.Networks.Zones {
+				nodesSubnet := findSubnet(fmt.Sprintf("%s-nodes-z%d", clusterName, index))
+				privateSubnet := findSubnet(fmt.Sprintf("%s-private-utility-z%d", clusterName, index))
+				publicSubnet := findSubnet(fmt.Sprintf("%s-public-utility-z%d", clusterName, index))
+				Expect(nodesSubnet.CidrBlock).To(Equal(string(zone.Workers)))
+				Expect(nodesSubnet.AvailabilityZone).To(Equal(zone.Name))
+				Expect(privateSubnet.CidrBlock).To(Equal(string(zone.Internal)))
+				Expect(privateSubnet.Tags).To(HaveKeyWithValue("kubernetes.io/role/internal-elb", "use"))
+				Expect(publicSubnet.CidrBlock).To(Equal(string(zone.Public)))
+				Expect(publicSubnet.Tags).To(HaveKeyWithValue("kubernetes.io/role/elb", "use"))
+
+				privateRouteTable := findRouteTable(fmt.Sprintf("%s-private-%s", clusterName, zone.Name))
+				Expect(privateRouteTable).NotTo(BeNil())
+				Expect(privateRouteTable.Routes).To(HaveLen(2))
+				natGateway := awsClient.EC2.NATGateways[privateRouteTable.Routes[1].NatGatewayID]
+				Expect(natGateway).NotTo(BeNil())
+				Expect(natGateway.SubnetID).To(Equal(publicSubnet.SubnetID))
+				Expect(awsClient.EC2.ElasticIPs).To(HaveKey(natGateway.EIPAllocationID))
+
+				Expect(associatedSubnets(privateRouteTable)).To(ConsistOf(privateSubnet.SubnetID, nodesSubnet.SubnetID))
+				Expect(associatedSubnets(mainRouteTable)).To(ContainElement(publicSubnet.SubnetID))
+
+				Expect(output).To(HaveKeyWithValue(fmt.Sprintf("%s%d", aws.SubnetNodesPrefix, index), nodesSubnet.SubnetID))
+				Expect(output).To(HaveKeyWithValue(fmt.Sprintf("%s%d", aws.SubnetPublicPrefix, index), publicSubnet.SubnetID))
+			}
+
+			bastions := findSecurityGroup(clusterName + "-bastions")
+			nodes := findSecurityGroup(clusterName + "-nodes")
+			Expect(bastions.Rules).To(ConsistOf(
+				&awsclient.SecurityGroupRule{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "tcp", FromPort: 22, ToPort: 22, CidrBlock: "0.0.0.0/0"},
+				&awsclient.SecurityGroupRule{Type: awsclient.SecurityGroupRuleTypeEgress, Protocol: "-1", CidrBlock: "0.0.0.0/0"},
+			))
+			Expect(nodes.Rules).To(HaveLen(13))
+			Expect(nodes.Rules).To(ContainElement(&awsclient.SecurityGroupRule{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "-1", SourceSecurityGroupID: nodes.GroupID}))
+			Expect(nodes.Rules).To(ContainElement(&awsclient.SecurityGroupRule{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "tcp", FromPort: 22, ToPort: 22, SourceSecurityGroupID: bastions.GroupID}))
+			Expect(nodes.Rules).To(ContainElement(&awsclient.SecurityGroupRule{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "udp", FromPort: 30000, ToPort: 32767, CidrBlock: "10.250.100.0/22"}))
+
+			Expect(awsClient.IAM.Roles).To(HaveLen(2))
+			Expect(awsClient.IAM.InstanceProfiles).To(HaveKeyWithValue(clusterName+"-nodes", &awsclient.IAMInstanceProfile{InstanceProfileName: clusterName + "-nodes", Path: "/", RoleName: clusterName + "-nodes"}))
+			Expect(awsClient.IAM.InstanceProfiles).To(HaveKeyWithValue(clusterName+"-bastions", &awsclient.IAMInstanceProfile{InstanceProfileName: clusterName + "-bastions", Path: "/", RoleName: clusterName + "-bastions"}))
+			Expect(awsClient.IAM.RolePolicies[clusterName+"-nodes"]).To(HaveKey(clusterName + "-nodes"))
+			Expect(awsClient.IAM.RolePolicies[clusterName+"-nodes"][clusterName+"-nodes"].PolicyDocument).To(ContainSubstring("ecr:BatchGetImage"))
+			Expect(awsClient.IAM.RolePolicies[clusterName+"-bastions"][clusterName+"-bastions"].PolicyDocument).To(ContainSubstring("ec2:DescribeRegions"))
+
+			Expect(awsClient.EC2.KeyPairs).To(HaveKey(clusterName + "-ssh-publickey"))
+
+			Expect(output).To(HaveLen(9))
+			Expect(output).To(HaveKeyWithValue(aws.VPCIDKey, vpc.VpcID))
+			Expect(output).To(HaveKeyWithValue(aws.SecurityGroupsNodes, nodes.GroupID))
+			Expect(output).To(HaveKeyWithValue(aws.IAMInstanceProfileNodes, clusterName+"-nodes"))
+			Expect(output).To(HaveKeyWithValue(aws.NodesRole, awsClient.IAM.Roles[clusterName+"-nodes"].ARN))
+			Expect(output).To(HaveKeyWithValue(aws.SSHKeyName, clusterName+"-ssh-publickey"))
+		})
+
+		It("should be idempotent", func() {
+			output, err := reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			secondOutput, err := reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(secondOutput).To(Equal(output))
+			Expect(awsClient.EC2.Vpcs).To(HaveLen(1))
+			Expect(awsClient.EC2.DhcpOptions).To(HaveLen(1))
+			Expect(awsClient.EC2.InternetGateways).To(HaveLen(1))
+			Expect(awsClient.EC2.Subnets).To(HaveLen(6))
+			Expect(awsClient.EC2.NATGateways).To(HaveLen(2))
+			Expect(awsClient.EC2.ElasticIPs).To(HaveLen(2))
+			Expect(awsClient.EC2.RouteTables).To(HaveLen(3))
+			Expect(awsClient.EC2.SecurityGroups).To(HaveLen(2))
+			Expect(findSecurityGroup(clusterName + "-nodes").Rules).To(HaveLen(13))
+		})
+
+		It("should use an existing VPC and its internet gateway", func() {
+			vpc, err := awsClient.CreateVpc(ctx, &awsclient.VPC{CidrBlock: "10.0.0.0/16"})
+			Expect(err).NotTo(HaveOccurred())
+			internetGateway, err := awsClient.CreateInternetGateway(ctx, &awsclient.InternetGateway{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(awsClient.AttachInternetGateway(ctx, vpc.VpcID, internetGateway.InternetGatewayID)).To(Succeed())
+
+			infrastructureConfig.Networks.VPC = awsapi.VPC{ID: &vpc.VpcID}
+
+			output, err := reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(output).To(HaveKeyWithValue(aws.VPCIDKey, vpc.VpcID))
+			Expect(awsClient.EC2.Vpcs).To(HaveLen(1))
+			Expect(awsClient.EC2.DhcpOptions).To(BeEmpty())
+			Expect(awsClient.EC2.InternetGateways).To(HaveLen(1))
+			Expect(findRouteTable(clusterName).Routes).To(ContainElement(&awsclient.Route{DestinationCidrBlock: "0.0.0.0/0", GatewayID: internetGateway.InternetGatewayID}))
+		})
+
+		It("should fail if the existing VPC has no internet gateway", func() {
+			vpc, err := awsClient.CreateVpc(ctx, &awsclient.VPC{CidrBlock: "10.0.0.0/16"})
+			Expect(err).NotTo(HaveOccurred())
+
+			infrastructureConfig.Networks.VPC = awsapi.VPC{ID: &vpc.VpcID}
+
+			_, err = reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should restore missing rules and keep foreign rules of the nodes security group", func() {
+			_, err := reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			nodes := findSecurityGroup(clusterName + "-nodes")
+			nodePortRule := &awsclient.SecurityGroupRule{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "tcp", FromPort: 30000, ToPort: 32767, CidrBlock: "0.0.0.0/0"}
+			foreignRule := &awsclient.SecurityGroupRule{Type: awsclient.SecurityGroupRuleTypeIngress, Protocol: "tcp", FromPort: 443, ToPort: 443, SourceSecurityGroupID: "sg-elb"}
+			Expect(awsClient.RevokeSecurityGroupRules(ctx, nodes.GroupID, []*awsclient.SecurityGroupRule{nodePortRule})).To(Succeed())
+			Expect(awsClient.AuthorizeSecurityGroupRules(ctx, nodes.GroupID, []*awsclient.SecurityGroupRule{foreignRule})).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			nodes = findSecurityGroup(clusterName + "-nodes")
+			Expect(nodes.Rules).To(HaveLen(14))
+			Expect(nodes.Rules).To(ContainElement(nodePortRule))
+			Expect(nodes.Rules).To(ContainElement(foreignRule))
+		})
+
+		It("should correct drifted routes and route table associations", func() {
+			_, err := reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			mainRouteTable := findRouteTable(clusterName)
+			privateRouteTable := findRouteTable(clusterName + "-private-eu-west-1a")
+			publicSubnet := findSubnet(clusterName + "-public-utility-z0")
+			for _, association := range mainRouteTable.Associations {
+				if association.SubnetID == publicSubnet.SubnetID {
+					Expect(awsClient.DisassociateRouteTable(ctx, association.RouteTableAssociationID)).To(Succeed())
+				}
+			}
+			_, err = awsClient.AssociateRouteTable(ctx, privateRouteTable.RouteTableID, publicSubnet.SubnetID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(awsClient.DeleteRoute(ctx, mainRouteTable.RouteTableID, &awsclient.Route{DestinationCidrBlock: "0.0.0.0/0"})).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			mainRouteTable = findRouteTable(clusterName)
+			Expect(mainRouteTable.Routes).To(HaveLen(2))
+			Expect(associatedSubnets(mainRouteTable)).To(ContainElement(publicSubnet.SubnetID))
+			Expect(associatedSubnets(findRouteTable(clusterName + "-private-eu-west-1a"))).NotTo(ContainElement(publicSubnet.SubnetID))
+		})
+
+		It("should replace the key pair if the SSH public key has changed", func() {
+			_, err := reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+			oldFingerprint := awsClient.EC2.KeyPairs[clusterName+"-ssh-publickey"].KeyFingerprint
+
+			infrastructure.Spec.SSHPublicKey = newSSHPublicKey()
+			_, err = reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			newFingerprint, err := awsclient.KeyPairFingerprint(string(infrastructure.Spec.SSHPublicKey))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(awsClient.EC2.KeyPairs[clusterName+"-ssh-publickey"].KeyFingerprint).To(Equal(newFingerprint))
+			Expect(newFingerprint).NotTo(Equal(oldFingerprint))
+		})
+
+		It("should fail if a subnet CIDR has been changed", func() {
+			_, err := reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			infrastructureConfig.Networks.Zones[0].Workers = "10.250.64.0/19"
+			_, err = reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete all resources of the infrastructure", func() {
+			_, err := reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.Delete(ctx, infrastructure, infrastructureConfig)).To(Succeed())
+
+			Expect(awsClient.EC2.Vpcs).To(BeEmpty())
+			Expect(awsClient.EC2.DhcpOptions).To(BeEmpty())
+			Expect(awsClient.EC2.InternetGateways).To(BeEmpty())
+			Expect(awsClient.EC2.Subnets).To(BeEmpty())
+			Expect(awsClient.EC2.NATGateways).To(BeEmpty())
+			Expect(awsClient.EC2.ElasticIPs).To(BeEmpty())
+			Expect(awsClient.EC2.RouteTables).To(BeEmpty())
+			Expect(awsClient.EC2.SecurityGroups).To(BeEmpty())
+			Expect(awsClient.EC2.KeyPairs).To(BeEmpty())
+			Expect(awsClient.IAM.Roles).To(BeEmpty())
+			Expect(awsClient.IAM.InstanceProfiles).To(BeEmpty())
+			Expect(awsClient.IAM.RolePolicies).To(BeEmpty())
+
+			Expect(reconciler.Delete(ctx, infrastructure, infrastructureConfig)).To(Succeed())
+		})
+
+		It("should delete the Kubernetes security groups of the cluster", func() {
+			output, err := reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = awsClient.CreateSecurityGroup(ctx, &awsclient.SecurityGroup{
+				GroupName: "k8s-elb-foo",
+				VpcID:     output[aws.VPCIDKey],
+				Tags:      awsclient.Tags{"kubernetes.io/cluster/" + clusterName: "owned"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.Delete(ctx, infrastructure, infrastructureConfig)).To(Succeed())
+
+			Expect(awsClient.EC2.SecurityGroups).To(BeEmpty())
+			Expect(awsClient.EC2.Vpcs).To(BeEmpty())
+		})
+
+		It("should keep an existing VPC and its internet gateway", func() {
+			vpc, err := awsClient.CreateVpc(ctx, &awsclient.VPC{CidrBlock: "10.0.0.0/16"})
+			Expect(err).NotTo(HaveOccurred())
+			internetGateway, err := awsClient.CreateInternetGateway(ctx, &awsclient.InternetGateway{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(awsClient.AttachInternetGateway(ctx, vpc.VpcID, internetGateway.InternetGatewayID)).To(Succeed())
+
+			infrastructureConfig.Networks.VPC = awsapi.VPC{ID: &vpc.VpcID}
+			_, err = reconciler.Reconcile(ctx, infrastructure, infrastructureConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.Delete(ctx, infrastructure, infrastructureConfig)).To(Succeed())
+
+			Expect(awsClient.EC2.Vpcs).To(HaveKey(vpc.VpcID))
+			Expect(awsClient.EC2.InternetGateways).To(HaveKeyWithValue(internetGateway.InternetGatewayID, &awsclient.InternetGateway{InternetGatewayID: internetGateway.InternetGatewayID, VpcID: vpc.VpcID, Tags: awsclient.Tags{}}))
+			Expect(awsClient.EC2.Subnets).To(BeEmpty())
+			Expect(awsClient.EC2.RouteTables).To(BeEmpty())
+			Expect(awsClient.EC2.SecurityGroups).To(BeEmpty())
+		})
+	})
+})
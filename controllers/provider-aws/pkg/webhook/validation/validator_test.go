@@ -0,0 +1,256 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/install"
+	awsv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/v1alpha1"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+const (
+	namespace = "shoot--foo--bar"
+)
+
+var _ = Describe("Validator", func() {
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		podsCIDR     = gardencorev1alpha1.CIDR("100.96.0.0/11")
+		servicesCIDR = gardencorev1alpha1.CIDR("100.64.0.0/13")
+		vpcCIDR      = gardencorev1alpha1.CIDR("10.250.0.0/16")
+
+		cluster = &extensionsv1alpha1.Cluster{
+			Spec: extensionsv1alpha1.ClusterSpec{
+				CloudProfile: runtime.RawExtension{Raw: encode(&gardenv1beta1.CloudProfile{})},
+				Seed:         runtime.RawExtension{Raw: encode(&gardenv1beta1.Seed{})},
+				Shoot: runtime.RawExtension{Raw: encode(&gardenv1beta1.Shoot{
+					Spec: gardenv1beta1.ShootSpec{
+						Cloud: gardenv1beta1.Cloud{
+							AWS: &gardenv1beta1.AWSCloud{
+								Networks: gardenv1beta1.AWSNetworks{
+									K8SNetworks: gardencorev1alpha1.K8SNetworks{
+										Pods:     &podsCIDR,
+										Services: &servicesCIDR,
+									},
+								},
+							},
+						},
+					},
+				})},
+			},
+		}
+
+		infrastructureConfig = func(workers gardencorev1alpha1.CIDR) *awsv1alpha1.InfrastructureConfig {
+			return &awsv1alpha1.InfrastructureConfig{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "InfrastructureConfig",
+				},
+				Networks: awsv1alpha1.Networks{
+					VPC: awsv1alpha1.VPC{CIDR: &vpcCIDR},
+					Zones: []awsv1alpha1.Zone{
+						{
+							Name:     "eu-west-1a",
+							Internal: "10.250.112.0/22",
+							Public:   "10.250.96.0/22",
+							Workers:  workers,
+						},
+					},
+				},
+			}
+		}
+		infrastructure = func(config *awsv1alpha1.InfrastructureConfig) *extensionsv1alpha1.Infrastructure {
+			return &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infrastructure", Namespace: namespace},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: encode(config)},
+				},
+			}
+		}
+		worker = func(volumeType string, config *awsv1alpha1.WorkerConfig) *extensionsv1alpha1.Worker {
+			return &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: namespace},
+				Spec: extensionsv1alpha1.WorkerSpec{
+					Pools: []extensionsv1alpha1.WorkerPool{
+						{
+							Name:           "pool-1",
+							Volume:         &extensionsv1alpha1.Volume{Type: volumeType, Size: "20Gi"},
+							ProviderConfig: &runtime.RawExtension{Raw: encode(config)},
+						},
+					},
+				},
+			}
+		}
+		workerConfig = func(iops int64) *awsv1alpha1.WorkerConfig {
+			return &awsv1alpha1.WorkerConfig{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "WorkerConfig",
+				},
+				Volume: &awsv1alpha1.Volume{IOPS: &iops},
+			}
+		}
+
+		newValidator = func() *validator {
+			scheme := runtime.NewScheme()
+			install.Install(scheme)
+
+			v := NewValidator()
+			Expect(v.(inject.Scheme).InjectScheme(scheme)).To(Succeed())
+			Expect(v.(inject.Client).InjectClient(c)).To(Succeed())
+			return v.(*validator)
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#Validate", func() {
+		It("should ignore other objects", func() {
+			Expect(newValidator().Validate(context.TODO(), &corev1.Secret{}, nil)).To(Succeed())
+		})
+
+		It("should allow a valid infrastructure", func() {
+			c.EXPECT().Get(context.TODO(), kutil.Key(namespace), &extensionsv1alpha1.Cluster{}).DoAndReturn(clientGet(cluster))
+
+			infra := infrastructure(infrastructureConfig("10.250.0.0/19"))
+			Expect(newValidator().Validate(context.TODO(), infra, nil)).To(Succeed())
+		})
+
+		It("should reject an infrastructure overlapping with the shoot networks", func() {
+			c.EXPECT().Get(context.TODO(), kutil.Key(namespace), &extensionsv1alpha1.Cluster{}).DoAndReturn(clientGet(cluster))
+
+			infra := infrastructure(infrastructureConfig("100.96.0.0/19"))
+			err := newValidator().Validate(context.TODO(), infra, nil)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should reject changing an existing zone", func() {
+			c.EXPECT().Get(context.TODO(), kutil.Key(namespace), &extensionsv1alpha1.Cluster{}).DoAndReturn(clientGet(cluster))
+
+			oldInfra := infrastructure(infrastructureConfig("10.250.0.0/19"))
+			infra := infrastructure(infrastructureConfig("10.250.0.0/20"))
+			err := newValidator().Validate(context.TODO(), infra, oldInfra)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should not validate an unchanged provider config on updates", func() {
+			oldInfra := infrastructure(infrastructureConfig("100.96.0.0/19"))
+			infra := oldInfra.DeepCopy()
+			infra.Finalizers = []string{"extensions.gardener.cloud/aws"}
+
+			Expect(newValidator().Validate(context.TODO(), infra, oldInfra)).To(Succeed())
+		})
+
+		It("should reject switching an existing infrastructure to the native reconciler", func() {
+			oldInfra := infrastructure(nil)
+			oldInfra.Spec.ProviderConfig = nil
+			infra := oldInfra.DeepCopy()
+			infra.Annotations = map[string]string{aws.InfrastructureReconcilerAnnotation: aws.InfrastructureReconcilerNative}
+
+			err := newValidator().Validate(context.TODO(), infra, oldInfra)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should allow setting the reconciler of an existing infrastructure to its default", func() {
+			oldInfra := infrastructure(nil)
+			oldInfra.Spec.ProviderConfig = nil
+			infra := oldInfra.DeepCopy()
+			infra.Annotations = map[string]string{aws.InfrastructureReconcilerAnnotation: aws.InfrastructureReconcilerTerraform}
+
+			Expect(newValidator().Validate(context.TODO(), infra, oldInfra)).To(Succeed())
+		})
+
+		It("should allow a valid worker", func() {
+			Expect(newValidator().Validate(context.TODO(), worker("io1", workerConfig(1000)), nil)).To(Succeed())
+		})
+
+		It("should allow a worker without provider config", func() {
+			w := worker("gp2", nil)
+			w.Spec.Pools[0].ProviderConfig = nil
+
+			Expect(newValidator().Validate(context.TODO(), w, nil)).To(Succeed())
+		})
+
+		It("should allow a worker whose provider config has no data", func() {
+			w := worker("gp2", nil)
+			w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{}
+
+			Expect(newValidator().Validate(context.TODO(), w, nil)).To(Succeed())
+		})
+
+		It("should reject a worker with an invalid provider config", func() {
+			err := newValidator().Validate(context.TODO(), worker("gp2", workerConfig(1000)), nil)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should not validate an unchanged worker pool on updates", func() {
+			oldWorker := worker("gp2", workerConfig(1000))
+			w := oldWorker.DeepCopy()
+			w.Finalizers = []string{"extensions.gardener.cloud/aws"}
+
+			Expect(newValidator().Validate(context.TODO(), w, oldWorker)).To(Succeed())
+		})
+
+		It("should reject changing the volume type of a worker pool to one that does not fit its provider config", func() {
+			oldWorker := worker("io1", workerConfig(1000))
+			w := worker("gp2", workerConfig(1000))
+
+			err := newValidator().Validate(context.TODO(), w, oldWorker)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+	})
+})
+
+func clientGet(result runtime.Object) interface{} {
+	return func(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+		switch obj.(type) {
+		case *extensionsv1alpha1.Cluster:
+			*obj.(*extensionsv1alpha1.Cluster) = *result.(*extensionsv1alpha1.Cluster)
+		}
+		return nil
+	}
+}
+
+func encode(obj runtime.Object) []byte {
+	data, _ := json.Marshal(obj)
+	return data
+}
@@ -34,6 +34,13 @@ resource "azurerm_subnet" "workers" {
   network_security_group_id = "${azurerm_network_security_group.workers.id}"
 }
 
+{{ if .Values.zoned -}}
+resource "azurerm_subnet_nat_gateway_association" "workers" {
+  subnet_id      = "${azurerm_subnet.workers.id}"
+  nat_gateway_id = "${azurerm_nat_gateway.nat.id}"
+}
+{{- end}}
+
 resource "azurerm_route_table" "workers" {
   name                = "worker_route_table"
   location            = "{{ required "azure.region is required" .Values.azure.region }}"
@@ -46,6 +53,29 @@ resource "azurerm_network_security_group" "workers" {
   resource_group_name = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
 }
 
+{{ if .Values.zoned -}}
+#=====================================================================
+#= NAT Gateway
+#=====================================================================
+
+// Public IPs with the Standard SKU are zone-redundant, i.e. they survive the outage of a single zone.
+resource "azurerm_public_ip" "natip" {
+  name                = "{{ required "clusterName is required" .Values.clusterName }}-nat-ip"
+  location            = "{{ required "azure.region is required" .Values.azure.region }}"
+  resource_group_name = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_nat_gateway" "nat" {
+  name                    = "{{ required "clusterName is required" .Values.clusterName }}-nat-gateway"
+  location                = "{{ required "azure.region is required" .Values.azure.region }}"
+  resource_group_name     = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
+  sku_name                = "Standard"
+  public_ip_address_ids   = ["${azurerm_public_ip.natip.id}"]
+  idle_timeout_in_minutes = 4
+}
+{{- else }}
 #=====================================================================
 #= Availability Set
 #=====================================================================
@@ -58,6 +88,7 @@ resource "azurerm_availability_set" "workers" {
   platform_fault_domain_count  = "{{ required "azure.countFaultDomains is required" .Values.azure.countFaultDomains }}"
   managed                      = true
 }
+{{- end}}
 
 //=====================================================================
 //= Output variables
@@ -75,6 +106,7 @@ output "{{ .Values.outputKeys.subnetName }}" {
   value = "${azurerm_subnet.workers.name}"
 }
 
+{{ if not .Values.zoned -}}
 output "{{ .Values.outputKeys.availabilitySetID }}" {
   value = "${azurerm_availability_set.workers.id}"
 }
@@ -82,6 +114,7 @@ output "{{ .Values.outputKeys.availabilitySetID }}" {
 output "{{ .Values.outputKeys.availabilitySetName }}" {
   value = "${azurerm_availability_set.workers.name}"
 }
+{{- end}}
 
 output "{{ .Values.outputKeys.routeTableName }}" {
   value = "${azurerm_route_table.workers.name}"
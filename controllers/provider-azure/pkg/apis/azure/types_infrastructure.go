@@ -29,6 +29,9 @@ type InfrastructureConfig struct {
 	ResourceGroup *ResourceGroup
 	// Networks is the network configuration (VNets, subnets, etc.)
 	Networks NetworkConfig
+	// Zoned indicates whether the cluster uses availability zones. If set, no availability set is created,
+	// the NAT and public IPs are zone-redundant and the machines are spread over the zones of the worker pools.
+	Zoned bool
 }
 
 // ResourceGroup is azure resource group
@@ -60,6 +63,8 @@ type InfrastructureStatus struct {
 	RouteTables []RouteTable
 	// SecurityGroups is a list of created security groups
 	SecurityGroups []SecurityGroup
+	// Zoned indicates whether the cluster uses availability zones.
+	Zoned bool
 }
 
 // NetworkStatus is the current status of the infrastructure networks.
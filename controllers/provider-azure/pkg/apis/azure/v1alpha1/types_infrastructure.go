@@ -30,6 +30,10 @@ type InfrastructureConfig struct {
 	ResourceGroup *ResourceGroup `json:"resourceGroup,omitempty"`
 	// Networks is the network configuration (VNet, subnets, etc.)
 	Networks NetworkConfig `json:"networks"`
+	// Zoned indicates whether the cluster uses availability zones. If set, no availability set is created,
+	// the NAT and public IPs are zone-redundant and the machines are spread over the zones of the worker pools.
+	// +optional
+	Zoned bool `json:"zoned,omitempty"`
 }
 
 // ResourceGroup is azure resource group
@@ -61,6 +65,9 @@ type InfrastructureStatus struct {
 	RouteTables []RouteTable `json:"routeTables"`
 	// SecurityGroups is a list of created security groups
 	SecurityGroups []SecurityGroup `json:"securityGroups"`
+	// Zoned indicates whether the cluster uses availability zones.
+	// +optional
+	Zoned bool `json:"zoned,omitempty"`
 }
 
 // NetworkStatus is the current status of the infrastructure networks.
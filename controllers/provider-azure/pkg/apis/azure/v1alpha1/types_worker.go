@@ -0,0 +1,52 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains configuration settings for the machines of a worker pool.
+type WorkerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Volume contains additional settings for the OS disk of the machines.
+	// +optional
+	Volume *Volume `json:"volume,omitempty"`
+	// AdditionalTags is a map of tags that are added to the machines in addition to the default ones.
+	// +optional
+	AdditionalTags map[string]string `json:"additionalTags,omitempty"`
+}
+
+// Volume contains additional settings for the OS disk.
+type Volume struct {
+	// Caching is the caching type of the OS disk. Defaults to 'None'.
+	// +optional
+	Caching *CachingType `json:"caching,omitempty"`
+}
+
+// CachingType is a constant for the caching types of disks.
+type CachingType string
+
+const (
+	// CachingNone is a constant for disks without caching.
+	CachingNone CachingType = "None"
+	// CachingReadOnly is a constant for disks with read-only caching.
+	CachingReadOnly CachingType = "ReadOnly"
+	// CachingReadWrite is a constant for disks with read-write caching.
+	CachingReadWrite CachingType = "ReadWrite"
+)
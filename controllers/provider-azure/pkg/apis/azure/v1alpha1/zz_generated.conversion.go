@@ -166,6 +166,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Volume)(nil), (*azure.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Volume_To_azure_Volume(a.(*Volume), b.(*azure.Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_Volume_To_v1alpha1_Volume(a.(*azure.Volume), b.(*Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*azure.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(a.(*WorkerConfig), b.(*azure.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*azure.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -238,6 +258,7 @@ func autoConvert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(in
 	if err := Convert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
+	out.Zoned = in.Zoned
 	return nil
 }
 
@@ -251,6 +272,7 @@ func autoConvert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in
 	if err := Convert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
+	out.Zoned = in.Zoned
 	return nil
 }
 
@@ -269,6 +291,7 @@ func autoConvert_v1alpha1_InfrastructureStatus_To_azure_InfrastructureStatus(in
 	out.AvailabilitySets = *(*[]azure.AvailabilitySet)(unsafe.Pointer(&in.AvailabilitySets))
 	out.RouteTables = *(*[]azure.RouteTable)(unsafe.Pointer(&in.RouteTables))
 	out.SecurityGroups = *(*[]azure.SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.Zoned = in.Zoned
 	return nil
 }
 
@@ -287,6 +310,7 @@ func autoConvert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in
 	out.AvailabilitySets = *(*[]AvailabilitySet)(unsafe.Pointer(&in.AvailabilitySets))
 	out.RouteTables = *(*[]RouteTable)(unsafe.Pointer(&in.RouteTables))
 	out.SecurityGroups = *(*[]SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.Zoned = in.Zoned
 	return nil
 }
 
@@ -474,3 +498,45 @@ func autoConvert_azure_VNetStatus_To_v1alpha1_VNetStatus(in *azure.VNetStatus, o
 func Convert_azure_VNetStatus_To_v1alpha1_VNetStatus(in *azure.VNetStatus, out *VNetStatus, s conversion.Scope) error {
 	return autoConvert_azure_VNetStatus_To_v1alpha1_VNetStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_Volume_To_azure_Volume(in *Volume, out *azure.Volume, s conversion.Scope) error {
+	out.Caching = (*azure.CachingType)(unsafe.Pointer(in.Caching))
+	return nil
+}
+
+// Convert_v1alpha1_Volume_To_azure_Volume is an autogenerated conversion function.
+func Convert_v1alpha1_Volume_To_azure_Volume(in *Volume, out *azure.Volume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Volume_To_azure_Volume(in, out, s)
+}
+
+func autoConvert_azure_Volume_To_v1alpha1_Volume(in *azure.Volume, out *Volume, s conversion.Scope) error {
+	out.Caching = (*CachingType)(unsafe.Pointer(in.Caching))
+	return nil
+}
+
+// Convert_azure_Volume_To_v1alpha1_Volume is an autogenerated conversion function.
+func Convert_azure_Volume_To_v1alpha1_Volume(in *azure.Volume, out *Volume, s conversion.Scope) error {
+	return autoConvert_azure_Volume_To_v1alpha1_Volume(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in *WorkerConfig, out *azure.WorkerConfig, s conversion.Scope) error {
+	out.Volume = (*azure.Volume)(unsafe.Pointer(in.Volume))
+	out.AdditionalTags = *(*map[string]string)(unsafe.Pointer(&in.AdditionalTags))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in *WorkerConfig, out *azure.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in, out, s)
+}
+
+func autoConvert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in *azure.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.Volume = (*Volume)(unsafe.Pointer(in.Volume))
+	out.AdditionalTags = *(*map[string]string)(unsafe.Pointer(&in.AdditionalTags))
+	return nil
+}
+
+// Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in *azure.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object against the given pod and service networks of the shoot.
+func ValidateInfrastructureConfig(infra *apisazure.InfrastructureConfig, podsCIDR, servicesCIDR string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if infra.ResourceGroup != nil && len(infra.ResourceGroup.Name) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("resourceGroup", "name"), "field is required"))
+	}
+
+	networksPath := field.NewPath("networks")
+	vnetPath := networksPath.Child("vnet")
+
+	var vnetCIDR string
+	if infra.Networks.VNet.Name != nil && len(*infra.Networks.VNet.Name) == 0 {
+		allErrs = append(allErrs, field.Required(vnetPath.Child("name"), "field must not be empty"))
+	}
+	if infra.Networks.VNet.Name == nil && infra.Networks.VNet.CIDR == nil {
+		allErrs = append(allErrs, field.Invalid(vnetPath, infra.Networks.VNet, "must specify either a vnet name or a cidr"))
+	}
+	if infra.Networks.VNet.CIDR != nil {
+		vnetCIDR = string(*infra.Networks.VNet.CIDR)
+		allErrs = append(allErrs, validateNetwork(vnetCIDR, vnetPath.Child("cidr"), podsCIDR, servicesCIDR)...)
+	}
+
+	workersPath := networksPath.Child("workers")
+	workersCIDR := string(infra.Networks.Workers)
+	allErrs = append(allErrs, validateNetwork(workersCIDR, workersPath, podsCIDR, servicesCIDR)...)
+	if len(vnetCIDR) > 0 {
+		allErrs = append(allErrs, extensionsvalidation.ValidateCIDRIsSubset(workersCIDR, workersPath, vnetCIDR, "vnet cidr")...)
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update.
+// The resource group, the networks and the zoned flag are immutable.
+func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisazure.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.ResourceGroup, oldConfig.ResourceGroup, field.NewPath("resourceGroup"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks, oldConfig.Networks, field.NewPath("networks"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Zoned, oldConfig.Zoned, field.NewPath("zoned"))...)
+
+	return allErrs
+}
+
+func validateNetwork(cidr string, fldPath *field.Path, podsCIDR, servicesCIDR string) field.ErrorList {
+	allErrs := extensionsvalidation.ValidateCIDR(cidr, fldPath)
+	allErrs = append(allErrs, extensionsvalidation.ValidateCIDRDisjoint(cidr, fldPath, podsCIDR, "pod network")...)
+	allErrs = append(allErrs, extensionsvalidation.ValidateCIDRDisjoint(cidr, fldPath, servicesCIDR, "service network")...)
+	return allErrs
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	const (
+		podsCIDR     = "100.96.0.0/11"
+		servicesCIDR = "100.64.0.0/13"
+	)
+
+	var (
+		infrastructureConfig *apisazure.InfrastructureConfig
+
+		vnetName = "existing"
+		vnetCIDR = gardencorev1alpha1.CIDR("10.250.0.0/16")
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisazure.InfrastructureConfig{
+			ResourceGroup: &apisazure.ResourceGroup{Name: "existing"},
+			Networks: apisazure.NetworkConfig{
+				VNet: apisazure.VNet{
+					CIDR: &vnetCIDR,
+				},
+				Workers: "10.250.0.0/19",
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(BeEmpty())
+		})
+
+		It("should allow an existing vnet", func() {
+			infrastructureConfig.Networks.VNet = apisazure.VNet{Name: &vnetName}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(BeEmpty())
+		})
+
+		It("should forbid specifying neither a vnet name nor a cidr", func() {
+			infrastructureConfig.Networks.VNet = apisazure.VNet{}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vnet"),
+			}))))
+		})
+
+		It("should forbid an empty resource group name", func() {
+			infrastructureConfig.ResourceGroup.Name = ""
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("resourceGroup.name"),
+			}))))
+		})
+
+		It("should forbid a workers CIDR outside of the vnet CIDR", func() {
+			infrastructureConfig.Networks.Workers = "10.251.0.0/19"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.workers"),
+			}))))
+		})
+
+		It("should forbid CIDRs overlapping with the pod and service networks", func() {
+			overlappingCIDR := gardencorev1alpha1.CIDR("100.64.0.0/10")
+			infrastructureConfig.Networks.VNet.CIDR = &overlappingCIDR
+			infrastructureConfig.Networks.Workers = "100.96.0.0/19"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vnet.cidr"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vnet.cidr"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.workers"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should allow an unchanged configuration", func() {
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, infrastructureConfig.DeepCopy())).To(BeEmpty())
+		})
+
+		It("should forbid changing the resource group and the networks", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.ResourceGroup = nil
+			newInfrastructureConfig.Networks.Workers = "10.250.0.0/20"
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("resourceGroup"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks"),
+				})),
+			))
+		})
+
+		It("should forbid changing the zoned flag", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Zoned = true
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("zoned"),
+			}))))
+		})
+	})
+})
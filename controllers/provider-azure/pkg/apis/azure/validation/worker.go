@@ -0,0 +1,53 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var availableCachingTypes = sets.NewString(
+	string(apisazure.CachingNone),
+	string(apisazure.CachingReadOnly),
+	string(apisazure.CachingReadWrite),
+)
+
+// ValidateWorkerConfig validates a WorkerConfig object of a worker pool.
+func ValidateWorkerConfig(workerConfig *apisazure.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig.Volume != nil && workerConfig.Volume.Caching != nil && !availableCachingTypes.Has(string(*workerConfig.Volume.Caching)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("volume", "caching"), *workerConfig.Volume.Caching, availableCachingTypes.List()))
+	}
+
+	tagsPath := fldPath.Child("additionalTags")
+	for key := range workerConfig.AdditionalTags {
+		switch {
+		case len(key) == 0:
+			allErrs = append(allErrs, field.Required(tagsPath, "tag keys must not be empty"))
+		case key == "Name" || strings.HasPrefix(key, "kubernetes.io"):
+			allErrs = append(allErrs, field.Forbidden(tagsPath.Key(key), "must not overwrite a tag managed by Gardener"))
+		case strings.ContainsAny(key, `<>%&\?/`):
+			allErrs = append(allErrs, field.Invalid(tagsPath.Key(key), key, `must not contain any of the characters <>%&\?/`))
+		}
+	}
+
+	return allErrs
+}
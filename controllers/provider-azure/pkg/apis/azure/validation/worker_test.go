@@ -0,0 +1,84 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("WorkerConfig validation", func() {
+	var (
+		workerConfig *apisazure.WorkerConfig
+		fldPath      = field.NewPath("providerConfig")
+
+		readOnly = apisazure.CachingReadOnly
+	)
+
+	BeforeEach(func() {
+		workerConfig = &apisazure.WorkerConfig{
+			Volume: &apisazure.Volume{
+				Caching: &readOnly,
+			},
+			AdditionalTags: map[string]string{
+				"team": "foo",
+			},
+		}
+	})
+
+	Describe("#ValidateWorkerConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an unsupported caching type", func() {
+			invalid := apisazure.CachingType("foo")
+			workerConfig.Volume.Caching = &invalid
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.volume.caching"),
+			}))))
+		})
+
+		It("should forbid invalid or reserved tags", func() {
+			workerConfig.AdditionalTags = map[string]string{
+				"Name":                    "foo",
+				"kubernetes.io-role-node": "0",
+				"foo/bar":                 "baz",
+			}
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.additionalTags[Name]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.additionalTags[kubernetes.io-role-node]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.additionalTags[foo/bar]"),
+				})),
+			))
+		})
+	})
+})
@@ -28,7 +28,19 @@ const (
 	MachineControllerManagerImageName = "machine-controller-manager"
 	// ETCDBackupRestoreImageName is the name of the etcd backup and restore image.
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSINodeDriverRegistrarImageName is the name of the CSI driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSISnapshotterImageName is the name of the CSI snapshotter image.
+	CSISnapshotterImageName = "csi-snapshotter"
+	// CSIPluginImageName is the name of the CSI plugin image.
+	CSIPluginImageName = "azuredisk-csi"
 
+	// CloudControllerManagerName is a constant for the name of the cloud-controller-manager.
+	CloudControllerManagerName = "cloud-controller-manager"
 	// MachineControllerManagerName is a constant for the name of the machine-controller-manager.
 	MachineControllerManagerName = "machine-controller-manager"
 	HyperkubeImageName           = "hyperkube"
@@ -58,6 +70,10 @@ const (
 	CloudProviderConfigMapKey = "cloudprovider.conf"
 	// BackupSecretName is the name of the secret containing the credentials for storing the backups of Shoot clusters.
 	BackupSecretName = "etcd-backup"
+
+	// CSIMinimumKubernetesVersion is the minimum Kubernetes version of Shoot clusters for which the CSI driver is deployed.
+	// Older Shoot clusters still use the in-tree volume plugin.
+	CSIMinimumKubernetesVersion = "1.14"
 )
 
 var (
@@ -0,0 +1,41 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket_test
+
+import (
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
+	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/storage"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/test"
+	"github.com/gardener/gardener-extensions/pkg/util/test/objectstore"
+
+	. "github.com/onsi/ginkgo"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var _ = Describe("Actuator", test.DescribeActuatorTest(test.ActuatorTestArgs{
+	Region: "westeurope",
+	Credentials: map[string][]byte{
+		azure.StorageAccount: []byte("account"),
+		azure.StorageKey:     []byte("key"),
+	},
+	IncompleteCredentials: map[string][]byte{azure.StorageAccount: []byte("account")},
+	NewActuator: func(store *objectstore.Store) backupbucket.Actuator {
+		return NewActuatorWithDeps(log.Log, storage.FactoryFunc(func(_, _ string) (storage.Interface, error) {
+			return store, nil
+		}))
+	},
+}))
@@ -22,6 +22,7 @@ import (
 	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
 	azureapihelper "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+	azureimagevector "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/imagevector"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane/genericactuator"
@@ -39,7 +40,9 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apiserver/pkg/authentication/user"
@@ -49,8 +52,11 @@ import (
 
 // Object names
 const (
-	cloudControllerManagerDeploymentName = "cloud-controller-manager"
+	cloudControllerManagerDeploymentName = azure.CloudControllerManagerName
 	cloudControllerManagerServerName     = "cloud-controller-manager-server"
+	csiAttacherName                      = "csi-attacher"
+	csiProvisionerName                   = "csi-provisioner"
+	csiSnapshotterName                   = "csi-snapshotter"
 )
 
 var controlPlaneSecrets = &secrets.Secrets{
@@ -85,6 +91,45 @@ var controlPlaneSecrets = &secrets.Secrets{
 					SigningCA:  cas[gardencorev1alpha1.SecretNameCACluster],
 				},
 			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiAttacherName,
+					CommonName:   "system:csi-attacher",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[gardencorev1alpha1.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: common.KubeAPIServerDeploymentName,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiProvisionerName,
+					CommonName:   "system:csi-provisioner",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[gardencorev1alpha1.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: common.KubeAPIServerDeploymentName,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiSnapshotterName,
+					CommonName:   "system:csi-snapshotter",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[gardencorev1alpha1.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: common.KubeAPIServerDeploymentName,
+				},
+			},
 		}
 	},
 }
@@ -100,22 +145,62 @@ var configChart = &chart.Chart{
 	},
 }
 
-var ccmChart = &chart.Chart{
-	Name:   "cloud-controller-manager",
-	Path:   filepath.Join(internal.InternalChartsPath, "cloud-controller-manager"),
-	Images: []string{azure.HyperkubeImageName},
-	Objects: []*chart.Object{
-		{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
-		{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
+var controlPlaneChart = &chart.Chart{
+	Name: "seed-controlplane",
+	Path: filepath.Join(internal.InternalChartsPath, "seed-controlplane"),
+	SubCharts: []*chart.Chart{
+		{
+			Name:   "azure-cloud-controller-manager",
+			Images: []string{azure.HyperkubeImageName},
+			Objects: []*chart.Object{
+				{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
+				{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
+			},
+		},
+		{
+			Name: "csi-azure",
+			// The CSI images are injected explicitly by the values provider, see controlplane.GetCSIImages.
+			Objects: []*chart.Object{
+				{Type: &appsv1.Deployment{}, Name: "csi-plugin-controller"},
+			},
+		},
 	},
 }
 
-var ccmShootChart = &chart.Chart{
-	Name: "cloud-controller-manager-shoot",
-	Path: filepath.Join(internal.InternalChartsPath, "cloud-controller-manager-shoot"),
-	Objects: []*chart.Object{
-		{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
-		{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+var controlPlaneShootChart = &chart.Chart{
+	Name: "shoot-system-components",
+	Path: filepath.Join(internal.InternalChartsPath, "shoot-system-components"),
+	SubCharts: []*chart.Chart{
+		{
+			Name: "azure-cloud-controller-manager",
+			Objects: []*chart.Object{
+				{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+			},
+		},
+		{
+			Name: "csi-azure",
+			// The CSI images are injected explicitly by the values provider, see controlplane.GetCSIImages.
+			Objects: []*chart.Object{
+				{Type: &appsv1.DaemonSet{}, Name: "csi-disk-plugin-azure"},
+				{Type: &storagev1.StorageClass{}, Name: "default"},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-disk-plugin-azure"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:psp:kube-system:csi-disk-plugin-azure"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:psp:csi-disk-plugin-azure"},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-attacher"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-attacher"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-attacher"},
+				{Type: &rbacv1.Role{}, Name: "csi-attacher"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-attacher"},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-provisioner"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-provisioner"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-provisioner"},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-snapshotter"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-snapshotter"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-snapshotter"},
+				{Type: &policyv1beta1.PodSecurityPolicy{}, Name: "gardener.kube-system.csi-disk-plugin-azure"},
+			},
+		},
 	},
 }
 
@@ -187,8 +272,8 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
 	}
 
-	// Get CCM chart values
-	return getCCMChartValues(cpConfig, cp, cluster, checksums, scaledDown)
+	// Get control plane chart values
+	return getControlPlaneChartValues(cpConfig, cp, cluster, checksums, scaledDown)
 }
 
 // GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
@@ -197,7 +282,8 @@ func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 ) (map[string]interface{}, error) {
-	return nil, nil
+	// Get control plane shoot chart values
+	return getControlPlaneShootChartValues(cluster)
 }
 
 // getConfigChartValues collects and returns the configuration chart values.
@@ -226,18 +312,19 @@ func getConfigChartValues(
 		"routeTableName":      routeTableName,
 		"securityGroupName":   securityGroupName,
 		"region":              cp.Spec.Region,
+		"zoned":               infraStatus.Zoned,
 	}, nil
 }
 
-// getCCMChartValues collects and returns the CCM chart values.
-func getCCMChartValues(
+// getControlPlaneChartValues collects and returns the control plane chart values.
+func getControlPlaneChartValues(
 	cpConfig *apisazure.ControlPlaneConfig,
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	checksums map[string]string,
 	scaledDown bool,
 ) (map[string]interface{}, error) {
-	values := map[string]interface{}{
+	ccmValues := map[string]interface{}{
 		"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster.Shoot, scaledDown, 1),
 		"clusterName":       cp.Namespace,
 		"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
@@ -253,21 +340,80 @@ func getCCMChartValues(
 	}
 
 	if cpConfig.CloudControllerManager != nil {
-		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+		ccmValues["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+	}
+
+	csiEnabled, err := controlplane.IsCSIEnabled(cluster, azure.CSIMinimumKubernetesVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	csiValues := map[string]interface{}{
+		"enabled": csiEnabled,
+	}
+
+	if csiEnabled {
+		images, err := controlplane.GetCSIImages(cluster, azureimagevector.ImageVector(), azure.CSIAttacherImageName, azure.CSIProvisionerImageName, azure.CSISnapshotterImageName, azure.CSIPluginImageName)
+		if err != nil {
+			return nil, err
+		}
+
+		csiValues["replicas"] = extensionscontroller.GetControlPlaneReplicas(cluster.Shoot, scaledDown, 1)
+		csiValues["podAnnotations"] = map[string]interface{}{
+			"checksum/secret-" + csiAttacherName:       checksums[csiAttacherName],
+			"checksum/secret-" + csiProvisionerName:    checksums[csiProvisionerName],
+			"checksum/secret-" + csiSnapshotterName:    checksums[csiSnapshotterName],
+			"checksum/configmap-cloud-provider-config": checksums[azure.CloudProviderConfigName],
+		}
+		csiValues["images"] = images
 	}
 
-	return values, nil
+	return map[string]interface{}{
+		"azure-cloud-controller-manager": ccmValues,
+		"csi-azure":                      csiValues,
+	}, nil
+}
+
+// getControlPlaneShootChartValues collects and returns the control plane shoot chart values.
+func getControlPlaneShootChartValues(
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	csiEnabled, err := controlplane.IsCSIEnabled(cluster, azure.CSIMinimumKubernetesVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	csiValues := map[string]interface{}{
+		"enabled": csiEnabled,
+	}
+
+	if csiEnabled {
+		images, err := controlplane.GetCSIImages(cluster, azureimagevector.ImageVector(), azure.CSINodeDriverRegistrarImageName, azure.CSIPluginImageName)
+		if err != nil {
+			return nil, err
+		}
+		csiValues["images"] = images
+	}
+
+	return map[string]interface{}{
+		"csi-azure": csiValues,
+	}, nil
 }
 
 // getInfraNames determines the subnet, availability set, route table and security group names from the given infrastructure status.
+// Zoned infrastructures have no availability set, hence its name is empty for them.
 func getInfraNames(infraStatus *apisazure.InfrastructureStatus) (string, string, string, string, error) {
 	nodesSubnet, err := azureapihelper.FindSubnetByPurpose(infraStatus.Networks.Subnets, apisazure.PurposeNodes)
 	if err != nil {
 		return "", "", "", "", errors.Wrapf(err, "could not determine subnet for purpose 'nodes'")
 	}
-	nodesAvailabilitySet, err := azureapihelper.FindAvailabilitySetByPurpose(infraStatus.AvailabilitySets, apisazure.PurposeNodes)
-	if err != nil {
-		return "", "", "", "", errors.Wrapf(err, "could not determine availability set for purpose 'nodes'")
+	var availabilitySetName string
+	if !infraStatus.Zoned {
+		nodesAvailabilitySet, err := azureapihelper.FindAvailabilitySetByPurpose(infraStatus.AvailabilitySets, apisazure.PurposeNodes)
+		if err != nil {
+			return "", "", "", "", errors.Wrapf(err, "could not determine availability set for purpose 'nodes'")
+		}
+		availabilitySetName = nodesAvailabilitySet.Name
 	}
 	nodesRouteTable, err := azureapihelper.FindRouteTableByPurpose(infraStatus.RouteTables, apisazure.PurposeNodes)
 	if err != nil {
@@ -278,5 +424,5 @@ func getInfraNames(infraStatus *apisazure.InfrastructureStatus) (string, string,
 		return "", "", "", "", errors.Wrapf(err, "could not determine security group for purpose 'nodes'")
 	}
 
-	return nodesSubnet.Name, nodesAvailabilitySet.Name, nodesRouteTable.Name, nodesSecurityGroup.Name, nil
+	return nodesSubnet.Name, availabilitySetName, nodesRouteTable.Name, nodesSecurityGroup.Name, nil
 }
@@ -377,6 +377,17 @@ var _ = Describe("ValuesProvider", func() {
 			},
 		}
 
+		csiCluster = &extensionscontroller.Cluster{
+			Shoot: &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: cluster.Shoot.Spec.Cloud,
+					Kubernetes: gardenv1beta1.Kubernetes{
+						Version: "1.14.3",
+					},
+				},
+			},
+		}
+
 		cpSecretKey = client.ObjectKey{Namespace: namespace, Name: common.CloudProviderSecretName}
 		cpSecret    = &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
@@ -397,6 +408,9 @@ var _ = Describe("ValuesProvider", func() {
 			azure.CloudProviderConfigName:     "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
 			"cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
 			"cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
+			"csi-attacher":                    "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+			"csi-provisioner":                 "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
+			"csi-snapshotter":                 "bf417a6c4f8b3c1e7b5c3ed8a2ab1fa1a1ef8dd2d8e65e1b1ac2e8f4a2a0f5c6",
 		}
 
 		configChartValues = map[string]interface{}{
@@ -412,6 +426,7 @@ var _ = Describe("ValuesProvider", func() {
 			"routeTableName":      "route-table-name",
 			"securityGroupName":   "security-group-name-workers",
 			"kubernetesVersion":   "1.13.4",
+			"zoned":               false,
 		}
 
 		ccmChartValues = map[string]interface{}{
@@ -430,6 +445,19 @@ var _ = Describe("ValuesProvider", func() {
 			},
 		}
 
+		controlPlaneChartValues = map[string]interface{}{
+			"azure-cloud-controller-manager": ccmChartValues,
+			"csi-azure": map[string]interface{}{
+				"enabled": false,
+			},
+		}
+
+		controlPlaneShootChartValues = map[string]interface{}{
+			"csi-azure": map[string]interface{}{
+				"enabled": false,
+			},
+		}
+
 		logger = log.Log.WithName("test")
 	)
 
@@ -501,6 +529,34 @@ var _ = Describe("ValuesProvider", func() {
 		})
 	})
 
+	Describe("#GetConfigChartValuesZoned", func() {
+		It("should not require an availability set for a zoned infrastructure", func() {
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+			err = vp.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Mark the infrastructure of the control plane without availability set as zoned
+			infraStatus := &apisazure.InfrastructureStatus{}
+			Expect(json.Unmarshal(cpNoAvailabilitySet.Spec.InfrastructureProviderStatus.Raw, infraStatus)).To(Succeed())
+			infraStatus.Zoned = true
+			cpZoned := cpNoAvailabilitySet.DeepCopy()
+			cpZoned.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infraStatus)}
+
+			// Call GetConfigChartValues method and check the result
+			values, err := vp.GetConfigChartValues(context.TODO(), cpZoned, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("zoned", true))
+			Expect(values).To(HaveKeyWithValue("availabilitySetName", ""))
+		})
+	})
+
 	Describe("#GetConfigChartValuesNoRouteTable", func() {
 		It("should return error, missing route tables", func() {
 			// Create mock client
@@ -551,7 +607,66 @@ var _ = Describe("ValuesProvider", func() {
 			// Call GetControlPlaneChartValues method and check the result
 			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, checksums, false)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(values).To(Equal(ccmChartValues))
+			Expect(values).To(Equal(controlPlaneChartValues))
+		})
+
+		It("should return correct control plane chart values if the CSI driver is enabled", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneChartValues method and check the result
+			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, csiCluster, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("csi-azure", And(
+				HaveKeyWithValue("enabled", true),
+				HaveKeyWithValue("replicas", 1),
+				HaveKeyWithValue("podAnnotations", map[string]interface{}{
+					"checksum/secret-csi-attacher":             "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+					"checksum/secret-csi-provisioner":          "f75b42d40ab501428c383dfb2336cb1fc892bbee1fc1d739675171e4acc4d911",
+					"checksum/secret-csi-snapshotter":          "bf417a6c4f8b3c1e7b5c3ed8a2ab1fa1a1ef8dd2d8e65e1b1ac2e8f4a2a0f5c6",
+					"checksum/configmap-cloud-provider-config": "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+				}),
+				HaveKeyWithValue("images", And(
+					HaveKey(azure.CSIAttacherImageName),
+					HaveKey(azure.CSIProvisionerImageName),
+					HaveKey(azure.CSISnapshotterImageName),
+					HaveKey(azure.CSIPluginImageName),
+				)),
+			)))
+		})
+	})
+
+	Describe("#GetControlPlaneShootChartValues", func() {
+		It("should return correct control plane shoot chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(controlPlaneShootChartValues))
+		})
+
+		It("should return correct control plane shoot chart values if the CSI driver is enabled", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("csi-azure", And(
+				HaveKeyWithValue("enabled", true),
+				HaveKeyWithValue("images", And(
+					HaveKey(azure.CSINodeDriverRegistrarImageName),
+					HaveKey(azure.CSIPluginImageName),
+				)),
+			)))
 		})
 	})
 })
@@ -21,6 +21,7 @@ import (
 	infrainternal "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/infrastructure"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionschartrenderer "github.com/gardener/gardener-extensions/pkg/gardener/chartrenderer"
 
 	"github.com/go-logr/logr"
 
@@ -59,7 +60,7 @@ func (a *actuator) InjectClient(client client.Client) error {
 func (a *actuator) InjectConfig(config *rest.Config) error {
 	a.restConfig = config
 
-	chartRenderer, err := chartrenderer.NewForConfig(config)
+	chartRenderer, err := extensionschartrenderer.NewForConfig(config)
 	if err != nil {
 		return err
 	}
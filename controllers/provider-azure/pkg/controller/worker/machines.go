@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strconv"
 
 	azureapi "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
 	azureapihelper "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/helper"
@@ -28,6 +29,7 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -100,12 +102,30 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	nodesAvailabilitySet, err := azureapihelper.FindAvailabilitySetByPurpose(infrastructureStatus.AvailabilitySets, azureapi.PurposeNodes)
-	if err != nil {
-		return err
+
+	// Zoned infrastructures don't have an availability set, their machines are spread over the zones of the pools.
+	var nodesAvailabilitySet *azureapi.AvailabilitySet
+	if !infrastructureStatus.Zoned {
+		nodesAvailabilitySet, err = azureapihelper.FindAvailabilitySetByPurpose(infrastructureStatus.AvailabilitySets, azureapi.PurposeNodes)
+		if err != nil {
+			return err
+		}
 	}
 
 	for _, pool := range w.worker.Spec.Pools {
+		// Without availability zones, a worker pool has a single machine deployment that is not bound to a zone.
+		zones := []string{""}
+		var zoneDistribution *worker.ZoneDistribution
+		if infrastructureStatus.Zoned {
+			if len(pool.Zones) == 0 {
+				return fmt.Errorf("worker pool '%s' must specify at least one zone as the infrastructure is zoned", pool.Name)
+			}
+			zones = pool.Zones
+			if zoneDistribution, err = worker.NewZoneDistribution(pool.Zones, pool.Annotations); err != nil {
+				return errors.Wrapf(err, "invalid zone weights of worker pool '%s'", pool.Name)
+			}
+		}
+
 		machineImage, err := confighelper.FindImage(w.machineImages, pool.MachineImage.Name, pool.MachineImage.Version)
 		if err != nil {
 			return err
@@ -116,57 +136,99 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			return err
 		}
 
-		machineClassSpec := map[string]interface{}{
-			"region":            w.worker.Spec.Region,
-			"resourceGroup":     infrastructureStatus.ResourceGroup.Name,
-			"vnetName":          infrastructureStatus.Networks.VNet.Name,
-			"subnetName":        nodesSubnet.Name,
-			"availabilitySetID": nodesAvailabilitySet.ID,
-			"tags": map[string]interface{}{
-				"Name": w.worker.Namespace,
-				fmt.Sprintf("kubernetes.io-cluster-%s", w.worker.Namespace): "1",
-				"kubernetes.io-role-node":                                   "1",
-			},
-			"secret": map[string]interface{}{
-				"cloudConfig": string(pool.UserData),
-			},
-			"machineType": pool.MachineType,
-			"image": map[string]interface{}{
-				"publisher": machineImage.Publisher,
-				"offer":     machineImage.Offer,
-				"sku":       machineImage.SKU,
-				"version":   machineImage.Version,
-			},
-			"volumeSize":   volumeSize,
-			"sshPublicKey": string(w.worker.Spec.SSHPublicKey),
+		workerConfig := &azureapi.WorkerConfig{}
+		if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
+			if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+				return errors.Wrapf(err, "could not decode provider config of worker pool '%s'", pool.Name)
+			}
+		}
+
+		tags := map[string]interface{}{
+			"Name": w.worker.Namespace,
+			fmt.Sprintf("kubernetes.io-cluster-%s", w.worker.Namespace): "1",
+			"kubernetes.io-role-node":                                   "1",
+		}
+		for key, value := range workerConfig.AdditionalTags {
+			tags[key] = value
 		}
 
-		var (
-			machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-			deploymentName       = fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name)
-			className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
-		)
-
-		machineDeployments = append(machineDeployments, worker.MachineDeployment{
-			Name:           deploymentName,
-			ClassName:      className,
-			SecretName:     className,
-			Minimum:        pool.Minimum,
-			Maximum:        pool.Maximum,
-			MaxSurge:       pool.MaxSurge,
-			MaxUnavailable: pool.MaxUnavailable,
-			Labels:         pool.Labels,
-			Annotations:    pool.Annotations,
-			Taints:         pool.Taints,
-		})
-
-		machineClassSpec["name"] = className
-		machineClassSpec["secret"].(map[string]interface{})[azure.ClientIDKey] = string(machineClassSecretData[machinev1alpha1.AzureClientID])
-		machineClassSpec["secret"].(map[string]interface{})[azure.ClientSecretKey] = string(machineClassSecretData[machinev1alpha1.AzureClientSecret])
-		machineClassSpec["secret"].(map[string]interface{})[azure.SubscriptionIDKey] = string(machineClassSecretData[machinev1alpha1.AzureSubscriptionID])
-		machineClassSpec["secret"].(map[string]interface{})[azure.TenantIDKey] = string(machineClassSecretData[machinev1alpha1.AzureTenantID])
-
-		machineClasses = append(machineClasses, machineClassSpec)
+		for _, zone := range zones {
+			machineClassSpec := map[string]interface{}{
+				"region":        w.worker.Spec.Region,
+				"resourceGroup": infrastructureStatus.ResourceGroup.Name,
+				"vnetName":      infrastructureStatus.Networks.VNet.Name,
+				"subnetName":    nodesSubnet.Name,
+				"tags":          tags,
+				"secret": map[string]interface{}{
+					"cloudConfig": string(pool.UserData),
+				},
+				"machineType": pool.MachineType,
+				"image": map[string]interface{}{
+					"publisher": machineImage.Publisher,
+					"offer":     machineImage.Offer,
+					"sku":       machineImage.SKU,
+					"version":   machineImage.Version,
+				},
+				"volumeSize":   volumeSize,
+				"sshPublicKey": string(w.worker.Spec.SSHPublicKey),
+			}
+
+			if workerConfig.Volume != nil && workerConfig.Volume.Caching != nil {
+				machineClassSpec["osDiskCaching"] = string(*workerConfig.Volume.Caching)
+			}
+
+			var (
+				deploymentName = fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name)
+				minimum        = pool.Minimum
+				maximum        = pool.Maximum
+				maxSurge       = pool.MaxSurge
+				maxUnavailable = pool.MaxUnavailable
+			)
+
+			if infrastructureStatus.Zoned {
+				zoneNumber, err := strconv.Atoi(zone)
+				if err != nil {
+					return errors.Wrapf(err, "could not parse zone '%s' of worker pool '%s'", zone, pool.Name)
+				}
+				machineClassSpec["zone"] = zoneNumber
+
+				deploymentName = worker.DeploymentName(w.worker.Namespace, pool.Name, zone)
+				minimum = zoneDistribution.Distribute(zone, pool.Minimum)
+				maximum = zoneDistribution.Distribute(zone, pool.Maximum)
+				maxSurge = zoneDistribution.DistributePositiveIntOrPercent(zone, pool.MaxSurge, pool.Maximum)
+				maxUnavailable = zoneDistribution.DistributePositiveIntOrPercent(zone, pool.MaxUnavailable, pool.Minimum)
+			} else {
+				machineClassSpec["availabilitySetID"] = nodesAvailabilitySet.ID
+			}
+
+			var (
+				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
+				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+			)
+
+			machineDeployments = append(machineDeployments, worker.MachineDeployment{
+				Name:           deploymentName,
+				PoolName:       pool.Name,
+				Zone:           zone,
+				ClassName:      className,
+				SecretName:     className,
+				Minimum:        minimum,
+				Maximum:        maximum,
+				MaxSurge:       maxSurge,
+				MaxUnavailable: maxUnavailable,
+				Labels:         pool.Labels,
+				Annotations:    pool.Annotations,
+				Taints:         pool.Taints,
+			})
+
+			machineClassSpec["name"] = className
+			machineClassSpec["secret"].(map[string]interface{})[azure.ClientIDKey] = string(machineClassSecretData[machinev1alpha1.AzureClientID])
+			machineClassSpec["secret"].(map[string]interface{})[azure.ClientSecretKey] = string(machineClassSecretData[machinev1alpha1.AzureClientSecret])
+			machineClassSpec["secret"].(map[string]interface{})[azure.SubscriptionIDKey] = string(machineClassSecretData[machinev1alpha1.AzureSubscriptionID])
+			machineClassSpec["secret"].(map[string]interface{})[azure.TenantIDKey] = string(machineClassSecretData[machinev1alpha1.AzureTenantID])
+
+			machineClasses = append(machineClasses, machineClassSpec)
+		}
 	}
 
 	w.machineDeployments = machineDeployments
@@ -328,6 +328,7 @@ var _ = Describe("Machines", func() {
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1,
+						PoolName:       namePool1,
 						ClassName:      machineClassWithHashPool1,
 						SecretName:     machineClassWithHashPool1,
 						Minimum:        minPool1,
@@ -337,6 +338,7 @@ var _ = Describe("Machines", func() {
 					},
 					{
 						Name:           machineClassNamePool2,
+						PoolName:       namePool2,
 						ClassName:      machineClassWithHashPool2,
 						SecretName:     machineClassWithHashPool2,
 						Minimum:        minPool2,
@@ -351,6 +353,247 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should return the expected machine classes for a pool with provider config", func() {
+				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+				caching := apisazure.CachingReadWrite
+
+				w.Spec.Pools = w.Spec.Pools[:1]
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisazure.WorkerConfig{
+						Volume: &apisazure.Volume{
+							Caching: &caching,
+						},
+						AdditionalTags: map[string]string{
+							"team": "foo",
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				var (
+					machineClass = map[string]interface{}{
+						"region":            region,
+						"resourceGroup":     resourceGroupName,
+						"vnetName":          vnetName,
+						"subnetName":        subnetName,
+						"availabilitySetID": availabilitySetID,
+						"tags": map[string]interface{}{
+							"Name": namespace,
+							fmt.Sprintf("kubernetes.io-cluster-%s", namespace): "1",
+							"kubernetes.io-role-node":                          "1",
+							"team":                                             "foo",
+						},
+						"secret": map[string]interface{}{
+							"cloudConfig": string(userData),
+						},
+						"machineType": machineType,
+						"image": map[string]interface{}{
+							"publisher": machineImagePublisher,
+							"offer":     machineImageOffer,
+							"sku":       machineImageSKU,
+							"version":   machineImageVersion,
+						},
+						"volumeSize":    volumeSize,
+						"sshPublicKey":  sshKey,
+						"osDiskCaching": string(caching),
+					}
+
+					machineClassName     = fmt.Sprintf("%s-%s", namespace, namePool1)
+					machineClassWithHash = fmt.Sprintf("%s-%s", machineClassName, worker.MachineClassHash(machineClass, shootVersionMajorMinor))
+				)
+
+				addNameAndSecretsToMachineClass(machineClass, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID, machineClassWithHash)
+
+				chartApplier.
+					EXPECT().
+					ApplyChart(
+						context.TODO(),
+						filepath.Join(azure.InternalChartsPath, "machineclass"),
+						namespace,
+						"machineclass",
+						map[string]interface{}{"machineClasses": []map[string]interface{}{machineClass}},
+						nil,
+					).
+					Return(nil)
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+			})
+
+			It("should return one machine deployment per zone for a zoned infrastructure", func() {
+				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+					Raw: encode(&apisazure.InfrastructureStatus{
+						ResourceGroup: apisazure.ResourceGroup{
+							Name: resourceGroupName,
+						},
+						Networks: apisazure.NetworkStatus{
+							VNet: apisazure.VNetStatus{
+								Name: vnetName,
+							},
+							Subnets: []apisazure.Subnet{
+								{
+									Purpose: apisazure.PurposeNodes,
+									Name:    subnetName,
+								},
+							},
+						},
+						Zoned: true,
+					}),
+				}
+				w.Spec.Pools = w.Spec.Pools[:1]
+				w.Spec.Pools[0].Zones = []string{"1", "2"}
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				var (
+					defaultMachineClass = map[string]interface{}{
+						"region":        region,
+						"resourceGroup": resourceGroupName,
+						"vnetName":      vnetName,
+						"subnetName":    subnetName,
+						"tags": map[string]interface{}{
+							"Name": namespace,
+							fmt.Sprintf("kubernetes.io-cluster-%s", namespace): "1",
+							"kubernetes.io-role-node":                          "1",
+						},
+						"machineType": machineType,
+						"image": map[string]interface{}{
+							"publisher": machineImagePublisher,
+							"offer":     machineImageOffer,
+							"sku":       machineImageSKU,
+							"version":   machineImageVersion,
+						},
+						"volumeSize":   volumeSize,
+						"sshPublicKey": sshKey,
+					}
+
+					machineClassZone1 = copyMachineClass(defaultMachineClass)
+					machineClassZone2 = copyMachineClass(defaultMachineClass)
+				)
+
+				machineClassZone1["zone"] = 1
+				machineClassZone1["secret"] = map[string]interface{}{"cloudConfig": string(userData)}
+				machineClassZone2["zone"] = 2
+				machineClassZone2["secret"] = map[string]interface{}{"cloudConfig": string(userData)}
+
+				var (
+					deploymentNameZone1 = worker.DeploymentName(namespace, namePool1, "1")
+					deploymentNameZone2 = worker.DeploymentName(namespace, namePool1, "2")
+
+					machineClassWithHashZone1 = fmt.Sprintf("%s-%s", deploymentNameZone1, worker.MachineClassHash(machineClassZone1, shootVersionMajorMinor))
+					machineClassWithHashZone2 = fmt.Sprintf("%s-%s", deploymentNameZone2, worker.MachineClassHash(machineClassZone2, shootVersionMajorMinor))
+				)
+
+				addNameAndSecretsToMachineClass(machineClassZone1, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID, machineClassWithHashZone1)
+				addNameAndSecretsToMachineClass(machineClassZone2, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID, machineClassWithHashZone2)
+
+				chartApplier.
+					EXPECT().
+					ApplyChart(
+						context.TODO(),
+						filepath.Join(azure.InternalChartsPath, "machineclass"),
+						namespace,
+						"machineclass",
+						map[string]interface{}{"machineClasses": []map[string]interface{}{
+							machineClassZone1,
+							machineClassZone2,
+						}},
+						nil,
+					).
+					Return(nil)
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(worker.MachineDeployments{
+					{
+						Name:           deploymentNameZone1,
+						PoolName:       namePool1,
+						Zone:           "1",
+						ClassName:      machineClassWithHashZone1,
+						SecretName:     machineClassWithHashZone1,
+						Minimum:        worker.DistributeOverZones(0, minPool1, 2),
+						Maximum:        worker.DistributeOverZones(0, maxPool1, 2),
+						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool1, 2, maxPool1),
+						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool1, 2, minPool1),
+					},
+					{
+						Name:           deploymentNameZone2,
+						PoolName:       namePool1,
+						Zone:           "2",
+						ClassName:      machineClassWithHashZone2,
+						SecretName:     machineClassWithHashZone2,
+						Minimum:        worker.DistributeOverZones(1, minPool1, 2),
+						Maximum:        worker.DistributeOverZones(1, maxPool1, 2),
+						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool1, 2, maxPool1),
+						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool1, 2, minPool1),
+					},
+				}))
+			})
+
+			It("should fail because a pool of a zoned infrastructure has no zones", func() {
+				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+					Raw: encode(&apisazure.InfrastructureStatus{
+						Networks: apisazure.NetworkStatus{
+							Subnets: []apisazure.Subnet{
+								{
+									Purpose: apisazure.PurposeNodes,
+									Name:    subnetName,
+								},
+							},
+						},
+						Zoned: true,
+					}),
+				}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
+			It("should fail because a zone of a zoned infrastructure cannot be parsed", func() {
+				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+					Raw: encode(&apisazure.InfrastructureStatus{
+						Networks: apisazure.NetworkStatus{
+							Subnets: []apisazure.Subnet{
+								{
+									Purpose: apisazure.PurposeNodes,
+									Name:    subnetName,
+								},
+							},
+						},
+						Zoned: true,
+					}),
+				}
+				w.Spec.Pools[0].Zones = []string{"westeurope-1"}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
+			It("should fail because the provider config cannot be decoded", func() {
+				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte("not-decodeable")}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
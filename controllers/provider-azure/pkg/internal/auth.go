@@ -37,6 +37,14 @@ type ClientAuth struct {
 	ClientSecret string
 }
 
+// StorageAccountAuth represents Azure storage account credentials.
+type StorageAccountAuth struct {
+	// StorageAccountName is the name of the azure storage account.
+	StorageAccountName string
+	// StorageAccessKey is the access key of the azure storage account.
+	StorageAccessKey string
+}
+
 // GetClientAuthData retrieves the client auth data specified by the secret reference.
 func GetClientAuthData(ctx context.Context, c client.Client, secretRef corev1.SecretReference) (*ClientAuth, error) {
 	secret, err := util.GetSecretByRef(ctx, c, secretRef)
@@ -76,3 +84,31 @@ func ReadClientAuthDataFromSecret(secret *corev1.Secret) (*ClientAuth, error) {
 		ClientSecret:   string(clientSecret),
 	}, nil
 }
+
+// GetStorageAccountAuth retrieves the storage account credentials specified by the secret reference.
+func GetStorageAccountAuth(ctx context.Context, c client.Client, secretRef corev1.SecretReference) (*StorageAccountAuth, error) {
+	secret, err := util.GetSecretByRef(ctx, c, secretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadStorageAccountAuthFromSecret(secret)
+}
+
+// ReadStorageAccountAuthFromSecret reads the storage account credentials from the given secret.
+func ReadStorageAccountAuthFromSecret(secret *corev1.Secret) (*StorageAccountAuth, error) {
+	storageAccountName, ok := secret.Data[azure.StorageAccount]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s doesn't have a storage account", secret.Namespace, secret.Name)
+	}
+
+	storageAccessKey, ok := secret.Data[azure.StorageKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s doesn't have a storage key", secret.Namespace, secret.Name)
+	}
+
+	return &StorageAccountAuth{
+		StorageAccountName: string(storageAccountName),
+		StorageAccessKey:   string(storageAccessKey),
+	}, nil
+}
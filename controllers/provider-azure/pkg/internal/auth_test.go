@@ -67,6 +67,31 @@ var _ = Describe("Service Account", func() {
 		})
 	})
 
+	Describe("#ReadStorageAccountAuthFromSecret", func() {
+		It("should read the storage account credentials from the secret", func() {
+			actual, err := ReadStorageAccountAuthFromSecret(&corev1.Secret{
+				Data: map[string][]byte{
+					azure.StorageAccount: []byte("account"),
+					azure.StorageKey:     []byte("key"),
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(Equal(&StorageAccountAuth{
+				StorageAccountName: "account",
+				StorageAccessKey:   "key",
+			}))
+		})
+
+		It("should fail if the secret does not contain a storage key", func() {
+			_, err := ReadStorageAccountAuthFromSecret(&corev1.Secret{
+				Data: map[string][]byte{
+					azure.StorageAccount: []byte("account"),
+				},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("#GetClientAuthData", func() {
 		It("should retrieve the client auth data", func() {
 			var (
@@ -12,12 +12,15 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:generate packr2
+
 package imagevector
 
 import (
 	"strings"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extensions/pkg/util/chart"
 
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gobuffalo/packr/v2"
@@ -27,7 +30,8 @@ import (
 var imageVector imagevector.ImageVector
 
 func init() {
-	box := packr.New("charts", "../../../charts")
+	box := packr.New("provider-azure-charts", "../../../charts")
+	chart.RegisterSource(chart.NewBoxSource(azure.ChartsPath, box))
 
 	imagesYaml, err := box.FindString("images.yaml")
 	runtime.Must(err)
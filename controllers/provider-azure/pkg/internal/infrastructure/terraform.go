@@ -99,7 +99,7 @@ func ComputeTerraformerChartValues(infra *extensionsv1alpha1.Infrastructure, cli
 
 	var countUpdateDomainsCount, countFaultDomainsCount int
 
-	if cluster.CloudProfile.Spec.Azure != nil {
+	if !config.Zoned && cluster.CloudProfile.Spec.Azure != nil {
 		countUpdateDomains, err := findDomainCountByRegion(infra.Spec.Region, cluster.CloudProfile.Spec.Azure.CountUpdateDomains)
 		if err != nil {
 			return nil, err
@@ -133,6 +133,7 @@ func ComputeTerraformerChartValues(infra *extensionsv1alpha1.Infrastructure, cli
 			},
 		},
 		"clusterName": infra.Namespace,
+		"zoned":       config.Zoned,
 		"networks": map[string]interface{}{
 			"worker": config.Networks.Workers,
 		},
@@ -191,19 +192,22 @@ type TerraformState struct {
 	RouteTableName string
 	// SecurityGroupName is the name of the security group.
 	SecurityGroupName string
+	// Zoned indicates whether the infrastructure uses availability zones instead of an availability set.
+	Zoned bool
 }
 
 // ExtractTerraformState extracts the TerraformState from the given Terraformer.
 func ExtractTerraformState(tf *terraformer.Terraformer, config *azurev1alpha1.InfrastructureConfig) (*TerraformState, error) {
 	outputKeys := []string{
-		TerraformerOutputKeyAvailabilitySetID,
-		TerraformerOutputKeyAvailabilitySetName,
 		TerraformerOutputKeyResourceGroupName,
 		TerraformerOutputKeyRouteTableName,
 		TerraformerOutputKeySecurityGroupName,
 		TerraformerOutputKeySubnetName,
 		TerraformerOutputKeyVNetName,
 	}
+	if !config.Zoned {
+		outputKeys = append(outputKeys, TerraformerOutputKeyAvailabilitySetID, TerraformerOutputKeyAvailabilitySetName)
+	}
 
 	vars, err := tf.GetStateOutputVariables(outputKeys...)
 	if err != nil {
@@ -218,13 +222,14 @@ func ExtractTerraformState(tf *terraformer.Terraformer, config *azurev1alpha1.In
 		RouteTableName:      vars[TerraformerOutputKeyRouteTableName],
 		SecurityGroupName:   vars[TerraformerOutputKeySecurityGroupName],
 		SubnetName:          vars[TerraformerOutputKeySubnetName],
+		Zoned:               config.Zoned,
 	}, nil
 }
 
 // StatusFromTerraformState computes an InfrastructureStatus from the given
 // Terraform variables.
 func StatusFromTerraformState(state *TerraformState) *azurev1alpha1.InfrastructureStatus {
-	return &azurev1alpha1.InfrastructureStatus{
+	status := &azurev1alpha1.InfrastructureStatus{
 		TypeMeta: StatusTypeMeta,
 		ResourceGroup: azurev1alpha1.ResourceGroup{
 			Name: state.ResourceGroupName,
@@ -240,16 +245,21 @@ func StatusFromTerraformState(state *TerraformState) *azurev1alpha1.Infrastructu
 				},
 			},
 		},
-		AvailabilitySets: []azurev1alpha1.AvailabilitySet{
-			{Name: state.AvailabilitySetName, ID: state.AvailabilitySetID, Purpose: azurev1alpha1.PurposeNodes},
-		},
 		RouteTables: []azurev1alpha1.RouteTable{
 			{Purpose: azurev1alpha1.PurposeNodes, Name: state.RouteTableName},
 		},
 		SecurityGroups: []azurev1alpha1.SecurityGroup{
 			{Name: state.SecurityGroupName, Purpose: azurev1alpha1.PurposeNodes},
 		},
+		Zoned: state.Zoned,
+	}
+
+	if !state.Zoned {
+		status.AvailabilitySets = []azurev1alpha1.AvailabilitySet{
+			{Name: state.AvailabilitySetName, ID: state.AvailabilitySetID, Purpose: azurev1alpha1.PurposeNodes},
+		}
 	}
+	return status
 }
 
 // ComputeStatus computes the status based on the Terraformer and the given InfrastructureConfig.
@@ -140,6 +140,7 @@ var _ = Describe("Terraform", func() {
 					},
 				},
 				"clusterName": infra.Namespace,
+				"zoned":       false,
 				"networks": map[string]interface{}{
 					"worker": config.Networks.Workers,
 				},
@@ -155,6 +156,24 @@ var _ = Describe("Terraform", func() {
 			}
 			Expect(values).To(BeEquivalentTo(expectedValues))
 		})
+
+		It("should not compute the domain counts for a zoned infrastructure", func() {
+			config.Zoned = true
+			cluster.CloudProfile.Spec.Azure.CountUpdateDomains = nil
+			cluster.CloudProfile.Spec.Azure.CountFaultDomains = nil
+
+			values, err := ComputeTerraformerChartValues(infra, clientAuth, config, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+
+			Expect(values).To(HaveKeyWithValue("zoned", true))
+			Expect(values).To(HaveKeyWithValue("azure", map[string]interface{}{
+				"subscriptionID":     clientAuth.SubscriptionID,
+				"tenantID":           clientAuth.TenantID,
+				"region":             infra.Spec.Region,
+				"countUpdateDomains": 0,
+				"countFaultDomains":  0,
+			}))
+		})
 	})
 
 	Describe("#StatusFromTerraformState", func() {
@@ -210,5 +229,13 @@ var _ = Describe("Terraform", func() {
 				},
 			}))
 		})
+
+		It("should correctly compute the status for a zoned infrastructure", func() {
+			state.Zoned = true
+			status := StatusFromTerraformState(state)
+
+			Expect(status.Zoned).To(BeTrue())
+			Expect(status.AvailabilitySets).To(BeEmpty())
+		})
 	})
 })
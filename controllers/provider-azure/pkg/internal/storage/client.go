@@ -0,0 +1,100 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+)
+
+type client struct {
+	serviceURL azblob.ServiceURL
+}
+
+// NewFromStorageAccount creates a new Azure Blob storage client for the given storage account.
+// Blobs are always encrypted at rest by the Azure Storage Service Encryption.
+func NewFromStorageAccount(storageAccountName, storageAccessKey string) (Interface, error) {
+	credential, err := azblob.NewSharedKeyCredential(storageAccountName, storageAccessKey)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(fmt.Sprintf("https://%s.blob.core.windows.net", storageAccountName))
+	if err != nil {
+		return nil, err
+	}
+
+	return New(azblob.NewServiceURL(*u, azblob.NewPipeline(credential, azblob.PipelineOptions{}))), nil
+}
+
+// New creates a new Azure Blob storage client backed by the given service URL.
+func New(serviceURL azblob.ServiceURL) Interface {
+	return &client{serviceURL}
+}
+
+// DefaultFactory instantiates a default Factory.
+func DefaultFactory() Factory {
+	return FactoryFunc(NewFromStorageAccount)
+}
+
+// CreateBucketIfNotExists implements Interface.
+func (c *client) CreateBucketIfNotExists(ctx context.Context, bucket, _ string) error {
+	if _, err := c.serviceURL.NewContainerURL(bucket).Create(ctx, nil, azblob.PublicAccessNone); err != nil && !hasServiceCode(err, azblob.ServiceCodeContainerAlreadyExists) {
+		return err
+	}
+	return nil
+}
+
+// DeleteBucketIfExists implements Interface.
+// Deleting a container also deletes all blobs stored in it.
+func (c *client) DeleteBucketIfExists(ctx context.Context, bucket string) error {
+	if _, err := c.serviceURL.NewContainerURL(bucket).Delete(ctx, azblob.ContainerAccessConditions{}); err != nil && !hasServiceCode(err, azblob.ServiceCodeContainerNotFound) {
+		return err
+	}
+	return nil
+}
+
+// DeleteObjectsWithPrefix implements Interface.
+func (c *client) DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error {
+	containerURL := c.serviceURL.NewContainerURL(bucket)
+
+	for marker := (azblob.Marker{}); marker.NotDone(); {
+		blobs, err := containerURL.ListBlobsFlatSegment(ctx, marker, azblob.ListBlobsSegmentOptions{Prefix: prefix})
+		if err != nil {
+			if hasServiceCode(err, azblob.ServiceCodeContainerNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		for _, blob := range blobs.Segment.BlobItems {
+			if _, err := containerURL.NewBlobURL(blob.Name).Delete(ctx, azblob.DeleteSnapshotsOptionInclude, azblob.BlobAccessConditions{}); err != nil && !hasServiceCode(err, azblob.ServiceCodeBlobNotFound) {
+				return err
+			}
+		}
+		marker = blobs.NextMarker
+	}
+	return nil
+}
+
+func hasServiceCode(err error, code azblob.ServiceCodeType) bool {
+	if serr, ok := err.(azblob.StorageError); ok {
+		return serr.ServiceCode() == code
+	}
+	return false
+}
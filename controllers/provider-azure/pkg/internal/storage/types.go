@@ -0,0 +1,44 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+)
+
+// Interface is the interface for the Azure Blob storage operations needed to manage backups.
+type Interface interface {
+	// CreateBucketIfNotExists creates the container with the given name if it does not exist yet.
+	// The region is ignored as the location of a container is determined by its storage account.
+	CreateBucketIfNotExists(ctx context.Context, bucket, region string) error
+	// DeleteBucketIfExists deletes the container with the given name including all its blobs if it exists.
+	DeleteBucketIfExists(ctx context.Context, bucket string) error
+	// DeleteObjectsWithPrefix deletes all blobs of the given container whose name starts with the given prefix.
+	DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error
+}
+
+// Factory is the factory to instantiate Azure Blob storage clients.
+type Factory interface {
+	// NewFromStorageAccount creates a new Azure Blob storage client for the given storage account.
+	NewFromStorageAccount(storageAccountName, storageAccessKey string) (Interface, error)
+}
+
+// FactoryFunc is a function that implements Factory.
+type FactoryFunc func(storageAccountName, storageAccessKey string) (Interface, error)
+
+// NewFromStorageAccount implements Factory.
+func (f FactoryFunc) NewFromStorageAccount(storageAccountName, storageAccessKey string) (Interface, error) {
+	return f(storageAccountName, storageAccessKey)
+}
@@ -16,6 +16,7 @@ package internal
 
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/imagevector"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"k8s.io/client-go/rest"
@@ -57,3 +58,24 @@ func NewTerraformer(
 
 	return tf.SetVariablesEnvironment(variables), nil
 }
+
+// NewPlanTerraformer initializes a new Terraformer that has the azure auth credentials and is able to plan changes.
+func NewPlanTerraformer(
+	restConfig *rest.Config,
+	clientAuth *ClientAuth,
+	purpose,
+	namespace,
+	name string,
+) (extensionsterraformer.Interface, error) {
+	tf, err := extensionsterraformer.DefaultFactory().NewForConfig(logger.NewLogger("info"), restConfig, purpose, namespace, name, imagevector.TerraformerImage())
+	if err != nil {
+		return nil, err
+	}
+
+	variables, err := TerraformVariablesEnvironmentFromClientAuth(clientAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	return tf.SetVariablesEnvironment(variables), nil
+}
@@ -39,6 +39,43 @@ resource "google_compute_subnetwork" "subnetwork-internal" {
   region        = "{{ required "google.region is required" .Values.google.region }}"
 }
 {{- end}}
+
+{{ if .Values.create.cloudRouter -}}
+resource "google_compute_router" "router" {
+  name    = "{{ required "clusterName is required" .Values.clusterName }}-cloud-router"
+  region  = "{{ required "google.region is required" .Values.google.region }}"
+  network = "{{ required "vpc.name is required" .Values.vpc.name }}"
+}
+{{- end}}
+
+{{ if .Values.create.cloudNAT -}}
+{{ range $natIP := .Values.networks.cloudNAT.natIPNames -}}
+data "google_compute_address" "{{ $natIP.name }}" {
+  name   = "{{ $natIP.name }}"
+  region = "{{ required "google.region is required" $.Values.google.region }}"
+}
+
+{{ end -}}
+resource "google_compute_router_nat" "nat" {
+  name                               = "{{ required "clusterName is required" .Values.clusterName }}-cloud-nat"
+  router                             = "{{ required "vpc.cloudRouter is required" .Values.vpc.cloudRouter }}"
+  region                             = "{{ required "google.region is required" .Values.google.region }}"
+  min_ports_per_vm                   = "{{ required "networks.cloudNAT.minPortsPerVM is required" .Values.networks.cloudNAT.minPortsPerVM }}"
+  source_subnetwork_ip_ranges_to_nat = "LIST_OF_SUBNETWORKS"
+{{- if .Values.networks.cloudNAT.natIPNames }}
+  nat_ip_allocate_option             = "MANUAL_ONLY"
+  nat_ips                            = [{{ range $i, $natIP := .Values.networks.cloudNAT.natIPNames }}{{ if $i }}, {{ end }}"${data.google_compute_address.{{ $natIP.name }}.self_link}"{{ end }}]
+{{- else }}
+  nat_ip_allocate_option             = "AUTO_ONLY"
+{{- end }}
+
+  subnetwork {
+    name                    = "${google_compute_subnetwork.subnetwork-nodes.self_link}"
+    source_ip_ranges_to_nat = ["ALL_IP_RANGES"]
+  }
+}
+{{- end}}
+
 //=====================================================================
 //= Firewall
 //=====================================================================
@@ -133,3 +170,13 @@ output "{{ .Values.outputKeys.subnetInternal }}" {
   value = "${google_compute_subnetwork.subnetwork-internal.name}"
 }
 {{- end}}
+{{ if .Values.create.cloudNAT -}}
+output "{{ .Values.outputKeys.cloudRouter }}" {
+  value = "{{ required "vpc.cloudRouter is required" .Values.vpc.cloudRouter }}"
+}
+{{- end}}
+{{ if .Values.networks.cloudNAT.natIPNames -}}
+output "{{ .Values.outputKeys.natIPs }}" {
+  value = "{{ range $i, $natIP := .Values.networks.cloudNAT.natIPNames }}{{ if $i }},{{ end }}${data.google_compute_address.{{ $natIP.name }}.address}{{ end }}"
+}
+{{- end}}
@@ -34,6 +34,9 @@ type InfrastructureConfig struct {
 type NetworkConfig struct {
 	// VPC indicates whether to use an existing VPC or create a new one.
 	VPC *VPC
+	// CloudNAT contains configuration about the Cloud NAT resource. If set, a Cloud Router and a
+	// Cloud NAT are created so that the worker nodes can reach the internet without public IPs.
+	CloudNAT *CloudNAT
 	// Internal is a private subnet (used for internal load balancers).
 	Internal *gardencorev1alpha1.CIDR
 	// Workers is the worker subnet range to create (used for the VMs).
@@ -60,6 +63,9 @@ type NetworkStatus struct {
 
 	// Subnets are the subnets that have been created.
 	Subnets []Subnet
+
+	// NatIPs is a list of all user provided external IPs which are used by the Cloud NAT.
+	NatIPs []NatIP
 }
 
 // SubnetPurpose is a purpose of a subnet.
@@ -84,4 +90,34 @@ type Subnet struct {
 type VPC struct {
 	// Name is the VPC name.
 	Name string
+	// CloudRouter indicates whether to use an existing Cloud Router or create a new one.
+	CloudRouter *CloudRouter
+}
+
+// CloudRouter contains information about the Cloud Router configuration.
+type CloudRouter struct {
+	// Name is the Cloud Router name.
+	Name string
+}
+
+// CloudNAT contains configuration about the Cloud NAT resource.
+type CloudNAT struct {
+	// MinPortsPerVM is the minimum number of ports allocated to a VM in the NAT config.
+	// The default value is 2048 ports.
+	MinPortsPerVM *int32
+	// NatIPNames is a list of all user provided external IPs (referenced by their address names)
+	// which are used by the Cloud NAT. If empty, the IPs are allocated automatically.
+	NatIPNames []NatIPName
+}
+
+// NatIPName is the name of a user provided external IP address resource.
+type NatIPName struct {
+	// Name is the name of the IP address resource.
+	Name string
+}
+
+// NatIP is a user provided external IP which is used by the Cloud NAT.
+type NatIP struct {
+	// IP is the external IP address.
+	IP string
 }
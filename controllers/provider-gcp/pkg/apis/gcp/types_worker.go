@@ -0,0 +1,44 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains configuration settings for the machines of a worker pool.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// DataVolumes is a list of additional disks attached to the machines.
+	DataVolumes []DataVolume
+	// Preemptible indicates whether preemptible machines are used for the worker pool.
+	Preemptible *bool
+	// AdditionalNetworkTags is a list of network tags that are attached to the machines in addition
+	// to the default ones.
+	AdditionalNetworkTags []string
+}
+
+// DataVolume contains the settings of an additional disk.
+type DataVolume struct {
+	// Name is the name of the data volume.
+	Name string
+	// Size is the size of the data volume, e.g. 50Gi.
+	Size string
+	// Type is the disk type. Defaults to the volume type of the worker pool.
+	Type *string
+}
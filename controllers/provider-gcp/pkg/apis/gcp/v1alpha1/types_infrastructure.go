@@ -35,6 +35,10 @@ type NetworkConfig struct {
 	// VPC indicates whether to use an existing VPC or create a new one.
 	// +optional
 	VPC *VPC `json:"vpc,omitempty"`
+	// CloudNAT contains configuration about the Cloud NAT resource. If set, a Cloud Router and a
+	// Cloud NAT are created so that the worker nodes can reach the internet without public IPs.
+	// +optional
+	CloudNAT *CloudNAT `json:"cloudNAT,omitempty"`
 	// Internal is a private subnet (used for internal load balancers).
 	// +optional
 	Internal *gardencorev1alpha1.CIDR `json:"internal,omitempty"`
@@ -62,6 +66,10 @@ type NetworkStatus struct {
 
 	// Subnets are the subnets that have been created.
 	Subnets []Subnet `json:"subnets"`
+
+	// NatIPs is a list of all user provided external IPs which are used by the Cloud NAT.
+	// +optional
+	NatIPs []NatIP `json:"natIPs,omitempty"`
 }
 
 // SubnetPurpose is a purpose of a subnet.
@@ -86,4 +94,37 @@ type Subnet struct {
 type VPC struct {
 	// Name is the VPC name.
 	Name string `json:"name,omitempty"`
+	// CloudRouter indicates whether to use an existing Cloud Router or create a new one.
+	// +optional
+	CloudRouter *CloudRouter `json:"cloudRouter,omitempty"`
+}
+
+// CloudRouter contains information about the Cloud Router configuration.
+type CloudRouter struct {
+	// Name is the Cloud Router name.
+	Name string `json:"name"`
+}
+
+// CloudNAT contains configuration about the Cloud NAT resource.
+type CloudNAT struct {
+	// MinPortsPerVM is the minimum number of ports allocated to a VM in the NAT config.
+	// The default value is 2048 ports.
+	// +optional
+	MinPortsPerVM *int32 `json:"minPortsPerVM,omitempty"`
+	// NatIPNames is a list of all user provided external IPs (referenced by their address names)
+	// which are used by the Cloud NAT. If empty, the IPs are allocated automatically.
+	// +optional
+	NatIPNames []NatIPName `json:"natIPNames,omitempty"`
+}
+
+// NatIPName is the name of a user provided external IP address resource.
+type NatIPName struct {
+	// Name is the name of the IP address resource.
+	Name string `json:"name"`
+}
+
+// NatIP is a user provided external IP which is used by the Cloud NAT.
+type NatIP struct {
+	// IP is the external IP address.
+	IP string `json:"ip"`
 }
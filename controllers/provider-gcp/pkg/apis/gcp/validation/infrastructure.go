@@ -0,0 +1,98 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object against the given pod and service networks of the shoot.
+func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, podsCIDR, servicesCIDR string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := field.NewPath("networks")
+
+	if infra.Networks.VPC != nil && len(infra.Networks.VPC.Name) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath.Child("vpc", "name"), "field is required"))
+	}
+
+	if infra.Networks.VPC != nil && infra.Networks.VPC.CloudRouter != nil && len(infra.Networks.VPC.CloudRouter.Name) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath.Child("vpc", "cloudRouter", "name"), "field is required"))
+	}
+
+	if infra.Networks.CloudNAT != nil {
+		allErrs = append(allErrs, validateCloudNAT(infra.Networks.CloudNAT, networksPath.Child("cloudNAT"))...)
+	}
+
+	workerCIDR := string(infra.Networks.Worker)
+	allErrs = append(allErrs, validateNetwork(workerCIDR, networksPath.Child("worker"), podsCIDR, servicesCIDR)...)
+
+	if infra.Networks.Internal != nil {
+		internalPath := networksPath.Child("internal")
+		internalCIDR := string(*infra.Networks.Internal)
+		allErrs = append(allErrs, validateNetwork(internalCIDR, internalPath, podsCIDR, servicesCIDR)...)
+		allErrs = append(allErrs, extensionsvalidation.ValidateCIDRDisjoint(internalCIDR, internalPath, workerCIDR, "worker network")...)
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update.
+// The networks are immutable, except for the Cloud NAT configuration.
+func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisgcp.InfrastructureConfig) field.ErrorList {
+	oldNetworks, newNetworks := oldConfig.Networks.DeepCopy(), newConfig.Networks.DeepCopy()
+	oldNetworks.CloudNAT, newNetworks.CloudNAT = nil, nil
+
+	return apivalidation.ValidateImmutableField(*newNetworks, *oldNetworks, field.NewPath("networks"))
+}
+
+func validateCloudNAT(cloudNAT *apisgcp.CloudNAT, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if minPorts := cloudNAT.MinPortsPerVM; minPorts != nil && (*minPorts < 1 || *minPorts > 65536) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minPortsPerVM"), *minPorts, "must be between 1 and 65536"))
+	}
+
+	natIPNames := sets.NewString()
+	for i, natIPName := range cloudNAT.NatIPNames {
+		namePath := fldPath.Child("natIPNames").Index(i).Child("name")
+		if len(natIPName.Name) == 0 {
+			allErrs = append(allErrs, field.Required(namePath, "field is required"))
+			continue
+		}
+		for _, msg := range utilvalidation.IsDNS1035Label(natIPName.Name) {
+			allErrs = append(allErrs, field.Invalid(namePath, natIPName.Name, msg))
+		}
+		if natIPNames.Has(natIPName.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, natIPName.Name))
+		}
+		natIPNames.Insert(natIPName.Name)
+	}
+
+	return allErrs
+}
+
+func validateNetwork(cidr string, fldPath *field.Path, podsCIDR, servicesCIDR string) field.ErrorList {
+	allErrs := extensionsvalidation.ValidateCIDR(cidr, fldPath)
+	allErrs = append(allErrs, extensionsvalidation.ValidateCIDRDisjoint(cidr, fldPath, podsCIDR, "pod network")...)
+	allErrs = append(allErrs, extensionsvalidation.ValidateCIDRDisjoint(cidr, fldPath, servicesCIDR, "service network")...)
+	return allErrs
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	const (
+		podsCIDR     = "100.96.0.0/11"
+		servicesCIDR = "100.64.0.0/13"
+	)
+
+	var (
+		infrastructureConfig *apisgcp.InfrastructureConfig
+
+		internalCIDR = gardencorev1alpha1.CIDR("10.250.112.0/22")
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisgcp.InfrastructureConfig{
+			Networks: apisgcp.NetworkConfig{
+				VPC:      &apisgcp.VPC{Name: "existing"},
+				Internal: &internalCIDR,
+				Worker:   "10.250.0.0/19",
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(BeEmpty())
+		})
+
+		It("should forbid an empty vpc name", func() {
+			infrastructureConfig.Networks.VPC.Name = ""
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.vpc.name"),
+			}))))
+		})
+
+		It("should forbid an empty cloud router name", func() {
+			infrastructureConfig.Networks.VPC.CloudRouter = &apisgcp.CloudRouter{}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.vpc.cloudRouter.name"),
+			}))))
+		})
+
+		It("should allow a valid cloud NAT configuration", func() {
+			minPortsPerVM := int32(4096)
+			infrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+				MinPortsPerVM: &minPortsPerVM,
+				NatIPNames:    []apisgcp.NatIPName{{Name: "nat-ip-1"}, {Name: "nat-ip-2"}},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid cloud NAT configuration", func() {
+			minPortsPerVM := int32(0)
+			infrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+				MinPortsPerVM: &minPortsPerVM,
+				NatIPNames:    []apisgcp.NatIPName{{Name: ""}, {Name: "Invalid_Name"}, {Name: "nat-ip"}, {Name: "nat-ip"}},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudNAT.minPortsPerVM"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.cloudNAT.natIPNames[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudNAT.natIPNames[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.cloudNAT.natIPNames[3].name"),
+				})),
+			))
+		})
+
+		It("should forbid an invalid worker CIDR", func() {
+			infrastructureConfig.Networks.Worker = "not-a-cidr"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.worker"),
+			}))))
+		})
+
+		It("should forbid an internal CIDR overlapping with the worker CIDR", func() {
+			overlappingCIDR := gardencorev1alpha1.CIDR("10.250.0.0/22")
+			infrastructureConfig.Networks.Internal = &overlappingCIDR
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.internal"),
+			}))))
+		})
+
+		It("should forbid CIDRs overlapping with the pod and service networks", func() {
+			overlappingCIDR := gardencorev1alpha1.CIDR("100.64.0.0/22")
+			infrastructureConfig.Networks.Internal = &overlappingCIDR
+			infrastructureConfig.Networks.Worker = "100.96.0.0/19"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.worker"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.internal"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should allow an unchanged configuration", func() {
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, infrastructureConfig.DeepCopy())).To(BeEmpty())
+		})
+
+		It("should forbid changing the networks", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Worker = "10.250.0.0/20"
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks"),
+			}))))
+		})
+
+		It("should allow changing the cloud NAT configuration", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+				NatIPNames: []apisgcp.NatIPName{{Name: "nat-ip"}},
+			}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+		})
+	})
+})
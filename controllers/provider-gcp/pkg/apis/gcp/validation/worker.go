@@ -0,0 +1,62 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateWorkerConfig validates a WorkerConfig object of a worker pool.
+func ValidateWorkerConfig(workerConfig *apisgcp.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.NewString()
+	for i, dataVolume := range workerConfig.DataVolumes {
+		dataVolumePath := fldPath.Child("dataVolumes").Index(i)
+
+		if len(dataVolume.Name) == 0 {
+			allErrs = append(allErrs, field.Required(dataVolumePath.Child("name"), "field is required"))
+		} else if names.Has(dataVolume.Name) {
+			allErrs = append(allErrs, field.Duplicate(dataVolumePath.Child("name"), dataVolume.Name))
+		} else {
+			names.Insert(dataVolume.Name)
+		}
+
+		if size, err := resource.ParseQuantity(dataVolume.Size); err != nil {
+			allErrs = append(allErrs, field.Invalid(dataVolumePath.Child("size"), dataVolume.Size, fmt.Sprintf("must be a valid quantity: %v", err)))
+		} else if size.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(dataVolumePath.Child("size"), dataVolume.Size, "must be greater than 0"))
+		}
+
+		if dataVolume.Type != nil && len(*dataVolume.Type) == 0 {
+			allErrs = append(allErrs, field.Required(dataVolumePath.Child("type"), "must not be empty if set"))
+		}
+	}
+
+	for i, tag := range workerConfig.AdditionalNetworkTags {
+		for _, msg := range validation.IsDNS1035Label(tag) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("additionalNetworkTags").Index(i), tag, msg))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("WorkerConfig validation", func() {
+	var (
+		workerConfig *apisgcp.WorkerConfig
+		fldPath      = field.NewPath("providerConfig")
+
+		preemptible = true
+		ssd         = "pd-ssd"
+	)
+
+	BeforeEach(func() {
+		workerConfig = &apisgcp.WorkerConfig{
+			DataVolumes: []apisgcp.DataVolume{
+				{
+					Name: "data",
+					Size: "50Gi",
+					Type: &ssd,
+				},
+			},
+			Preemptible:           &preemptible,
+			AdditionalNetworkTags: []string{"my-tag"},
+		}
+	})
+
+	Describe("#ValidateWorkerConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid data volumes", func() {
+			empty := ""
+			workerConfig.DataVolumes = append(workerConfig.DataVolumes, apisgcp.DataVolume{Name: "data", Size: "not-a-size", Type: &empty})
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("providerConfig.dataVolumes[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.dataVolumes[1].size"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("providerConfig.dataVolumes[1].type"),
+				})),
+			))
+		})
+
+		It("should forbid invalid network tags", func() {
+			workerConfig.AdditionalNetworkTags = []string{"Invalid_Tag"}
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.additionalNetworkTags[0]"),
+			}))))
+		})
+	})
+})
@@ -0,0 +1,80 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/storage"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/go-logr/logr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+type actuator struct {
+	logger               logr.Logger
+	storageClientFactory storage.Factory
+
+	client client.Client
+}
+
+// NewActuator creates a new Actuator that purges the backup prefixes of the handled BackupEntry resources.
+func NewActuator() backupentry.Actuator {
+	return NewActuatorWithDeps(log.Log.WithName("backupentry-actuator"), storage.DefaultFactory())
+}
+
+// NewActuatorWithDeps creates a new Actuator with the given dependencies.
+func NewActuatorWithDeps(logger logr.Logger, storageClientFactory storage.Factory) backupentry.Actuator {
+	return &actuator{
+		logger:               logger,
+		storageClientFactory: storageClientFactory,
+	}
+}
+
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+// Reconcile does nothing. Object stores have no directories, so the backup prefix of a BackupEntry needs no setup:
+// it comes into existence when the etcd backup sidecar writes the first snapshot below it. Bucket settings like the
+// encryption are configured by the BackupBucket actuator. The prefix is purged by Delete after the deletion grace
+// period of the BackupEntry controller.
+func (a *actuator) Reconcile(_ context.Context, _ *extensionsv1alpha1.BackupEntry) error {
+	return nil
+}
+
+// Delete deletes all objects stored below the backup prefix of the BackupEntry.
+func (a *actuator) Delete(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
+	serviceAccount, err := internal.GetServiceAccount(ctx, a.client, be.Spec.SecretRef)
+	if err != nil {
+		return err
+	}
+
+	storageClient, err := a.storageClientFactory.NewFromServiceAccount(ctx, serviceAccount.ProjectID, serviceAccount.Raw)
+	if err != nil {
+		return err
+	}
+
+	a.logger.Info("Purging backup prefix", "backupentry", be.Name, "bucket", be.Spec.BucketName)
+	return storageClient.DeleteObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/", be.Name))
+}
@@ -27,7 +27,9 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -98,38 +100,56 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	deploymentNames, err := worker.NewDeploymentNames(ctx, w.client, w.worker.Namespace)
+	if err != nil {
+		return err
+	}
+
 	for _, pool := range w.worker.Spec.Pools {
-		zoneLen := len(pool.Zones)
+		zoneDistribution, err := worker.NewZoneDistribution(pool.Zones, pool.Annotations)
+		if err != nil {
+			return errors.Wrapf(err, "invalid zone weights of worker pool '%s'", pool.Name)
+		}
 
 		machineImage, err := confighelper.FindImage(w.machineImages, pool.MachineImage.Name, pool.MachineImage.Version)
 		if err != nil {
 			return err
 		}
 
-		volumeSize, err := worker.DiskSize(pool.Volume.Size)
+		workerConfig := &gcpapi.WorkerConfig{}
+		if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
+			if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+				return errors.Wrapf(err, "could not decode provider config of worker pool '%s'", pool.Name)
+			}
+		}
+
+		disks, err := w.computeDisks(pool, workerConfig, machineImage)
 		if err != nil {
 			return err
 		}
 
-		for zoneIndex, zone := range pool.Zones {
+		scheduling := map[string]interface{}{
+			"automaticRestart":  true,
+			"onHostMaintenance": "MIGRATE",
+			"preemptible":       false,
+		}
+		if workerConfig.Preemptible != nil && *workerConfig.Preemptible {
+			// Preemptible instances can neither be restarted automatically nor live migrated.
+			scheduling = map[string]interface{}{
+				"automaticRestart":  false,
+				"onHostMaintenance": "TERMINATE",
+				"preemptible":       true,
+			}
+		}
+
+		for _, zone := range pool.Zones {
 			machineClassSpec := map[string]interface{}{
 				"region":             w.worker.Spec.Region,
 				"zone":               zone,
 				"canIpForward":       true,
 				"deletionProtection": false,
 				"description":        fmt.Sprintf("Machine of Shoot %s created by machine-controller-manager.", w.worker.Name),
-				"disks": []map[string]interface{}{
-					{
-						"autoDelete": true,
-						"boot":       true,
-						"sizeGb":     volumeSize,
-						"type":       pool.Volume.Type,
-						"image":      machineImage,
-						"labels": map[string]interface{}{
-							"name": w.worker.Name,
-						},
-					},
-				},
+				"disks":              disks,
 				"labels": map[string]interface{}{
 					"name": w.worker.Name,
 				},
@@ -139,11 +159,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 						"subnetwork": nodesSubnet.Name,
 					},
 				},
-				"scheduling": map[string]interface{}{
-					"automaticRestart":  true,
-					"onHostMaintenance": "MIGRATE",
-					"preemptible":       false,
-				},
+				"scheduling": scheduling,
 				"secret": map[string]interface{}{
 					"cloudConfig": string(pool.UserData),
 				},
@@ -155,27 +171,29 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 						},
 					},
 				},
-				"tags": []string{
+				"tags": append([]string{
 					w.worker.Namespace,
 					fmt.Sprintf("kubernetes-io-cluster-%s", w.worker.Namespace),
 					"kubernetes-io-role-node",
-				},
+				}, workerConfig.AdditionalNetworkTags...),
 			}
 
 			var (
 				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-				deploymentName       = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
+				deploymentName       = deploymentNames.Name(pool.Name, pool.Zones, zone)
 				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
 				Name:           deploymentName,
+				PoolName:       pool.Name,
+				Zone:           zone,
 				ClassName:      className,
 				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
+				Minimum:        zoneDistribution.Distribute(zone, pool.Minimum),
+				Maximum:        zoneDistribution.Distribute(zone, pool.Maximum),
+				MaxSurge:       zoneDistribution.DistributePositiveIntOrPercent(zone, pool.MaxSurge, pool.Maximum),
+				MaxUnavailable: zoneDistribution.DistributePositiveIntOrPercent(zone, pool.MaxUnavailable, pool.Minimum),
 				Labels:         pool.Labels,
 				Annotations:    pool.Annotations,
 				Taints:         pool.Taints,
@@ -193,3 +211,49 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// computeDisks computes the disks for the machines of the given worker pool. The boot disk comes first,
+// followed by the data volumes.
+func (w *workerDelegate) computeDisks(pool extensionsv1alpha1.WorkerPool, workerConfig *gcpapi.WorkerConfig, machineImage string) ([]map[string]interface{}, error) {
+	volumeSize, err := worker.DiskSize(pool.Volume.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	disks := []map[string]interface{}{
+		{
+			"autoDelete": true,
+			"boot":       true,
+			"sizeGb":     volumeSize,
+			"type":       pool.Volume.Type,
+			"image":      machineImage,
+			"labels": map[string]interface{}{
+				"name": w.worker.Name,
+			},
+		},
+	}
+
+	for _, dataVolume := range workerConfig.DataVolumes {
+		dataVolumeSize, err := worker.DiskSize(dataVolume.Size)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute size of data volume '%s'", dataVolume.Name)
+		}
+
+		dataVolumeType := pool.Volume.Type
+		if dataVolume.Type != nil {
+			dataVolumeType = *dataVolume.Type
+		}
+
+		disks = append(disks, map[string]interface{}{
+			"autoDelete": true,
+			"boot":       false,
+			"sizeGb":     dataVolumeSize,
+			"type":       dataVolumeType,
+			"labels": map[string]interface{}{
+				"name": w.worker.Name,
+			},
+		})
+	}
+
+	return disks, nil
+}
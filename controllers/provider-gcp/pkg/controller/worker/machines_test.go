@@ -54,6 +54,8 @@ var _ = Describe("Machines", func() {
 		ctrl = gomock.NewController(GinkgoT())
 
 		c = mockclient.NewMockClient(ctrl)
+		// There are no existing machine deployments whose names must be kept.
+		c.EXPECT().List(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{})).AnyTimes()
 		chartApplier = mockkubernetes.NewMockChartApplier(ctrl)
 	})
 
@@ -312,10 +314,10 @@ var _ = Describe("Machines", func() {
 					machineClassPool2Zone1 = useDefaultMachineClass(defaultMachineClass, "zone", zone1)
 					machineClassPool2Zone2 = useDefaultMachineClass(defaultMachineClass, "zone", zone2)
 
-					machineClassNamePool1Zone1 = fmt.Sprintf("%s-%s-z1", namespace, namePool1)
-					machineClassNamePool1Zone2 = fmt.Sprintf("%s-%s-z2", namespace, namePool1)
-					machineClassNamePool2Zone1 = fmt.Sprintf("%s-%s-z1", namespace, namePool2)
-					machineClassNamePool2Zone2 = fmt.Sprintf("%s-%s-z2", namespace, namePool2)
+					machineClassNamePool1Zone1 = fmt.Sprintf("%s-%s-%s", namespace, namePool1, zone1)
+					machineClassNamePool1Zone2 = fmt.Sprintf("%s-%s-%s", namespace, namePool1, zone2)
+					machineClassNamePool2Zone1 = fmt.Sprintf("%s-%s-%s", namespace, namePool2, zone1)
+					machineClassNamePool2Zone2 = fmt.Sprintf("%s-%s-%s", namespace, namePool2, zone2)
 
 					machineClassHashPool1Zone1 = worker.MachineClassHash(machineClassPool1Zone1, shootVersionMajorMinor)
 					machineClassHashPool1Zone2 = worker.MachineClassHash(machineClassPool1Zone2, shootVersionMajorMinor)
@@ -357,6 +359,8 @@ var _ = Describe("Machines", func() {
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1Zone1,
+						PoolName:       namePool1,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool1Zone1,
 						SecretName:     machineClassWithHashPool1Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool1, 2),
@@ -366,6 +370,8 @@ var _ = Describe("Machines", func() {
 					},
 					{
 						Name:           machineClassNamePool1Zone2,
+						PoolName:       namePool1,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool1Zone2,
 						SecretName:     machineClassWithHashPool1Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool1, 2),
@@ -375,6 +381,8 @@ var _ = Describe("Machines", func() {
 					},
 					{
 						Name:           machineClassNamePool2Zone1,
+						PoolName:       namePool2,
+						Zone:           zone1,
 						ClassName:      machineClassWithHashPool2Zone1,
 						SecretName:     machineClassWithHashPool2Zone1,
 						Minimum:        worker.DistributeOverZones(0, minPool2, 2),
@@ -384,6 +392,8 @@ var _ = Describe("Machines", func() {
 					},
 					{
 						Name:           machineClassNamePool2Zone2,
+						PoolName:       namePool2,
+						Zone:           zone2,
 						ClassName:      machineClassWithHashPool2Zone2,
 						SecretName:     machineClassWithHashPool2Zone2,
 						Minimum:        worker.DistributeOverZones(1, minPool2, 2),
@@ -398,6 +408,125 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should return the expected machine classes for a pool with provider config", func() {
+				expectGetSecretCallToWork(c, serviceAccountJSON)
+
+				var (
+					preemptible = true
+					dataType    = "pd-ssd"
+				)
+
+				w.Spec.Pools = w.Spec.Pools[:1]
+				w.Spec.Pools[0].Zones = []string{zone1}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisgcp.WorkerConfig{
+						DataVolumes: []apisgcp.DataVolume{
+							{
+								Name: "data",
+								Size: "50Gi",
+								Type: &dataType,
+							},
+						},
+						Preemptible:           &preemptible,
+						AdditionalNetworkTags: []string{"my-tag"},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				var (
+					machineClass = map[string]interface{}{
+						"region":             region,
+						"zone":               zone1,
+						"canIpForward":       true,
+						"deletionProtection": false,
+						"description":        fmt.Sprintf("Machine of Shoot %s created by machine-controller-manager.", name),
+						"disks": []map[string]interface{}{
+							{
+								"autoDelete": true,
+								"boot":       true,
+								"sizeGb":     volumeSize,
+								"type":       volumeType,
+								"image":      machineImage,
+								"labels": map[string]interface{}{
+									"name": name,
+								},
+							},
+							{
+								"autoDelete": true,
+								"boot":       false,
+								"sizeGb":     50,
+								"type":       dataType,
+								"labels": map[string]interface{}{
+									"name": name,
+								},
+							},
+						},
+						"labels": map[string]interface{}{
+							"name": name,
+						},
+						"machineType": machineType,
+						"networkInterfaces": []map[string]interface{}{
+							{
+								"subnetwork": subnetName,
+							},
+						},
+						"scheduling": map[string]interface{}{
+							"automaticRestart":  false,
+							"onHostMaintenance": "TERMINATE",
+							"preemptible":       true,
+						},
+						"secret": map[string]interface{}{
+							"cloudConfig": string(userData),
+						},
+						"serviceAccounts": []map[string]interface{}{
+							{
+								"email": serviceAccountEmail,
+								"scopes": []string{
+									"https://www.googleapis.com/auth/compute",
+								},
+							},
+						},
+						"tags": []string{
+							namespace,
+							fmt.Sprintf("kubernetes-io-cluster-%s", namespace),
+							"kubernetes-io-role-node",
+							"my-tag",
+						},
+					}
+
+					machineClassName     = fmt.Sprintf("%s-%s-%s", namespace, namePool1, zone1)
+					machineClassWithHash = fmt.Sprintf("%s-%s", machineClassName, worker.MachineClassHash(machineClass, shootVersionMajorMinor))
+				)
+
+				addNameAndSecretToMachineClass(machineClass, serviceAccountJSON, machineClassWithHash)
+
+				chartApplier.
+					EXPECT().
+					ApplyChart(
+						context.TODO(),
+						filepath.Join(gcp.InternalChartsPath, "machineclass"),
+						namespace,
+						"machineclass",
+						map[string]interface{}{"machineClasses": []map[string]interface{}{machineClass}},
+						nil,
+					).
+					Return(nil)
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+			})
+
+			It("should fail because the provider config cannot be decoded", func() {
+				expectGetSecretCallToWork(c, serviceAccountJSON)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte("not-decodeable")}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
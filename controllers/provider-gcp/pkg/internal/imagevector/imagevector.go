@@ -18,6 +18,9 @@ package imagevector
 import (
 	"strings"
 
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
+	"github.com/gardener/gardener-extensions/pkg/util/chart"
+
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gobuffalo/packr/v2"
 	"k8s.io/apimachinery/pkg/util/runtime"
@@ -31,7 +34,8 @@ const (
 var imageVector imagevector.ImageVector
 
 func init() {
-	box := packr.New("charts", "../../../charts")
+	box := packr.New("provider-gcp-charts", "../../../charts")
+	chart.RegisterSource(chart.NewBoxSource(gcp.ChartsPath, box))
 
 	imagesYaml, err := box.FindString("images.yaml")
 	runtime.Must(err)
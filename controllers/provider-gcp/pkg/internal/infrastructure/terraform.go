@@ -16,6 +16,7 @@ package infrastructure
 
 import (
 	"path/filepath"
+	"strings"
 
 	gcpv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
@@ -32,6 +33,10 @@ import (
 const (
 	// DefaultVPCName is the default VPC terraform name.
 	DefaultVPCName = "${google_compute_network.network.name}"
+	// DefaultRouterName is the default Cloud Router terraform name.
+	DefaultRouterName = "${google_compute_router.router.name}"
+	// DefaultMinPortsPerVM is the default minimum number of ports allocated to a VM by the Cloud NAT.
+	DefaultMinPortsPerVM = 2048
 
 	// TerraformerPurpose is the terraformer infrastructure purpose.
 	TerraformerPurpose = "infra"
@@ -44,6 +49,10 @@ const (
 	TerraformerOutputKeySubnetNodes = "subnet_nodes"
 	// TerraformerOutputKeySubnetInternal is the name of the subnet_internal terraform output variable.
 	TerraformerOutputKeySubnetInternal = "subnet_internal"
+	// TerraformerOutputKeyCloudRouter is the name of the cloud_router terraform output variable.
+	TerraformerOutputKeyCloudRouter = "cloud_router"
+	// TerraformerOutputKeyNatIPs is the name of the nat_ips terraform output variable.
+	TerraformerOutputKeyNatIPs = "nat_ips"
 )
 
 var (
@@ -69,6 +78,12 @@ func ComputeTerraformerChartValues(
 	var (
 		vpcName   = DefaultVPCName
 		createVPC = true
+
+		cloudRouterName   string
+		createCloudRouter bool
+		createCloudNAT    bool
+		minPortsPerVM     = int32(DefaultMinPortsPerVM)
+		natIPNames        []map[string]interface{}
 	)
 
 	networks := getK8SNetworks(cluster)
@@ -78,16 +93,38 @@ func ComputeTerraformerChartValues(
 		vpcName = config.Networks.VPC.Name
 	}
 
+	if cloudNAT := config.Networks.CloudNAT; cloudNAT != nil {
+		createCloudNAT = true
+		createCloudRouter = true
+		cloudRouterName = DefaultRouterName
+
+		if config.Networks.VPC != nil && config.Networks.VPC.CloudRouter != nil {
+			createCloudRouter = false
+			cloudRouterName = config.Networks.VPC.CloudRouter.Name
+		}
+		if cloudNAT.MinPortsPerVM != nil {
+			minPortsPerVM = *cloudNAT.MinPortsPerVM
+		}
+		for _, natIPName := range cloudNAT.NatIPNames {
+			natIPNames = append(natIPNames, map[string]interface{}{
+				"name": natIPName.Name,
+			})
+		}
+	}
+
 	return map[string]interface{}{
 		"google": map[string]interface{}{
 			"region":  infra.Spec.Region,
 			"project": account.ProjectID,
 		},
 		"create": map[string]interface{}{
-			"vpc": createVPC,
+			"vpc":         createVPC,
+			"cloudRouter": createCloudRouter,
+			"cloudNAT":    createCloudNAT,
 		},
 		"vpc": map[string]interface{}{
-			"name": vpcName,
+			"name":        vpcName,
+			"cloudRouter": cloudRouterName,
 		},
 		"clusterName": infra.Namespace,
 		"networks": map[string]interface{}{
@@ -95,12 +132,18 @@ func ComputeTerraformerChartValues(
 			"services": networks.Services,
 			"worker":   config.Networks.Worker,
 			"internal": config.Networks.Internal,
+			"cloudNAT": map[string]interface{}{
+				"minPortsPerVM": minPortsPerVM,
+				"natIPNames":    natIPNames,
+			},
 		},
 		"outputKeys": map[string]interface{}{
 			"vpcName":             TerraformerOutputKeyVPCName,
 			"serviceAccountEmail": TerraformerOutputKeyServiceAccountEmail,
 			"subnetNodes":         TerraformerOutputKeySubnetNodes,
 			"subnetInternal":      TerraformerOutputKeySubnetInternal,
+			"cloudRouter":         TerraformerOutputKeyCloudRouter,
+			"natIPs":              TerraformerOutputKeyNatIPs,
 		},
 	}
 }
@@ -144,6 +187,10 @@ type TerraformState struct {
 	SubnetNodes string
 	// SubnetInternal is the CIDR of the internal subnet of an infrastructure.
 	SubnetInternal *string
+	// CloudRouterName is the name of the Cloud Router used by the Cloud NAT of an infrastructure.
+	CloudRouterName *string
+	// NatIPs are the user provided external IPs used by the Cloud NAT of an infrastructure.
+	NatIPs []string
 }
 
 // ExtractTerraformState extracts the TerraformState from the given Terraformer.
@@ -159,6 +206,16 @@ func ExtractTerraformState(tf *terraformer.Terraformer, config *gcpv1alpha1.Infr
 		outputKeys = append(outputKeys, TerraformerOutputKeySubnetInternal)
 	}
 
+	hasCloudNAT := config.Networks.CloudNAT != nil
+	if hasCloudNAT {
+		outputKeys = append(outputKeys, TerraformerOutputKeyCloudRouter)
+	}
+
+	hasNatIPs := hasCloudNAT && len(config.Networks.CloudNAT.NatIPNames) > 0
+	if hasNatIPs {
+		outputKeys = append(outputKeys, TerraformerOutputKeyNatIPs)
+	}
+
 	vars, err := tf.GetStateOutputVariables(outputKeys...)
 	if err != nil {
 		return nil, err
@@ -173,6 +230,13 @@ func ExtractTerraformState(tf *terraformer.Terraformer, config *gcpv1alpha1.Infr
 		subnetInternal := vars[TerraformerOutputKeySubnetInternal]
 		state.SubnetInternal = &subnetInternal
 	}
+	if hasCloudNAT {
+		cloudRouterName := vars[TerraformerOutputKeyCloudRouter]
+		state.CloudRouterName = &cloudRouterName
+	}
+	if hasNatIPs {
+		state.NatIPs = strings.Split(vars[TerraformerOutputKeyNatIPs], ",")
+	}
 	return state, nil
 }
 
@@ -203,6 +267,16 @@ func StatusFromTerraformState(state *TerraformState) *gcpv1alpha1.Infrastructure
 			Name:    *state.SubnetInternal,
 		})
 	}
+	if state.CloudRouterName != nil {
+		status.Networks.VPC.CloudRouter = &gcpv1alpha1.CloudRouter{
+			Name: *state.CloudRouterName,
+		}
+	}
+	for _, natIP := range state.NatIPs {
+		status.Networks.NatIPs = append(status.Networks.NatIPs, gcpv1alpha1.NatIP{
+			IP: natIP,
+		})
+	}
 	return status
 }
 
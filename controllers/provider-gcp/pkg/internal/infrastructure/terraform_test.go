@@ -105,10 +105,13 @@ var _ = Describe("Terraform", func() {
 					"project": projectID,
 				},
 				"create": map[string]interface{}{
-					"vpc": false,
+					"vpc":         false,
+					"cloudRouter": false,
+					"cloudNAT":    false,
 				},
 				"vpc": map[string]interface{}{
-					"name": config.Networks.VPC.Name,
+					"name":        config.Networks.VPC.Name,
+					"cloudRouter": "",
 				},
 				"clusterName": infra.Namespace,
 				"networks": map[string]interface{}{
@@ -116,12 +119,18 @@ var _ = Describe("Terraform", func() {
 					"services": cluster.Shoot.Spec.Cloud.GCP.Networks.Services,
 					"worker":   config.Networks.Worker,
 					"internal": config.Networks.Internal,
+					"cloudNAT": map[string]interface{}{
+						"minPortsPerVM": int32(DefaultMinPortsPerVM),
+						"natIPNames":    []map[string]interface{}(nil),
+					},
 				},
 				"outputKeys": map[string]interface{}{
 					"vpcName":             TerraformerOutputKeyVPCName,
 					"serviceAccountEmail": TerraformerOutputKeyServiceAccountEmail,
 					"subnetNodes":         TerraformerOutputKeySubnetNodes,
 					"subnetInternal":      TerraformerOutputKeySubnetInternal,
+					"cloudRouter":         TerraformerOutputKeyCloudRouter,
+					"natIPs":              TerraformerOutputKeyNatIPs,
 				},
 			}))
 		})
@@ -136,10 +145,13 @@ var _ = Describe("Terraform", func() {
 					"project": projectID,
 				},
 				"create": map[string]interface{}{
-					"vpc": true,
+					"vpc":         true,
+					"cloudRouter": false,
+					"cloudNAT":    false,
 				},
 				"vpc": map[string]interface{}{
-					"name": DefaultVPCName,
+					"name":        DefaultVPCName,
+					"cloudRouter": "",
 				},
 				"clusterName": infra.Namespace,
 				"networks": map[string]interface{}{
@@ -147,15 +159,70 @@ var _ = Describe("Terraform", func() {
 					"services": cluster.Shoot.Spec.Cloud.GCP.Networks.Services,
 					"worker":   config.Networks.Worker,
 					"internal": config.Networks.Internal,
+					"cloudNAT": map[string]interface{}{
+						"minPortsPerVM": int32(DefaultMinPortsPerVM),
+						"natIPNames":    []map[string]interface{}(nil),
+					},
 				},
 				"outputKeys": map[string]interface{}{
 					"vpcName":             TerraformerOutputKeyVPCName,
 					"serviceAccountEmail": TerraformerOutputKeyServiceAccountEmail,
 					"subnetNodes":         TerraformerOutputKeySubnetNodes,
 					"subnetInternal":      TerraformerOutputKeySubnetInternal,
+					"cloudRouter":         TerraformerOutputKeyCloudRouter,
+					"natIPs":              TerraformerOutputKeyNatIPs,
+				},
+			}))
+		})
+
+		It("should correctly compute the terraformer chart values with cloud NAT and vpc creation", func() {
+			minPortsPerVM := int32(4096)
+			config.Networks.VPC = nil
+			config.Networks.CloudNAT = &gcpv1alpha1.CloudNAT{
+				MinPortsPerVM: &minPortsPerVM,
+				NatIPNames:    []gcpv1alpha1.NatIPName{{Name: "nat-ip-1"}, {Name: "nat-ip-2"}},
+			}
+			values := ComputeTerraformerChartValues(infra, serviceAccount, config, cluster)
+
+			Expect(values).To(HaveKeyWithValue("create", map[string]interface{}{
+				"vpc":         true,
+				"cloudRouter": true,
+				"cloudNAT":    true,
+			}))
+			Expect(values).To(HaveKeyWithValue("vpc", map[string]interface{}{
+				"name":        DefaultVPCName,
+				"cloudRouter": DefaultRouterName,
+			}))
+			Expect(values).To(HaveKeyWithValue("networks", map[string]interface{}{
+				"pods":     cluster.Shoot.Spec.Cloud.GCP.Networks.Pods,
+				"services": cluster.Shoot.Spec.Cloud.GCP.Networks.Services,
+				"worker":   config.Networks.Worker,
+				"internal": config.Networks.Internal,
+				"cloudNAT": map[string]interface{}{
+					"minPortsPerVM": minPortsPerVM,
+					"natIPNames": []map[string]interface{}{
+						{"name": "nat-ip-1"},
+						{"name": "nat-ip-2"},
+					},
 				},
 			}))
 		})
+
+		It("should correctly compute the terraformer chart values with cloud NAT and an existing cloud router", func() {
+			config.Networks.VPC.CloudRouter = &gcpv1alpha1.CloudRouter{Name: "router"}
+			config.Networks.CloudNAT = &gcpv1alpha1.CloudNAT{}
+			values := ComputeTerraformerChartValues(infra, serviceAccount, config, cluster)
+
+			Expect(values).To(HaveKeyWithValue("create", map[string]interface{}{
+				"vpc":         false,
+				"cloudRouter": false,
+				"cloudNAT":    true,
+			}))
+			Expect(values).To(HaveKeyWithValue("vpc", map[string]interface{}{
+				"name":        config.Networks.VPC.Name,
+				"cloudRouter": "router",
+			}))
+		})
 	})
 
 	Describe("#StatusFromTerraformState", func() {
@@ -226,5 +293,21 @@ var _ = Describe("Terraform", func() {
 				ServiceAccountEmail: serviceAccountEmail,
 			}))
 		})
+
+		It("should correctly compute the status with cloud NAT", func() {
+			cloudRouterName := "router"
+			state.CloudRouterName = &cloudRouterName
+			state.NatIPs = []string{"1.2.3.4", "5.6.7.8"}
+			status := StatusFromTerraformState(state)
+
+			Expect(status.Networks.VPC).To(Equal(gcpv1alpha1.VPC{
+				Name:        vpcName,
+				CloudRouter: &gcpv1alpha1.CloudRouter{Name: cloudRouterName},
+			}))
+			Expect(status.Networks.NatIPs).To(Equal([]gcpv1alpha1.NatIP{
+				{IP: "1.2.3.4"},
+				{IP: "5.6.7.8"},
+			}))
+		})
 	})
 })
@@ -0,0 +1,120 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/googleapi"
+	gcs "google.golang.org/api/storage/v1"
+)
+
+// noncurrentObjectsRetentionDays is the number of days after which archived object versions are deleted.
+const noncurrentObjectsRetentionDays = 7
+
+type client struct {
+	projectID string
+	service   *gcs.Service
+}
+
+// NewFromServiceAccount creates a new GCS client from the given service account JSON for the given project.
+func NewFromServiceAccount(ctx context.Context, projectID string, serviceAccount []byte) (Interface, error) {
+	jwt, err := google.JWTConfigFromJSON(serviceAccount, gcs.DevstorageFullControlScope)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := oauth2.NewClient(ctx, jwt.TokenSource(ctx))
+	service, err := gcs.New(httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(projectID, service), nil
+}
+
+// New creates a new GCS client for the given project backed by the given storage service.
+func New(projectID string, service *gcs.Service) Interface {
+	return &client{projectID, service}
+}
+
+// DefaultFactory instantiates a default Factory.
+func DefaultFactory() Factory {
+	return FactoryFunc(NewFromServiceAccount)
+}
+
+// CreateBucketIfNotExists implements Interface.
+// Objects stored in GCS are always encrypted at rest with Google-managed keys, hence only a lifecycle
+// rule that deletes archived object versions is configured.
+func (c *client) CreateBucketIfNotExists(ctx context.Context, bucket, region string) error {
+	isLive := false
+	_, err := c.service.Buckets.Insert(c.projectID, &gcs.Bucket{
+		Name:     bucket,
+		Location: region,
+		Lifecycle: &gcs.BucketLifecycle{
+			Rule: []*gcs.BucketLifecycleRule{
+				{
+					Action: &gcs.BucketLifecycleRuleAction{Type: "Delete"},
+					Condition: &gcs.BucketLifecycleRuleCondition{
+						Age:    noncurrentObjectsRetentionDays,
+						IsLive: &isLive,
+					},
+				},
+			},
+		},
+	}).Context(ctx).Do()
+	if isHTTPStatus(err, http.StatusConflict) {
+		return nil
+	}
+	return err
+}
+
+// DeleteBucketIfExists implements Interface.
+func (c *client) DeleteBucketIfExists(ctx context.Context, bucket string) error {
+	if err := c.DeleteObjectsWithPrefix(ctx, bucket, ""); err != nil {
+		return err
+	}
+
+	if err := c.service.Buckets.Delete(bucket).Context(ctx).Do(); err != nil && !isHTTPStatus(err, http.StatusNotFound) {
+		return err
+	}
+	return nil
+}
+
+// DeleteObjectsWithPrefix implements Interface.
+func (c *client) DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error {
+	err := c.service.Objects.List(bucket).Prefix(prefix).Pages(ctx, func(objects *gcs.Objects) error {
+		for _, object := range objects.Items {
+			if err := c.service.Objects.Delete(bucket, object.Name).Context(ctx).Do(); err != nil && !isHTTPStatus(err, http.StatusNotFound) {
+				return err
+			}
+		}
+		return nil
+	})
+	if isHTTPStatus(err, http.StatusNotFound) {
+		return nil
+	}
+	return err
+}
+
+func isHTTPStatus(err error, code int) bool {
+	if gerr, ok := err.(*googleapi.Error); ok {
+		return gerr.Code == code
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+)
+
+// Interface is the interface for the GCS operations needed to manage backups.
+type Interface interface {
+	// CreateBucketIfNotExists creates the bucket with the given name in the given region if it does not exist yet.
+	CreateBucketIfNotExists(ctx context.Context, bucket, region string) error
+	// DeleteBucketIfExists deletes the bucket with the given name including all its objects if it exists.
+	DeleteBucketIfExists(ctx context.Context, bucket string) error
+	// DeleteObjectsWithPrefix deletes all objects of the given bucket whose name starts with the given prefix.
+	DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error
+}
+
+// Factory is the factory to instantiate GCS clients.
+type Factory interface {
+	// NewFromServiceAccount creates a new GCS client from the given service account JSON
+	// for the given project.
+	NewFromServiceAccount(ctx context.Context, projectID string, serviceAccount []byte) (Interface, error)
+}
+
+// FactoryFunc is a function that implements Factory.
+type FactoryFunc func(ctx context.Context, projectID string, serviceAccount []byte) (Interface, error)
+
+// NewFromServiceAccount implements Factory.
+func (f FactoryFunc) NewFromServiceAccount(ctx context.Context, projectID string, serviceAccount []byte) (Interface, error) {
+	return f(ctx, projectID, serviceAccount)
+}
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/imagevector"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"k8s.io/client-go/rest"
@@ -61,3 +62,24 @@ func NewTerraformer(
 
 	return tf.SetVariablesEnvironment(variables), nil
 }
+
+// NewPlanTerraformer initializes a new Terraformer that has the ServiceAccount credentials and is able to plan changes.
+func NewPlanTerraformer(
+	restConfig *rest.Config,
+	serviceAccount *ServiceAccount,
+	purpose,
+	namespace,
+	name string,
+) (extensionsterraformer.Interface, error) {
+	tf, err := extensionsterraformer.DefaultFactory().NewForConfig(logger.NewLogger("info"), restConfig, purpose, namespace, name, imagevector.TerraformerImage())
+	if err != nil {
+		return nil, err
+	}
+
+	variables, err := TerraformerVariablesEnvironmentFromServiceAccount(serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return tf.SetVariablesEnvironment(variables), nil
+}
@@ -156,6 +156,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*openstack.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig(a.(*WorkerConfig), b.(*openstack.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*openstack.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -440,3 +450,25 @@ func autoConvert_openstack_Subnet_To_v1alpha1_Subnet(in *openstack.Subnet, out *
 func Convert_openstack_Subnet_To_v1alpha1_Subnet(in *openstack.Subnet, out *Subnet, s conversion.Scope) error {
 	return autoConvert_openstack_Subnet_To_v1alpha1_Subnet(in, out, s)
 }
+
+func autoConvert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig(in *WorkerConfig, out *openstack.WorkerConfig, s conversion.Scope) error {
+	out.AdditionalSecurityGroups = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroups))
+	out.AdditionalTags = *(*map[string]string)(unsafe.Pointer(&in.AdditionalTags))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig(in *WorkerConfig, out *openstack.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig(in, out, s)
+}
+
+func autoConvert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(in *openstack.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.AdditionalSecurityGroups = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroups))
+	out.AdditionalTags = *(*map[string]string)(unsafe.Pointer(&in.AdditionalTags))
+	return nil
+}
+
+// Convert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(in *openstack.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
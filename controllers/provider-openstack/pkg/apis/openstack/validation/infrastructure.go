@@ -0,0 +1,57 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object against the given pod and service networks of the shoot.
+func ValidateInfrastructureConfig(infra *apisopenstack.InfrastructureConfig, podsCIDR, servicesCIDR string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(infra.FloatingPoolName) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("floatingPoolName"), "must provide the name of a floating pool"))
+	}
+
+	networksPath := field.NewPath("networks")
+
+	if infra.Networks.Router != nil && len(infra.Networks.Router.ID) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath.Child("router", "id"), "field is required"))
+	}
+
+	workerPath := networksPath.Child("worker")
+	workerCIDR := string(infra.Networks.Worker)
+	allErrs = append(allErrs, extensionsvalidation.ValidateCIDR(workerCIDR, workerPath)...)
+	allErrs = append(allErrs, extensionsvalidation.ValidateCIDRDisjoint(workerCIDR, workerPath, podsCIDR, "pod network")...)
+	allErrs = append(allErrs, extensionsvalidation.ValidateCIDRDisjoint(workerCIDR, workerPath, servicesCIDR, "service network")...)
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update.
+// The floating pool and the networks are immutable.
+func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisopenstack.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.FloatingPoolName, oldConfig.FloatingPoolName, field.NewPath("floatingPoolName"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks, oldConfig.Networks, field.NewPath("networks"))...)
+
+	return allErrs
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	. "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	const (
+		podsCIDR     = "100.96.0.0/11"
+		servicesCIDR = "100.64.0.0/13"
+	)
+
+	var infrastructureConfig *apisopenstack.InfrastructureConfig
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisopenstack.InfrastructureConfig{
+			FloatingPoolName: "fip",
+			Networks: apisopenstack.Networks{
+				Router: &apisopenstack.Router{ID: "router-id"},
+				Worker: "10.250.0.0/19",
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(BeEmpty())
+		})
+
+		It("should require a floating pool name and a router id", func() {
+			infrastructureConfig.FloatingPoolName = ""
+			infrastructureConfig.Networks.Router.ID = ""
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("floatingPoolName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.router.id"),
+				})),
+			))
+		})
+
+		It("should forbid an invalid worker CIDR", func() {
+			infrastructureConfig.Networks.Worker = "not-a-cidr"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.worker"),
+			}))))
+		})
+
+		It("should forbid a worker CIDR overlapping with the pod and service networks", func() {
+			infrastructureConfig.Networks.Worker = "100.64.0.0/10"
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, podsCIDR, servicesCIDR)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.worker"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.worker"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should allow an unchanged configuration", func() {
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, infrastructureConfig.DeepCopy())).To(BeEmpty())
+		})
+
+		It("should forbid changing the floating pool and the networks", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.FloatingPoolName = "other"
+			newInfrastructureConfig.Networks.Worker = "10.250.0.0/20"
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("floatingPoolName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks"),
+				})),
+			))
+		})
+	})
+})
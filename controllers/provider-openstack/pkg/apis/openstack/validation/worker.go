@@ -0,0 +1,59 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// maxTagKeyLength is the maximum length of a metadata key of an OpenStack server.
+const maxTagKeyLength = 255
+
+// ValidateWorkerConfig validates a WorkerConfig object of a worker pool.
+func ValidateWorkerConfig(workerConfig *apisopenstack.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	securityGroups := sets.NewString()
+	for i, securityGroup := range workerConfig.AdditionalSecurityGroups {
+		securityGroupPath := fldPath.Child("additionalSecurityGroups").Index(i)
+
+		if len(securityGroup) == 0 {
+			allErrs = append(allErrs, field.Required(securityGroupPath, "must not be empty"))
+		} else if securityGroups.Has(securityGroup) {
+			allErrs = append(allErrs, field.Duplicate(securityGroupPath, securityGroup))
+		} else {
+			securityGroups.Insert(securityGroup)
+		}
+	}
+
+	tagsPath := fldPath.Child("additionalTags")
+	for key := range workerConfig.AdditionalTags {
+		switch {
+		case len(key) == 0:
+			allErrs = append(allErrs, field.Required(tagsPath, "tag keys must not be empty"))
+		case strings.HasPrefix(key, "kubernetes.io"):
+			allErrs = append(allErrs, field.Forbidden(tagsPath.Key(key), "must not overwrite a tag managed by Gardener"))
+		case len(key) > maxTagKeyLength:
+			allErrs = append(allErrs, field.TooLong(tagsPath.Key(key), key, maxTagKeyLength))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	. "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("WorkerConfig validation", func() {
+	var (
+		workerConfig *apisopenstack.WorkerConfig
+		fldPath      = field.NewPath("providerConfig")
+	)
+
+	BeforeEach(func() {
+		workerConfig = &apisopenstack.WorkerConfig{
+			AdditionalSecurityGroups: []string{"my-group"},
+			AdditionalTags: map[string]string{
+				"team": "foo",
+			},
+		}
+	})
+
+	Describe("#ValidateWorkerConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid empty or duplicate security groups", func() {
+			workerConfig.AdditionalSecurityGroups = []string{"my-group", "", "my-group"}
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("providerConfig.additionalSecurityGroups[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("providerConfig.additionalSecurityGroups[2]"),
+				})),
+			))
+		})
+
+		It("should forbid reserved tags", func() {
+			workerConfig.AdditionalTags["kubernetes.io-role-node"] = "0"
+
+			Expect(ValidateWorkerConfig(workerConfig, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.additionalTags[kubernetes.io-role-node]"),
+			}))))
+		})
+	})
+})
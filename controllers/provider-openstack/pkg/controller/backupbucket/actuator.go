@@ -0,0 +1,97 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/storage"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/go-logr/logr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+type actuator struct {
+	logger               logr.Logger
+	storageClientFactory storage.Factory
+
+	client client.Client
+}
+
+// NewActuator creates a new Actuator that manages Swift containers for the handled BackupBucket resources.
+func NewActuator() backupbucket.Actuator {
+	return NewActuatorWithDeps(log.Log.WithName("backupbucket-actuator"), storage.DefaultFactory())
+}
+
+// NewActuatorWithDeps creates a new Actuator with the given dependencies.
+func NewActuatorWithDeps(logger logr.Logger, storageClientFactory storage.Factory) backupbucket.Actuator {
+	return &actuator{
+		logger:               logger,
+		storageClientFactory: storageClientFactory,
+	}
+}
+
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+// Reconcile ensures that the Swift container of the BackupBucket exists.
+func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	storageClient, err := a.newStorageClient(ctx, bb)
+	if err != nil {
+		return err
+	}
+
+	a.logger.Info("Ensuring backup bucket", "backupbucket", bb.Name)
+	return storageClient.CreateBucketIfNotExists(ctx, bb.Name, bb.Spec.Region)
+}
+
+// Delete deletes the Swift container of the BackupBucket including all objects stored in it.
+func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	storageClient, err := a.newStorageClient(ctx, bb)
+	if err != nil {
+		return err
+	}
+
+	a.logger.Info("Deleting backup bucket", "backupbucket", bb.Name)
+	return storageClient.DeleteBucketIfExists(ctx, bb.Name)
+}
+
+func (a *actuator) newStorageClient(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) (storage.Interface, error) {
+	secret, err := util.GetSecretByRef(ctx, a.client, bb.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	authURL, err := internal.ExtractAuthURL(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := internal.ExtractCredentials(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.storageClientFactory.NewFromCredentials(ctx, authURL, credentials, bb.Spec.Region)
+}
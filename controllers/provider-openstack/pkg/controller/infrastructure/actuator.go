@@ -23,6 +23,7 @@ import (
 	infrainternal "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/infrastructure"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionschartrenderer "github.com/gardener/gardener-extensions/pkg/gardener/chartrenderer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
@@ -70,7 +71,7 @@ func (a *actuator) InjectClient(client client.Client) error {
 func (a *actuator) InjectConfig(config *rest.Config) error {
 	a.restConfig = config
 
-	chartRenderer, err := chartrenderer.NewForConfig(config)
+	chartRenderer, err := extensionschartrenderer.NewForConfig(config)
 	if err != nil {
 		return err
 	}
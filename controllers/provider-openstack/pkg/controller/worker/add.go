@@ -27,7 +27,9 @@ import (
 
 var (
 	// DefaultAddOptions are the default AddOptions for AddToManager.
-	DefaultAddOptions = AddOptions{}
+	DefaultAddOptions = AddOptions{
+		Timeouts: worker.DefaultTimeoutConfig(),
+	}
 )
 
 // AddOptions are options to apply when adding the OpenStack worker controller to the manager.
@@ -36,6 +38,8 @@ type AddOptions struct {
 	Controller controller.Options
 	// MachineImagesToCloudProfilesMapping is the default mapping from machine images to cloud profiles.
 	MachineImagesToCloudProfilesMapping []config.MachineImage
+	// Timeouts are the timeouts for the convergence of the machines.
+	Timeouts worker.TimeoutConfig
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -50,7 +54,7 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	}
 
 	return worker.Add(mgr, worker.AddArgs{
-		Actuator:          NewActuator(opts.MachineImagesToCloudProfilesMapping),
+		Actuator:          NewActuator(opts.MachineImagesToCloudProfilesMapping, opts.Timeouts),
 		ControllerOptions: opts.Controller,
 		Predicates:        worker.DefaultPredicates(mgr.GetClient(), openstack.Type),
 	})
@@ -12,11 +12,16 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:generate packr2
+
 package imagevector
 
 import (
 	"strings"
 
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
+	"github.com/gardener/gardener-extensions/pkg/util/chart"
+
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gobuffalo/packr/v2"
 	"k8s.io/apimachinery/pkg/util/runtime"
@@ -33,7 +38,8 @@ var (
 )
 
 func init() {
-	box := packr.New("charts", "../../charts")
+	box := packr.New("provider-openstack-charts", "../../charts")
+	chart.RegisterSource(chart.NewBoxSource(openstack.ChartsPath, box))
 
 	imagesYaml, err := box.FindString("images.yaml")
 	runtime.Must(err)
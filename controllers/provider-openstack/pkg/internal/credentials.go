@@ -82,6 +82,11 @@ func ExtractCredentials(secret *corev1.Secret) (*Credentials, error) {
 	}, nil
 }
 
+// ExtractAuthURL extracts the OpenStack auth url from the given secret.
+func ExtractAuthURL(secret *corev1.Secret) (string, error) {
+	return getRequired(secret.Data, openstack.AuthURL)
+}
+
 // getRequired checks if the provided map has a valid value for a corresponding key.
 func getRequired(data map[string][]byte, key string) (string, error) {
 	value, ok := data[key]
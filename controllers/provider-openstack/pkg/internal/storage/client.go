@@ -0,0 +1,116 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type client struct {
+	serviceClient *gophercloud.ServiceClient
+}
+
+// NewFromCredentials creates a new Swift client for the object storage endpoint in the given region
+// that authenticates against the given auth url with the given credentials.
+func NewFromCredentials(ctx context.Context, authURL string, credentials *internal.Credentials, region string) (Interface, error) {
+	provider, err := openstack.NewClient(authURL)
+	if err != nil {
+		return nil, err
+	}
+	provider.Context = ctx
+
+	if err := openstack.Authenticate(provider, gophercloud.AuthOptions{
+		IdentityEndpoint: authURL,
+		DomainName:       credentials.DomainName,
+		TenantName:       credentials.TenantName,
+		Username:         credentials.Username,
+		Password:         credentials.Password,
+	}); err != nil {
+		return nil, err
+	}
+
+	serviceClient, err := openstack.NewObjectStorageV1(provider, gophercloud.EndpointOpts{Region: region})
+	if err != nil {
+		return nil, err
+	}
+
+	return New(serviceClient), nil
+}
+
+// New creates a new Swift client backed by the given object storage service client.
+func New(serviceClient *gophercloud.ServiceClient) Interface {
+	return &client{serviceClient}
+}
+
+// DefaultFactory instantiates a default Factory.
+func DefaultFactory() Factory {
+	return FactoryFunc(NewFromCredentials)
+}
+
+// CreateBucketIfNotExists implements Interface.
+// Creating an already existing container succeeds in Swift, hence no existence check is needed.
+func (c *client) CreateBucketIfNotExists(_ context.Context, bucket, _ string) error {
+	return containers.Create(c.serviceClient, bucket, containers.CreateOpts{}).Err
+}
+
+// DeleteBucketIfExists implements Interface.
+// Swift only deletes empty containers, hence all objects are deleted first.
+func (c *client) DeleteBucketIfExists(ctx context.Context, bucket string) error {
+	if err := c.DeleteObjectsWithPrefix(ctx, bucket, ""); err != nil {
+		return err
+	}
+
+	if err := containers.Delete(c.serviceClient, bucket).Err; err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// DeleteObjectsWithPrefix implements Interface.
+func (c *client) DeleteObjectsWithPrefix(_ context.Context, bucket, prefix string) error {
+	err := objects.List(c.serviceClient, bucket, objects.ListOpts{Prefix: prefix}).EachPage(func(page pagination.Page) (bool, error) {
+		names, err := objects.ExtractNames(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, name := range names {
+			if err := objects.Delete(c.serviceClient, bucket, name, nil).Err; err != nil && !isNotFound(err) {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func isNotFound(err error) bool {
+	switch err.(type) {
+	case gophercloud.ErrDefault404, *gophercloud.ErrDefault404:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+)
+
+// Interface is the interface for the Swift operations needed to manage backups.
+type Interface interface {
+	// CreateBucketIfNotExists creates the container with the given name if it does not exist yet.
+	// The region is ignored as the region of a container is determined by the object storage endpoint.
+	CreateBucketIfNotExists(ctx context.Context, bucket, region string) error
+	// DeleteBucketIfExists deletes the container with the given name including all its objects if it exists.
+	DeleteBucketIfExists(ctx context.Context, bucket string) error
+	// DeleteObjectsWithPrefix deletes all objects of the given container whose name starts with the given prefix.
+	DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error
+}
+
+// Factory is the factory to instantiate Swift clients.
+type Factory interface {
+	// NewFromCredentials creates a new Swift client for the object storage endpoint in the given region
+	// that authenticates against the given auth url with the given credentials.
+	NewFromCredentials(ctx context.Context, authURL string, credentials *internal.Credentials, region string) (Interface, error)
+}
+
+// FactoryFunc is a function that implements Factory.
+type FactoryFunc func(ctx context.Context, authURL string, credentials *internal.Credentials, region string) (Interface, error)
+
+// NewFromCredentials implements Factory.
+func (f FactoryFunc) NewFromCredentials(ctx context.Context, authURL string, credentials *internal.Credentials, region string) (Interface, error) {
+	return f(ctx, authURL, credentials, region)
+}
@@ -16,6 +16,7 @@ package internal
 
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/imagevector"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"k8s.io/client-go/rest"
@@ -54,3 +55,21 @@ func NewTerraformer(
 
 	return tf.SetVariablesEnvironment(variables), nil
 }
+
+// NewPlanTerraformer initializes a new Terraformer that has the credentials and is able to plan changes.
+func NewPlanTerraformer(
+	restConfig *rest.Config,
+	creds *Credentials,
+	purpose,
+	namespace,
+	name string,
+) (extensionsterraformer.Interface, error) {
+	tf, err := extensionsterraformer.DefaultFactory().NewForConfig(logger.NewLogger("info"), restConfig, purpose, namespace, name, imagevector.TerraformerImage())
+	if err != nil {
+		return nil, err
+	}
+
+	variables := TerraformerVariablesEnvironmentFromCredentials(creds)
+
+	return tf.SetVariablesEnvironment(variables), nil
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	openstackvalidation "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/validation"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/webhook/validation"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewValidator creates a new validator that validates the provider configuration of OpenStack resources.
+func NewValidator() validation.Validator {
+	return &validator{}
+}
+
+type validator struct {
+	client  client.Client
+	decoder runtime.Decoder
+}
+
+// InjectClient injects the given client into the validator.
+func (v *validator) InjectClient(client client.Client) error {
+	v.client = client
+	return nil
+}
+
+// InjectScheme injects the given scheme into the validator.
+func (v *validator) InjectScheme(scheme *runtime.Scheme) error {
+	v.decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+	return nil
+}
+
+// Validate validates the given new object, taking into account the old object on updates.
+func (v *validator) Validate(ctx context.Context, new, old runtime.Object) error {
+	switch x := new.(type) {
+	case *extensionsv1alpha1.Infrastructure:
+		return v.validateInfrastructure(ctx, x, old)
+	case *extensionsv1alpha1.ControlPlane:
+		return v.validateControlPlane(x, old)
+	case *extensionsv1alpha1.Worker:
+		return v.validateWorker(x, old)
+	}
+	return nil
+}
+
+func (v *validator) validateInfrastructure(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, old runtime.Object) error {
+	if infra.Spec.ProviderConfig == nil {
+		return nil
+	}
+
+	oldInfra, isUpdate := old.(*extensionsv1alpha1.Infrastructure)
+	if isUpdate && !validation.ProviderConfigChanged(infra.Spec.ProviderConfig, oldInfra.Spec.ProviderConfig) {
+		// Updates of e.g. finalizers, annotations or the status must not be blocked by an unchanged provider config.
+		return nil
+	}
+
+	config := &apisopenstack.InfrastructureConfig{}
+	if _, _, err := v.decoder.Decode(infra.Spec.ProviderConfig.Raw, nil, config); err != nil {
+		return errors.Wrapf(err, "could not decode provider config of infrastructure '%s'", infra.Name)
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, v.client, infra.Namespace)
+	if err != nil {
+		return errors.Wrapf(err, "could not get cluster for infrastructure '%s'", infra.Name)
+	}
+
+	allErrs := openstackvalidation.ValidateInfrastructureConfig(config,
+		string(extensionscontroller.GetPodNetwork(cluster.Shoot)), string(extensionscontroller.GetServiceNetwork(cluster.Shoot)))
+
+	if isUpdate && oldInfra.Spec.ProviderConfig != nil {
+		oldConfig := &apisopenstack.InfrastructureConfig{}
+		if _, _, err := v.decoder.Decode(oldInfra.Spec.ProviderConfig.Raw, nil, oldConfig); err != nil {
+			return errors.Wrapf(err, "could not decode old provider config of infrastructure '%s'", infra.Name)
+		}
+		allErrs = append(allErrs, openstackvalidation.ValidateInfrastructureConfigUpdate(oldConfig, config)...)
+	}
+
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(extensionsv1alpha1.Kind("Infrastructure"), infra.Name, allErrs)
+	}
+	return nil
+}
+
+func (v *validator) validateControlPlane(cp *extensionsv1alpha1.ControlPlane, old runtime.Object) error {
+	if cp.Spec.ProviderConfig == nil {
+		return nil
+	}
+
+	oldCP, isUpdate := old.(*extensionsv1alpha1.ControlPlane)
+	if isUpdate && !validation.ProviderConfigChanged(cp.Spec.ProviderConfig, oldCP.Spec.ProviderConfig) {
+		// Updates of e.g. finalizers, annotations or the status must not be blocked by an unchanged provider config.
+		return nil
+	}
+
+	config := &apisopenstack.ControlPlaneConfig{}
+	if _, _, err := v.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, config); err != nil {
+		return errors.Wrapf(err, "could not decode provider config of controlplane '%s'", cp.Name)
+	}
+
+	allErrs := openstackvalidation.ValidateControlPlaneConfig(config)
+
+	if isUpdate && oldCP.Spec.ProviderConfig != nil {
+		oldConfig := &apisopenstack.ControlPlaneConfig{}
+		if _, _, err := v.decoder.Decode(oldCP.Spec.ProviderConfig.Raw, nil, oldConfig); err != nil {
+			return errors.Wrapf(err, "could not decode old provider config of controlplane '%s'", cp.Name)
+		}
+		allErrs = append(allErrs, openstackvalidation.ValidateControlPlaneConfigUpdate(oldConfig, config)...)
+	}
+
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(extensionsv1alpha1.Kind("ControlPlane"), cp.Name, allErrs)
+	}
+	return nil
+}
+
+func (v *validator) validateWorker(worker *extensionsv1alpha1.Worker, old runtime.Object) error {
+	oldWorker, _ := old.(*extensionsv1alpha1.Worker)
+
+	allErrs := field.ErrorList{}
+
+	poolsPath := field.NewPath("spec", "pools")
+	for i, pool := range worker.Spec.Pools {
+		if pool.ProviderConfig == nil || pool.ProviderConfig.Raw == nil {
+			continue
+		}
+		if !validation.WorkerPoolProviderConfigChanged(pool, oldWorker) {
+			// Pools whose provider config is unchanged must not be blocked by a provider config that was accepted before.
+			continue
+		}
+
+		config := &apisopenstack.WorkerConfig{}
+		if _, _, err := v.decoder.Decode(pool.ProviderConfig.Raw, nil, config); err != nil {
+			return errors.Wrapf(err, "could not decode provider config of worker pool '%s'", pool.Name)
+		}
+
+		allErrs = append(allErrs, openstackvalidation.ValidateWorkerConfig(config, poolsPath.Index(i).Child("providerConfig"))...)
+	}
+
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(extensionsv1alpha1.Kind("Worker"), worker.Name, allErrs)
+	}
+	return nil
+}
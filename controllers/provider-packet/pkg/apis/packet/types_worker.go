@@ -0,0 +1,45 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packet
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains configuration settings for the machines of a worker pool.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// BillingCycle is the billing cycle of the machines. Defaults to 'hourly'.
+	BillingCycle *BillingCycle
+	// AdditionalTags is a list of tags that are added to the machines in addition to the default ones.
+	AdditionalTags []string
+}
+
+// BillingCycle is a constant for the billing cycles of Packet devices.
+type BillingCycle string
+
+const (
+	// BillingCycleHourly is a constant for hourly billed devices.
+	BillingCycleHourly BillingCycle = "hourly"
+	// BillingCycleDaily is a constant for daily billed devices.
+	BillingCycleDaily BillingCycle = "daily"
+	// BillingCycleMonthly is a constant for monthly billed devices.
+	BillingCycleMonthly BillingCycle = "monthly"
+	// BillingCycleYearly is a constant for yearly billed devices.
+	BillingCycleYearly BillingCycle = "yearly"
+)
@@ -21,6 +21,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	unsafe "unsafe"
+
 	packet "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/packet"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -63,6 +65,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*packet.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_packet_WorkerConfig(a.(*WorkerConfig), b.(*packet.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*packet.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_packet_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*packet.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -121,3 +133,25 @@ func autoConvert_packet_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in
 func Convert_packet_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *packet.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
 	return autoConvert_packet_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_WorkerConfig_To_packet_WorkerConfig(in *WorkerConfig, out *packet.WorkerConfig, s conversion.Scope) error {
+	out.BillingCycle = (*packet.BillingCycle)(unsafe.Pointer(in.BillingCycle))
+	out.AdditionalTags = *(*[]string)(unsafe.Pointer(&in.AdditionalTags))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_packet_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_packet_WorkerConfig(in *WorkerConfig, out *packet.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_packet_WorkerConfig(in, out, s)
+}
+
+func autoConvert_packet_WorkerConfig_To_v1alpha1_WorkerConfig(in *packet.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.BillingCycle = (*BillingCycle)(unsafe.Pointer(in.BillingCycle))
+	out.AdditionalTags = *(*[]string)(unsafe.Pointer(&in.AdditionalTags))
+	return nil
+}
+
+// Convert_packet_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_packet_WorkerConfig_To_v1alpha1_WorkerConfig(in *packet.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_packet_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+
+	apispacket "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/packet"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var availableBillingCycles = sets.NewString(
+	string(apispacket.BillingCycleHourly),
+	string(apispacket.BillingCycleDaily),
+	string(apispacket.BillingCycleMonthly),
+	string(apispacket.BillingCycleYearly),
+)
+
+// ValidateWorkerConfig validates a WorkerConfig object of a worker pool.
+func ValidateWorkerConfig(workerConfig *apispacket.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig.BillingCycle != nil && !availableBillingCycles.Has(string(*workerConfig.BillingCycle)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("billingCycle"), *workerConfig.BillingCycle, availableBillingCycles.List()))
+	}
+
+	tags := sets.NewString()
+	for i, tag := range workerConfig.AdditionalTags {
+		tagPath := fldPath.Child("additionalTags").Index(i)
+
+		switch {
+		case len(tag) == 0:
+			allErrs = append(allErrs, field.Required(tagPath, "must not be empty"))
+		case strings.HasPrefix(tag, "kubernetes.io/"):
+			allErrs = append(allErrs, field.Forbidden(tagPath, "must not overwrite a tag managed by Gardener"))
+		case tags.Has(tag):
+			allErrs = append(allErrs, field.Duplicate(tagPath, tag))
+		default:
+			tags.Insert(tag)
+		}
+	}
+
+	return allErrs
+}
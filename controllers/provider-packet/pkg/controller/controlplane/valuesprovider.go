@@ -135,7 +135,7 @@ var controlPlaneShootChart = &chart.Chart{
 			Name:   "csi-packet",
 			Images: []string{packet.CSINodeDriverRegistrarImageName, packet.CSIPluginImageName},
 			Objects: []*chart.Object{
-				{Type: &appsv1.DaemonSet{}, Name: "csi-node"},
+				{Type: &appsv1.DaemonSet{}, Name: packet.CSINodeName},
 				{Type: &corev1.Secret{}, Name: "csi-diskplugin-packet"},
 				{Type: &rbacv1.ClusterRole{}, Name: "packet.com:csi-node-sa"},
 				{Type: &corev1.ServiceAccount{}, Name: "csi-node-sa"},
@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	glogger "github.com/gardener/gardener/pkg/logger"
@@ -78,12 +79,26 @@ func (a *actuator) Delete(ctx context.Context, config *extensionsv1alpha1.Infras
 	return a.delete(ctx, config, cluster)
 }
 
+// CanPlan implements infrastructure.Planner.
+func (a *actuator) CanPlan(_ *extensionsv1alpha1.Infrastructure) bool {
+	return true
+}
+
+// Plan implements infrastructure.Planner.
+func (a *actuator) Plan(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (*extensionsterraformer.Plan, error) {
+	return a.plan(ctx, config, cluster)
+}
+
 // Helper functions
 
 func (a *actuator) newTerraformer(purpose, namespace, name string) (*terraformer.Terraformer, error) {
 	return terraformer.NewForConfig(glogger.NewLogger("info"), a.restConfig, purpose, namespace, name, imagevector.TerraformerImage())
 }
 
+func (a *actuator) newPlanTerraformer(purpose, namespace, name string) (extensionsterraformer.Interface, error) {
+	return extensionsterraformer.DefaultFactory().NewForConfig(glogger.NewLogger("info"), a.restConfig, purpose, namespace, name, imagevector.TerraformerImage())
+}
+
 func generateTerraformInfraVariablesEnvironment(secret *corev1.Secret) map[string]string {
 	return terraformer.GenerateVariablesEnvironment(secret, map[string]string{
 		"PACKET_API_KEY": packet.APIToken,
@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	extensionschartrenderer "github.com/gardener/gardener-extensions/pkg/gardener/chartrenderer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
@@ -37,21 +38,9 @@ import (
 )
 
 func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
-	providerSecret := &corev1.Secret{}
-	if err := a.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
-		return err
-	}
-
-	terraformConfig := GenerateTerraformInfraConfig(infrastructure, string(providerSecret.Data[packet.ProjectID]))
-
-	chartRenderer, err := chartrenderer.NewForConfig(a.restConfig)
+	providerSecret, release, err := a.renderTerraformChart(ctx, infrastructure)
 	if err != nil {
-		return fmt.Errorf("could not create chart renderer: %+v", err)
-	}
-
-	release, err := chartRenderer.Render(filepath.Join(packet.InternalChartsPath, "packet-infra"), "packet-infra", infrastructure.Namespace, terraformConfig)
-	if err != nil {
-		return fmt.Errorf("could not render Terraform chart: %+v", err)
+		return err
 	}
 
 	tf, err := a.newTerraformer(packet.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
@@ -79,6 +68,28 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 	return a.updateProviderStatus(ctx, tf, infrastructure)
 }
 
+// renderTerraformChart reads the provider secret and renders the Terraform chart of the given infrastructure.
+func (a *actuator) renderTerraformChart(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) (*corev1.Secret, *chartrenderer.RenderedChart, error) {
+	providerSecret := &corev1.Secret{}
+	if err := a.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
+		return nil, nil, err
+	}
+
+	terraformConfig := GenerateTerraformInfraConfig(infrastructure, string(providerSecret.Data[packet.ProjectID]))
+
+	chartRenderer, err := extensionschartrenderer.NewForConfig(a.restConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create chart renderer: %+v", err)
+	}
+
+	release, err := chartRenderer.Render(filepath.Join(packet.InternalChartsPath, "packet-infra"), "packet-infra", infrastructure.Namespace, terraformConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not render Terraform chart: %+v", err)
+	}
+
+	return providerSecret, release, nil
+}
+
 // GenerateTerraformInfraConfig generates the Packet Terraform configuration based on the given infrastructure and project.
 func GenerateTerraformInfraConfig(infrastructure *extensionsv1alpha1.Infrastructure, projectID string) map[string]interface{} {
 	return map[string]interface{}{
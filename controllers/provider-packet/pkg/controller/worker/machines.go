@@ -26,6 +26,7 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -103,17 +104,29 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			return err
 		}
 
+		workerConfig := &packetapi.WorkerConfig{}
+		if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
+			if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+				return errors.Wrapf(err, "could not decode provider config of worker pool '%s'", pool.Name)
+			}
+		}
+
+		billingCycle := packetapi.BillingCycleHourly
+		if workerConfig.BillingCycle != nil {
+			billingCycle = *workerConfig.BillingCycle
+		}
+
 		machineClassSpec := map[string]interface{}{
 			"OS":           machineImage,
 			"projectID":    string(machineClassSecretData[packet.ProjectID]),
-			"billingCycle": "hourly",
+			"billingCycle": string(billingCycle),
 			"machineType":  pool.MachineType,
 			"facility":     pool.Zones,
 			"sshKeys":      []string{infrastructureStatus.SSHKeyID},
-			"tags": []string{
+			"tags": append([]string{
 				fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace),
 				"kubernetes.io/role/node",
-			},
+			}, workerConfig.AdditionalTags...),
 			"secret": map[string]interface{}{
 				"cloudConfig": string(pool.UserData),
 			},
@@ -127,6 +140,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 		machineDeployments = append(machineDeployments, worker.MachineDeployment{
 			Name:           deploymentName,
+			PoolName:       pool.Name,
 			ClassName:      className,
 			SecretName:     className,
 			Minimum:        pool.Minimum,
@@ -290,6 +290,7 @@ var _ = Describe("Machines", func() {
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1,
+						PoolName:       namePool1,
 						ClassName:      machineClassWithHashPool1,
 						SecretName:     machineClassWithHashPool1,
 						Minimum:        minPool1,
@@ -299,6 +300,7 @@ var _ = Describe("Machines", func() {
 					},
 					{
 						Name:           machineClassNamePool2,
+						PoolName:       namePool2,
 						ClassName:      machineClassWithHashPool2,
 						SecretName:     machineClassWithHashPool2,
 						Minimum:        minPool2,
@@ -313,6 +315,74 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should return the expected machine classes for a pool with provider config", func() {
+				expectGetSecretCallToWork(c, packetAPIToken, packetProjectID)
+
+				monthly := apispacket.BillingCycleMonthly
+
+				w.Spec.Pools = w.Spec.Pools[:1]
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apispacket.WorkerConfig{
+						BillingCycle:   &monthly,
+						AdditionalTags: []string{"team=foo"},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				var (
+					machineClass = map[string]interface{}{
+						"OS":           machineImage,
+						"projectID":    packetProjectID,
+						"billingCycle": string(monthly),
+						"machineType":  machineType,
+						"facility": []string{
+							zone1,
+							zone2,
+						},
+						"sshKeys": []string{sshKeyID},
+						"tags": []string{
+							fmt.Sprintf("kubernetes.io/cluster/%s", namespace),
+							"kubernetes.io/role/node",
+							"team=foo",
+						},
+						"secret": map[string]interface{}{
+							"cloudConfig": string(userData),
+						},
+					}
+
+					machineClassName     = fmt.Sprintf("%s-%s", namespace, namePool1)
+					machineClassWithHash = fmt.Sprintf("%s-%s", machineClassName, worker.MachineClassHash(machineClass, shootVersionMajorMinor))
+				)
+
+				addNameAndSecretToMachineClass(machineClass, packetAPIToken, machineClassWithHash)
+
+				chartApplier.
+					EXPECT().
+					ApplyChart(
+						context.TODO(),
+						filepath.Join(packet.InternalChartsPath, "machineclass"),
+						namespace,
+						"machineclass",
+						map[string]interface{}{"machineClasses": []map[string]interface{}{machineClass}},
+						nil,
+					).
+					Return(nil)
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+			})
+
+			It("should fail because the provider config cannot be decoded", func() {
+				expectGetSecretCallToWork(c, packetAPIToken, packetProjectID)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte("not-decodeable")}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
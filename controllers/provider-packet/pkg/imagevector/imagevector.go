@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
+	"github.com/gardener/gardener-extensions/pkg/util/chart"
 
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gobuffalo/packr/v2"
@@ -29,7 +30,8 @@ import (
 var imageVector imagevector.ImageVector
 
 func init() {
-	box := packr.New("charts", "../../charts")
+	box := packr.New("provider-packet-charts", "../../charts")
+	chart.RegisterSource(chart.NewBoxSource(packet.ChartsPath, box))
 
 	imagesYaml, err := box.FindString("images.yaml")
 	runtime.Must(err)
@@ -57,8 +57,12 @@ const (
 	// SSHKeyID key for accessing SSH key ID from outputs in terraform
 	SSHKeyID = "key_pair_id"
 
+	// CloudControllerManagerName is a constant for the name of the cloud-controller-manager.
+	CloudControllerManagerName = "cloud-controller-manager"
 	// MachineControllerManagerName is a constant for the name of the machine-controller-manager.
 	MachineControllerManagerName = "machine-controller-manager"
+	// CSINodeName is a constant for the name of the CSI node daemonset in the shoot cluster.
+	CSINodeName = "csi-node"
 )
 
 var (
@@ -0,0 +1,103 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/packet/install"
+	packetv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/packet/v1alpha1"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+var _ = Describe("Validator", func() {
+	var (
+		worker = func(config *packetv1alpha1.WorkerConfig) *extensionsv1alpha1.Worker {
+			return &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "shoot--foo--bar"},
+				Spec: extensionsv1alpha1.WorkerSpec{
+					Pools: []extensionsv1alpha1.WorkerPool{
+						{
+							Name:           "pool-1",
+							ProviderConfig: &runtime.RawExtension{Raw: encode(config)},
+						},
+					},
+				},
+			}
+		}
+		workerConfig = func(tags ...string) *packetv1alpha1.WorkerConfig {
+			return &packetv1alpha1.WorkerConfig{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: packetv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "WorkerConfig",
+				},
+				AdditionalTags: tags,
+			}
+		}
+
+		newValidator = func() *validator {
+			scheme := runtime.NewScheme()
+			install.Install(scheme)
+
+			v := NewValidator()
+			Expect(v.(inject.Scheme).InjectScheme(scheme)).To(Succeed())
+			return v.(*validator)
+		}
+	)
+
+	Describe("#Validate", func() {
+		It("should ignore other objects", func() {
+			Expect(newValidator().Validate(context.TODO(), &corev1.Secret{}, nil)).To(Succeed())
+		})
+
+		It("should allow a valid worker", func() {
+			Expect(newValidator().Validate(context.TODO(), worker(workerConfig("foo")), nil)).To(Succeed())
+		})
+
+		It("should allow a worker without provider config", func() {
+			w := worker(nil)
+			w.Spec.Pools[0].ProviderConfig = nil
+
+			Expect(newValidator().Validate(context.TODO(), w, nil)).To(Succeed())
+		})
+
+		It("should reject a worker with an invalid provider config", func() {
+			err := newValidator().Validate(context.TODO(), worker(workerConfig("kubernetes.io/foo")), nil)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should not validate an unchanged worker pool on updates", func() {
+			oldWorker := worker(workerConfig("kubernetes.io/foo"))
+			w := oldWorker.DeepCopy()
+			w.Finalizers = []string{"extensions.gardener.cloud/packet"}
+
+			Expect(newValidator().Validate(context.TODO(), w, oldWorker)).To(Succeed())
+		})
+	})
+})
+
+func encode(obj runtime.Object) []byte {
+	data, _ := json.Marshal(obj)
+	return data
+}
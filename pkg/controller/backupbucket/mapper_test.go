@@ -0,0 +1,86 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/golang/mock/gomock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Mapper", func() {
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "garden"}}
+
+		backupBucket = func(name, secretName string) extensionsv1alpha1.BackupBucket {
+			return extensionsv1alpha1.BackupBucket{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: extensionsv1alpha1.BackupBucketSpec{
+					SecretRef: corev1.SecretReference{Name: secretName, Namespace: "garden"},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#SecretToBackupBucketMapper", func() {
+		It("should request the backup buckets that reference the secret", func() {
+			c.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&extensionsv1alpha1.BackupBucketList{})).
+				DoAndReturn(func(_ context.Context, _ *client.ListOptions, list *extensionsv1alpha1.BackupBucketList) error {
+					list.Items = []extensionsv1alpha1.BackupBucket{
+						backupBucket("foo", "secret"),
+						backupBucket("bar", "other-secret"),
+					}
+					return nil
+				})
+
+			requests := backupbucket.SecretToBackupBucketMapper(c, nil).Map(handler.MapObject{Object: secret})
+
+			Expect(requests).To(ConsistOf(reconcile.Request{NamespacedName: types.NamespacedName{Name: "foo"}}))
+		})
+
+		It("should not request anything for other objects", func() {
+			requests := backupbucket.SecretToBackupBucketMapper(c, nil).Map(handler.MapObject{Object: &extensionsv1alpha1.BackupBucket{}})
+
+			Expect(requests).To(BeEmpty())
+		})
+	})
+})
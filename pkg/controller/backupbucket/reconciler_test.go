@@ -0,0 +1,178 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	mockmanager "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/manager"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/golang/mock/gomock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+type fakeActuator struct {
+	reconciled, deleted int
+	err                 error
+}
+
+func (a *fakeActuator) Reconcile(context.Context, *extensionsv1alpha1.BackupBucket) error {
+	a.reconciled++
+	return a.err
+}
+
+func (a *fakeActuator) Delete(context.Context, *extensionsv1alpha1.BackupBucket) error {
+	a.deleted++
+	return a.err
+}
+
+type statusWriterFunc func(context.Context, runtime.Object) error
+
+func (f statusWriterFunc) Update(ctx context.Context, obj runtime.Object) error {
+	return f(ctx, obj)
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+		mgr  *mockmanager.MockManager
+
+		actuator   *fakeActuator
+		reconciler reconcile.Reconciler
+		stopCh     chan struct{}
+
+		// stored is the backup bucket as stored by the API server.
+		stored  *extensionsv1alpha1.BackupBucket
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: "bucket"}}
+		now     = metav1.Now()
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+		mgr = mockmanager.NewMockManager(ctrl)
+		stopCh = make(chan struct{})
+
+		stored = &extensionsv1alpha1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket"}}
+		actuator = &fakeActuator{}
+
+		mgr.EXPECT().GetRecorder(backupbucket.ControllerName).Return(record.NewFakeRecorder(100))
+		reconciler = backupbucket.NewReconciler(mgr, actuator)
+		Expect(inject.ClientInto(c, reconciler)).To(BeTrue())
+		Expect(inject.StopChannelInto(stopCh, reconciler)).To(BeTrue())
+
+		store := func(_ context.Context, obj runtime.Object) error {
+			stored = obj.(*extensionsv1alpha1.BackupBucket).DeepCopy()
+			return nil
+		}
+		c.EXPECT().Get(gomock.Any(), client.ObjectKey{Name: "bucket"}, gomock.AssignableToTypeOf(&extensionsv1alpha1.BackupBucket{})).
+			DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *extensionsv1alpha1.BackupBucket) error {
+				if stored == nil {
+					return apierrors.NewNotFound(schema.GroupResource{}, "bucket")
+				}
+				stored.DeepCopyInto(obj)
+				return nil
+			}).AnyTimes()
+		c.EXPECT().Update(gomock.Any(), gomock.AssignableToTypeOf(&extensionsv1alpha1.BackupBucket{})).DoAndReturn(store).AnyTimes()
+		c.EXPECT().Status().Return(statusWriterFunc(store)).AnyTimes()
+	})
+
+	AfterEach(func() {
+		close(stopCh)
+		ctrl.Finish()
+	})
+
+	It("should ignore backup buckets that do not exist", func() {
+		stored = nil
+
+		result, err := reconciler.Reconcile(request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+		Expect(actuator.reconciled).To(BeZero())
+	})
+
+	It("should add the finalizer and reconcile the backup bucket", func() {
+		_, err := reconciler.Reconcile(request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actuator.reconciled).To(Equal(1))
+		Expect(stored.Finalizers).To(ConsistOf(backupbucket.FinalizerName))
+		Expect(stored.Status.LastOperation.Type).To(Equal(gardencorev1alpha1.LastOperationTypeCreate))
+		Expect(stored.Status.LastOperation.State).To(Equal(gardencorev1alpha1.LastOperationStateSucceeded))
+		Expect(stored.Status.LastError).To(BeNil())
+	})
+
+	It("should record the error of the actuator", func() {
+		actuator.err = fmt.Errorf("bucket quota exceeded")
+
+		_, err := reconciler.Reconcile(request)
+		Expect(err).To(HaveOccurred())
+
+		Expect(stored.Status.LastOperation.State).To(Equal(gardencorev1alpha1.LastOperationStateError))
+		Expect(stored.Status.LastError).NotTo(BeNil())
+		Expect(stored.Status.LastError.Description).To(ContainSubstring("bucket quota exceeded"))
+	})
+
+	It("should not delete backup buckets without finalizer", func() {
+		stored.DeletionTimestamp = &now
+
+		_, err := reconciler.Reconcile(request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actuator.deleted).To(BeZero())
+	})
+
+	It("should delete the backup bucket and remove the finalizer", func() {
+		stored.DeletionTimestamp = &now
+		stored.Finalizers = []string{backupbucket.FinalizerName}
+
+		_, err := reconciler.Reconcile(request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actuator.deleted).To(Equal(1))
+		Expect(stored.Finalizers).To(BeEmpty())
+		Expect(stored.Status.LastOperation.Type).To(Equal(gardencorev1alpha1.LastOperationTypeDelete))
+		Expect(stored.Status.LastOperation.State).To(Equal(gardencorev1alpha1.LastOperationStateSucceeded))
+	})
+
+	Describe("#OperationAnnotationWrapper", func() {
+		It("should remove the operation annotation before reconciling", func() {
+			stored.Annotations = map[string]string{gardencorev1alpha1.GardenerOperation: gardencorev1alpha1.GardenerOperationReconcile}
+			wrapper := backupbucket.OperationAnnotationWrapper(actuator)
+			Expect(inject.ClientInto(c, wrapper)).To(BeTrue())
+
+			Expect(wrapper.Reconcile(context.TODO(), stored.DeepCopy())).To(Succeed())
+			Expect(stored.Annotations).NotTo(HaveKey(gardencorev1alpha1.GardenerOperation))
+			Expect(actuator.reconciled).To(Equal(1))
+		})
+	})
+})
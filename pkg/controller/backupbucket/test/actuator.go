@@ -0,0 +1,131 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	"github.com/gardener/gardener-extensions/pkg/util/test/objectstore"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/golang/mock/gomock"
+	"github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+// ActuatorTestArgs are the provider-specific arguments of DescribeActuatorTest.
+type ActuatorTestArgs struct {
+	// Region is the region of the tested BackupBucket.
+	Region string
+	// Credentials is the data of the secret referenced by the BackupBucket.
+	Credentials map[string][]byte
+	// IncompleteCredentials is secret data that lacks credentials required by the actuator.
+	IncompleteCredentials map[string][]byte
+	// NewActuator returns the actuator to test. Its storage client must store the buckets in the given store.
+	NewActuator func(store *objectstore.Store) backupbucket.Actuator
+}
+
+// DescribeActuatorTest returns a function which can be used in tests for the BackupBucket actuator of a
+// provider. The actuator must read its credentials from the referenced secret and manage the bucket with
+// the storage client backed by the given in-memory store.
+var DescribeActuatorTest = func(args ActuatorTestArgs) func() {
+	return func() {
+		var (
+			ctrl *gomock.Controller
+			ctx  context.Context
+
+			c        *mockclient.MockClient
+			store    *objectstore.Store
+			actuator backupbucket.Actuator
+
+			bb *extensionsv1alpha1.BackupBucket
+		)
+
+		ginkgo.BeforeEach(func() {
+			ctrl = gomock.NewController(ginkgo.GinkgoT())
+			ctx = context.TODO()
+
+			c = mockclient.NewMockClient(ctrl)
+			store = objectstore.New()
+			actuator = args.NewActuator(store)
+
+			ok, err := inject.ClientInto(c, actuator)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ok).To(gomega.BeTrue())
+
+			bb = &extensionsv1alpha1.BackupBucket{
+				ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+				Spec: extensionsv1alpha1.BackupBucketSpec{
+					Region: args.Region,
+					SecretRef: corev1.SecretReference{
+						Namespace: "garden",
+						Name:      "backup",
+					},
+				},
+			}
+		})
+
+		ginkgo.AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		expectGetSecret := func(data map[string][]byte) {
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: "garden", Name: "backup"}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+				SetArg(2, corev1.Secret{Data: data})
+		}
+
+		ginkgo.Describe("#Reconcile", func() {
+			ginkgo.It("should create the bucket in the requested region", func() {
+				expectGetSecret(args.Credentials)
+
+				gomega.Expect(actuator.Reconcile(ctx, bb)).To(gomega.Succeed())
+				gomega.Expect(store.Bucket("bucket")).To(gomega.Equal(&objectstore.Bucket{Region: args.Region, Objects: map[string][]byte{}}))
+			})
+
+			ginkgo.It("should not touch an existing bucket", func() {
+				expectGetSecret(args.Credentials)
+				gomega.Expect(store.CreateBucketIfNotExists(ctx, "bucket", args.Region)).To(gomega.Succeed())
+				gomega.Expect(store.PutObject("bucket", "shoot--foo--bar/full", []byte("data"))).To(gomega.Succeed())
+
+				gomega.Expect(actuator.Reconcile(ctx, bb)).To(gomega.Succeed())
+				gomega.Expect(store.Bucket("bucket").Objects).To(gomega.HaveKey("shoot--foo--bar/full"))
+			})
+
+			ginkgo.It("should fail if the secret does not contain the credentials", func() {
+				expectGetSecret(args.IncompleteCredentials)
+
+				gomega.Expect(actuator.Reconcile(ctx, bb)).NotTo(gomega.Succeed())
+				gomega.Expect(store.Bucket("bucket")).To(gomega.BeNil())
+			})
+		})
+
+		ginkgo.Describe("#Delete", func() {
+			ginkgo.It("should delete the bucket including its objects", func() {
+				expectGetSecret(args.Credentials)
+				gomega.Expect(store.CreateBucketIfNotExists(ctx, "bucket", args.Region)).To(gomega.Succeed())
+				gomega.Expect(store.PutObject("bucket", "shoot--foo--bar/full", []byte("data"))).To(gomega.Succeed())
+
+				gomega.Expect(actuator.Delete(ctx, bb)).To(gomega.Succeed())
+				gomega.Expect(store.Bucket("bucket")).To(gomega.BeNil())
+			})
+		})
+	}
+}
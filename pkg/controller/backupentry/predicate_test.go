@@ -0,0 +1,86 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry_test
+
+import (
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Predicate", func() {
+	var (
+		now       = metav1.Now()
+		succeeded = func(lastOperationType gardencorev1alpha1.LastOperationType) *extensionsv1alpha1.BackupEntry {
+			return &extensionsv1alpha1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Status: extensionsv1alpha1.BackupEntryStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						ObservedGeneration: 1,
+						LastOperation: &gardencorev1alpha1.LastOperation{
+							Type:  lastOperationType,
+							State: gardencorev1alpha1.LastOperationStateSucceeded,
+						},
+					},
+				},
+			}
+		}
+	)
+
+	DescribeTable("#OperationAnnotationPredicate",
+		func(obj runtime.Object, expected bool) {
+			predicate := backupentry.OperationAnnotationPredicate()
+
+			Expect(predicate.Create(event.CreateEvent{Object: obj})).To(Equal(expected))
+			Expect(predicate.Update(event.UpdateEvent{ObjectNew: obj})).To(Equal(expected))
+			Expect(predicate.Generic(event.GenericEvent{Object: obj})).To(Equal(expected))
+		},
+
+		Entry("no backup entry", &corev1.Secret{}, false),
+		Entry("never reconciled", &extensionsv1alpha1.BackupEntry{}, true),
+		Entry("successfully reconciled", succeeded(gardencorev1alpha1.LastOperationTypeReconcile), false),
+		Entry("successfully created", succeeded(gardencorev1alpha1.LastOperationTypeCreate), true),
+		Entry("generation changed", func() *extensionsv1alpha1.BackupEntry {
+			be := succeeded(gardencorev1alpha1.LastOperationTypeReconcile)
+			be.Generation = 2
+			return be
+		}(), true),
+		Entry("last operation failed", func() *extensionsv1alpha1.BackupEntry {
+			be := succeeded(gardencorev1alpha1.LastOperationTypeReconcile)
+			be.Status.LastOperation.State = gardencorev1alpha1.LastOperationStateError
+			return be
+		}(), true),
+		Entry("being deleted", func() *extensionsv1alpha1.BackupEntry {
+			be := succeeded(gardencorev1alpha1.LastOperationTypeReconcile)
+			be.DeletionTimestamp = &now
+			return be
+		}(), true),
+		Entry("operation annotation", func() *extensionsv1alpha1.BackupEntry {
+			be := succeeded(gardencorev1alpha1.LastOperationTypeReconcile)
+			be.Annotations = map[string]string{gardencorev1alpha1.GardenerOperation: gardencorev1alpha1.GardenerOperationReconcile}
+			return be
+		}(), true),
+	)
+})
@@ -0,0 +1,229 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/go-logr/logr"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+const (
+	// EventBackupEntryReconciliation an event reason to describe backup entry reconciliation.
+	EventBackupEntryReconciliation string = "BackupEntryReconciliation"
+	// EventBackupEntryDeletion an event reason to describe backup entry deletion.
+	EventBackupEntryDeletion string = "BackupEntryDeletion"
+)
+
+type reconciler struct {
+	logger              logr.Logger
+	actuator            Actuator
+	deletionGracePeriod time.Duration
+
+	ctx      context.Context
+	client   client.Client
+	recorder record.EventRecorder
+}
+
+// NewReconciler creates a new reconcile.Reconciler that reconciles
+// BackupEntry resources of Gardener's `extensions.gardener.cloud` API group.
+// The deletion of a BackupEntry is delayed by the given grace period.
+func NewReconciler(mgr manager.Manager, actuator Actuator, deletionGracePeriod time.Duration) reconcile.Reconciler {
+	return &reconciler{
+		logger:              log.Log.WithName(ControllerName),
+		actuator:            actuator,
+		deletionGracePeriod: deletionGracePeriod,
+		recorder:            mgr.GetRecorder(ControllerName),
+	}
+}
+
+// InjectFunc implements inject.Func.
+func (r *reconciler) InjectFunc(f inject.Func) error {
+	return f(r.actuator)
+}
+
+// InjectClient implements inject.Client.
+func (r *reconciler) InjectClient(client client.Client) error {
+	r.client = client
+	return nil
+}
+
+// InjectStopChannel implements inject.Stoppable.
+func (r *reconciler) InjectStopChannel(stopCh <-chan struct{}) error {
+	r.ctx = util.ContextFromStopChannel(stopCh)
+	return nil
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (r *reconciler) Reconcile(request reconcile.Request) (result reconcile.Result, err error) {
+	be := &extensionsv1alpha1.BackupEntry{}
+	if err := r.client.Get(r.ctx, request.NamespacedName, be); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	defer func(start time.Time) { metrics.ObserveReconcile(be, start, err) }(time.Now())
+
+	if be.DeletionTimestamp != nil {
+		return r.delete(r.ctx, be)
+	}
+	return r.reconcile(r.ctx, be)
+}
+
+func (r *reconciler) reconcile(ctx context.Context, be *extensionsv1alpha1.BackupEntry) (reconcile.Result, error) {
+	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, FinalizerName, be); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	operationType := gardencorev1alpha1helper.ComputeOperationType(be.ObjectMeta, be.Status.LastOperation)
+	if err := r.updateStatusProcessing(ctx, be, operationType, "Reconciling the backupentry"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the reconciliation of backupentry", "backupentry", be.Name)
+	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryReconciliation, "Reconciling the backupentry")
+	start := time.Now()
+	err := r.actuator.Reconcile(ctx, be)
+	metrics.ObserveActuatorOperation(be, metrics.OperationReconcile, start, err)
+	if err != nil {
+		msg := "Error reconciling backupentry"
+		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), be, operationType, msg))
+		r.logger.Error(err, msg, "backupentry", be.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully reconciled backupentry"
+	r.logger.Info(msg, "backupentry", be.Name)
+	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryReconciliation, msg)
+	if err := r.updateStatusSuccess(ctx, be, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) delete(ctx context.Context, be *extensionsv1alpha1.BackupEntry) (reconcile.Result, error) {
+	hasFinalizer, err := extensionscontroller.HasFinalizer(be, FinalizerName)
+	if err != nil {
+		r.logger.Error(err, "Could not instantiate finalizer deletion")
+		return reconcile.Result{}, err
+	}
+	if !hasFinalizer {
+		r.logger.Info("Deleting backupentry causes a no-op as there is no finalizer.", "backupentry", be.Name)
+		return reconcile.Result{}, nil
+	}
+
+	operationType := gardencorev1alpha1helper.ComputeOperationType(be.ObjectMeta, be.Status.LastOperation)
+	if remaining := DeletionGracePeriodRemaining(be, r.deletionGracePeriod, time.Now()); remaining > 0 {
+		msg := fmt.Sprintf("Deletion of the backupentry is delayed by the deletion grace period, %s remaining", remaining.Round(time.Second))
+		r.logger.Info(msg, "backupentry", be.Name)
+		if err := r.updateStatusProcessing(ctx, be, operationType, msg); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	if err := r.updateStatusProcessing(ctx, be, operationType, "Deleting the backupentry"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Starting the deletion of backupentry", "backupentry", be.Name)
+	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryDeletion, "Deleting the backupentry")
+	start := time.Now()
+	err = r.actuator.Delete(ctx, be)
+	metrics.ObserveActuatorOperation(be, metrics.OperationDelete, start, err)
+	if err != nil {
+		msg := "Error deleting backupentry"
+		r.recorder.Eventf(be, corev1.EventTypeWarning, EventBackupEntryDeletion, "%s: %+v", msg, err)
+		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), be, operationType, msg))
+		r.logger.Error(err, msg, "backupentry", be.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := "Successfully deleted backupentry"
+	r.logger.Info(msg, "backupentry", be.Name)
+	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryDeletion, msg)
+	if err := r.updateStatusSuccess(ctx, be, operationType, msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Removing finalizer.", "backupentry", be.Name)
+	if err := extensionscontroller.DeleteFinalizer(ctx, r.client, FinalizerName, be); err != nil {
+		r.logger.Error(err, "Error removing finalizer from BackupEntry", "backupentry", be.Name)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// DeletionGracePeriodRemaining returns the duration the deletion of the given BackupEntry still has to be delayed
+// at the given point in time, or zero if the grace period has passed or the BackupEntry is not being deleted.
+func DeletionGracePeriodRemaining(be *extensionsv1alpha1.BackupEntry, gracePeriod time.Duration, now time.Time) time.Duration {
+	if be.DeletionTimestamp == nil || gracePeriod <= 0 {
+		return 0
+	}
+
+	if remaining := be.DeletionTimestamp.Add(gracePeriod).Sub(now); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+func (r *reconciler) updateStatusProcessing(ctx context.Context, be *extensionsv1alpha1.BackupEntry, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, be, func() error {
+		be.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
+		return nil
+	})
+}
+
+func (r *reconciler) updateStatusError(ctx context.Context, err error, be *extensionsv1alpha1.BackupEntry, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, be, func() error {
+		be.Status.ObservedGeneration = be.Generation
+		be.Status.LastOperation, be.Status.LastError = extensionscontroller.ReconcileError(lastOperationType, gardencorev1alpha1helper.FormatLastErrDescription(fmt.Errorf("%s: %v", description, err)), 50, gardencorev1alpha1helper.ExtractErrorCodes(err)...)
+		return nil
+	})
+}
+
+func (r *reconciler) updateStatusSuccess(ctx context.Context, be *extensionsv1alpha1.BackupEntry, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, be, func() error {
+		be.Status.ObservedGeneration = be.Generation
+		be.Status.LastOperation, be.Status.LastError = extensionscontroller.ReconcileSucceeded(lastOperationType, description)
+		return nil
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	"github.com/gardener/gardener-extensions/pkg/util/test/objectstore"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/golang/mock/gomock"
+	"github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+// ActuatorTestArgs are the provider-specific arguments of DescribeActuatorTest.
+type ActuatorTestArgs struct {
+	// Region is the region of the tested BackupEntry.
+	Region string
+	// Credentials is the data of the secret referenced by the BackupEntry.
+	Credentials map[string][]byte
+	// NewActuator returns the actuator to test. Its storage client must store the buckets in the given store.
+	NewActuator func(store *objectstore.Store) backupentry.Actuator
+}
+
+// DescribeActuatorTest returns a function which can be used in tests for the BackupEntry actuator of a
+// provider. The actuator must read its credentials from the referenced secret and purge the backup prefix
+// with the storage client backed by the given in-memory store.
+var DescribeActuatorTest = func(args ActuatorTestArgs) func() {
+	return func() {
+		var (
+			ctrl *gomock.Controller
+			ctx  context.Context
+
+			c        *mockclient.MockClient
+			store    *objectstore.Store
+			actuator backupentry.Actuator
+
+			be *extensionsv1alpha1.BackupEntry
+		)
+
+		ginkgo.BeforeEach(func() {
+			ctrl = gomock.NewController(ginkgo.GinkgoT())
+			ctx = context.TODO()
+
+			c = mockclient.NewMockClient(ctrl)
+			store = objectstore.New()
+			actuator = args.NewActuator(store)
+
+			ok, err := inject.ClientInto(c, actuator)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ok).To(gomega.BeTrue())
+
+			be = &extensionsv1alpha1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"},
+				Spec: extensionsv1alpha1.BackupEntrySpec{
+					Region:     args.Region,
+					BucketName: "bucket",
+					SecretRef: corev1.SecretReference{
+						Namespace: "garden",
+						Name:      "backup",
+					},
+				},
+			}
+
+			gomega.Expect(store.CreateBucketIfNotExists(ctx, "bucket", args.Region)).To(gomega.Succeed())
+			gomega.Expect(store.PutObject("bucket", "shoot--foo--bar/full", []byte("data"))).To(gomega.Succeed())
+			gomega.Expect(store.PutObject("bucket", "shoot--foo--bar/incr", []byte("data"))).To(gomega.Succeed())
+			gomega.Expect(store.PutObject("bucket", "shoot--foo--bar2/full", []byte("data"))).To(gomega.Succeed())
+		})
+
+		ginkgo.AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		ginkgo.Describe("#Reconcile", func() {
+			ginkgo.It("should not change the bucket", func() {
+				gomega.Expect(actuator.Reconcile(ctx, be)).To(gomega.Succeed())
+				gomega.Expect(store.Bucket("bucket").Objects).To(gomega.HaveLen(3))
+			})
+		})
+
+		ginkgo.Describe("#Delete", func() {
+			ginkgo.It("should only delete the objects below the prefix of the BackupEntry", func() {
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: "garden", Name: "backup"}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+					SetArg(2, corev1.Secret{Data: args.Credentials})
+
+				gomega.Expect(actuator.Delete(ctx, be)).To(gomega.Succeed())
+				gomega.Expect(store.Bucket("bucket").Objects).To(gomega.Equal(map[string][]byte{
+					"shoot--foo--bar2/full": []byte("data"),
+				}))
+			})
+		})
+	}
+}
@@ -137,7 +137,7 @@ func (a *actuator) InjectConfig(config *rest.Config) error {
 	}
 
 	// Create chart applier
-	a.chartApplier, err = gardenerkubernetes.NewChartApplierForConfig(config)
+	a.chartApplier, err = util.NewChartApplierForConfig(config)
 	if err != nil {
 		return errors.Wrap(err, "could not create chart applier")
 	}
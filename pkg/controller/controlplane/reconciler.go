@@ -20,6 +20,7 @@ import (
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -79,7 +80,7 @@ func (r *reconciler) InjectStopChannel(stopCh <-chan struct{}) error {
 	return nil
 }
 
-func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+func (r *reconciler) Reconcile(request reconcile.Request) (result reconcile.Result, err error) {
 	cp := &extensionsv1alpha1.ControlPlane{}
 	if err := r.client.Get(r.ctx, request.NamespacedName, cp); err != nil {
 		if errors.IsNotFound(err) {
@@ -88,6 +89,8 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
+	defer func(start time.Time) { metrics.ObserveReconcile(cp, start, err) }(time.Now())
+
 	cluster, err := extensionscontroller.GetCluster(r.ctx, r.client, cp.Namespace)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -111,7 +114,9 @@ func (r *reconciler) reconcile(ctx context.Context, cp *extensionsv1alpha1.Contr
 
 	r.logger.Info("Starting the reconciliation of controlplane", "controlplane", cp.Name)
 	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneReconciliation, "Reconciling the controlplane")
+	start := time.Now()
 	requeue, err := r.actuator.Reconcile(ctx, cp, cluster)
+	metrics.ObserveActuatorOperation(cp, metrics.OperationReconcile, start, err)
 	if err != nil {
 		msg := "Error reconciling controlplane"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
@@ -150,7 +155,10 @@ func (r *reconciler) delete(ctx context.Context, cp *extensionsv1alpha1.ControlP
 
 	r.logger.Info("Starting the deletion of controlplane", "controlplane", cp.Name)
 	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneDeletion, "Deleting the cp")
-	if err := r.actuator.Delete(r.ctx, cp, cluster); err != nil {
+	start := time.Now()
+	err = r.actuator.Delete(r.ctx, cp, cluster)
+	metrics.ObserveActuatorOperation(cp, metrics.OperationDelete, start, err)
+	if err != nil {
 		msg := "Error deleting controlplane"
 		r.recorder.Eventf(cp, corev1.EventTypeWarning, EventControlPlaneDeletion, "%s: %+v", msg, err)
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
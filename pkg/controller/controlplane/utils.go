@@ -17,9 +17,14 @@ package controlplane
 import (
 	"fmt"
 
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/chart"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -55,3 +60,25 @@ func ComputeChecksums(secrets map[string]*corev1.Secret, cms map[string]*corev1.
 	}
 	return checksums
 }
+
+// IsCSIEnabled returns true if the CSI driver shall be deployed for the given cluster, i.e. if its Kubernetes version
+// is at least the given minimum version.
+func IsCSIEnabled(cluster *extensionscontroller.Cluster, minimumVersion string) (bool, error) {
+	csiEnabled, err := utils.CompareVersions(cluster.Shoot.Spec.Kubernetes.Version, ">=", minimumVersion)
+	if err != nil {
+		return false, errors.Wrapf(err, "could not compare Kubernetes version '%s'", cluster.Shoot.Spec.Kubernetes.Version)
+	}
+	return csiEnabled, nil
+}
+
+// GetCSIImages finds the CSI images with the given names in the given image vector for the Kubernetes version of
+// the given cluster. The images are injected explicitly to make sure that the correct images for the shoot version are used.
+// TODO Use the Images field of the CSI subcharts when the image vector is enhanced to support specifying the shoot version
+func GetCSIImages(cluster *extensionscontroller.Cluster, imageVector imagevector.ImageVector, names ...string) (map[string]interface{}, error) {
+	version := cluster.Shoot.Spec.Kubernetes.Version
+	values, err := chart.InjectImages(nil, imageVector, names, imagevector.RuntimeVersion(version), imagevector.TargetVersion(version))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not inject CSI images")
+	}
+	return map[string]interface{}(values["images"].(chart.Values)), nil
+}
@@ -15,6 +15,10 @@
 package controlplane
 
 import (
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -73,8 +77,54 @@ var _ = Describe("Utils", func() {
 			Expect(checksums).To(HaveKeyWithValue("test-config", "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606"))
 		})
 	})
+
+	Describe("#IsCSIEnabled", func() {
+		It("should return true if the Kubernetes version is at least the minimum version", func() {
+			Expect(IsCSIEnabled(getCluster("1.14.0"), "1.14")).To(BeTrue())
+			Expect(IsCSIEnabled(getCluster("1.15.1"), "1.14")).To(BeTrue())
+		})
+		It("should return false if the Kubernetes version is below the minimum version", func() {
+			Expect(IsCSIEnabled(getCluster("1.13.4"), "1.14")).To(BeFalse())
+		})
+		It("should fail if the Kubernetes version is invalid", func() {
+			_, err := IsCSIEnabled(getCluster("foo"), "1.14")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#GetCSIImages", func() {
+		var (
+			runtimeVersion = ">= 1.14"
+			tag            = "v1.0.0"
+			imageVector    = imagevector.ImageVector{
+				{Name: "csi-attacher", Repository: "quay.io/k8scsi/csi-attacher", Tag: &tag, RuntimeVersion: &runtimeVersion},
+				{Name: "csi-plugin", Repository: "example.com/csi-plugin", RuntimeVersion: &runtimeVersion},
+			}
+		)
+
+		It("should return the images with the given names for the Kubernetes version of the cluster", func() {
+			Expect(GetCSIImages(getCluster("1.14.0"), imageVector, "csi-attacher", "csi-plugin")).To(Equal(map[string]interface{}{
+				"csi-attacher": "quay.io/k8scsi/csi-attacher:v1.0.0",
+				"csi-plugin":   "example.com/csi-plugin:v1.14.0",
+			}))
+		})
+		It("should fail if an image cannot be found for the Kubernetes version of the cluster", func() {
+			_, err := GetCSIImages(getCluster("1.13.4"), imageVector, "csi-attacher")
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
 
+func getCluster(version string) *extensionscontroller.Cluster {
+	return &extensionscontroller.Cluster{
+		Shoot: &gardenv1beta1.Shoot{
+			Spec: gardenv1beta1.ShootSpec{
+				Kubernetes: gardenv1beta1.Kubernetes{Version: version},
+			},
+		},
+	}
+}
+
 func getSecret(name, namespace string, data map[string][]byte) *corev1.Secret {
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -147,7 +148,7 @@ func (r *reconciler) InjectStopChannel(stopCh <-chan struct{}) error {
 }
 
 // Reconcile is the reconciler function that gets executed in case there are new events for `Extension` resources.
-func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+func (r *reconciler) Reconcile(request reconcile.Request) (result reconcile.Result, err error) {
 	ex := &extensionsv1alpha1.Extension{}
 	if err := r.client.Get(r.ctx, request.NamespacedName, ex); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -157,10 +158,7 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
-	var (
-		result reconcile.Result
-		err    error
-	)
+	defer func(start time.Time) { metrics.ObserveReconcile(ex, start, err) }(time.Now())
 
 	if ex.DeletionTimestamp != nil {
 		return r.delete(r.ctx, ex)
@@ -185,7 +183,10 @@ func (r *reconciler) reconcile(ctx context.Context, ex *extensionsv1alpha1.Exten
 		return reconcile.Result{}, err
 	}
 
-	if err := r.actuator.Reconcile(ctx, ex); err != nil {
+	start := time.Now()
+	err := r.actuator.Reconcile(ctx, ex)
+	metrics.ObserveActuatorOperation(ex, metrics.OperationReconcile, start, err)
+	if err != nil {
 		msg := "Unable to reconcile Extension resource"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
 		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
@@ -217,7 +218,10 @@ func (r *reconciler) delete(ctx context.Context, ex *extensionsv1alpha1.Extensio
 		return reconcile.Result{}, err
 	}
 
-	if err := r.actuator.Delete(ctx, ex); err != nil {
+	start := time.Now()
+	err = r.actuator.Delete(ctx, ex)
+	metrics.ObserveActuatorOperation(ex, metrics.OperationDelete, start, err)
+	if err != nil {
 		msg := "Error deleting Extension resource"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
 		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
@@ -0,0 +1,185 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Actuator executes the health checks of an extension resource.
+type Actuator interface {
+	// ExecuteHealthCheckFunctions executes all registered health checks for the extension resource identified
+	// by the given request and returns the results aggregated per condition type.
+	ExecuteHealthCheckFunctions(ctx context.Context, request types.NamespacedName) ([]Result, error)
+}
+
+// ShootClientFactory creates a client for the shoot cluster whose control plane runs in the given namespace.
+type ShootClientFactory func(ctx context.Context, seedClient client.Client, namespace string) (client.Client, error)
+
+type actuator struct {
+	logger             logr.Logger
+	healthChecks       []ConditionTypeToHealthCheck
+	shootClientFactory ShootClientFactory
+
+	seedClient client.Client
+}
+
+// NewActuator creates a new Actuator that executes the given health checks.
+func NewActuator(healthChecks []ConditionTypeToHealthCheck, logger logr.Logger) Actuator {
+	return NewActuatorWithShootClientFactory(healthChecks, newShootClient, logger)
+}
+
+// NewActuatorWithShootClientFactory creates a new Actuator that executes the given health checks and uses the
+// given factory to create clients for the shoot cluster.
+func NewActuatorWithShootClientFactory(healthChecks []ConditionTypeToHealthCheck, shootClientFactory ShootClientFactory, logger logr.Logger) Actuator {
+	return &actuator{
+		logger:             logger,
+		healthChecks:       healthChecks,
+		shootClientFactory: shootClientFactory,
+	}
+}
+
+func newShootClient(ctx context.Context, seedClient client.Client, namespace string) (client.Client, error) {
+	shootClients, err := util.NewClientsForShoot(ctx, seedClient, namespace, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+	return shootClients.Client(), nil
+}
+
+// InjectClient injects the seed client into the actuator.
+func (a *actuator) InjectClient(client client.Client) error {
+	a.seedClient = client
+	return nil
+}
+
+type checkResult struct {
+	result *SingleCheckResult
+	err    error
+}
+
+// ExecuteHealthCheckFunctions implements Actuator.
+func (a *actuator) ExecuteHealthCheckFunctions(ctx context.Context, request types.NamespacedName) ([]Result, error) {
+	var (
+		shootClient    client.Client
+		shootClientErr error
+	)
+	if a.requiresShootClient() {
+		shootClient, shootClientErr = a.shootClientFactory(ctx, a.seedClient, request.Namespace)
+		if shootClientErr != nil {
+			a.logger.Error(shootClientErr, "Could not create shoot client", "namespace", request.Namespace)
+		}
+	}
+
+	var (
+		wg      sync.WaitGroup
+		results = make([]checkResult, len(a.healthChecks))
+	)
+
+	for i, healthCheck := range a.healthChecks {
+		check := healthCheck.HealthCheck.DeepCopy()
+		if c, ok := check.(SeedClient); ok {
+			c.InjectSeedClient(a.seedClient)
+		}
+		if c, ok := check.(ShootClient); ok {
+			if shootClientErr != nil {
+				results[i] = checkResult{err: fmt.Errorf("could not create shoot client: %v", shootClientErr)}
+				continue
+			}
+			c.InjectShootClient(shootClient)
+		}
+
+		wg.Add(1)
+		go func(i int, check HealthCheck) {
+			defer wg.Done()
+			result, err := check.Check(ctx, request)
+			results[i] = checkResult{result, err}
+		}(i, check)
+	}
+	wg.Wait()
+
+	return a.aggregate(request, results), nil
+}
+
+func (a *actuator) requiresShootClient() bool {
+	for _, healthCheck := range a.healthChecks {
+		if _, ok := healthCheck.HealthCheck.(ShootClient); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// aggregate aggregates the given check results per condition type. The condition types are kept in the order
+// in which they have been registered. Any failed check results in an unknown status, any unhealthy check in a
+// false status.
+func (a *actuator) aggregate(request types.NamespacedName, results []checkResult) []Result {
+	var (
+		conditionTypes []gardencorev1alpha1.ConditionType
+		unhealthy      = make(map[gardencorev1alpha1.ConditionType][]string)
+		failed         = make(map[gardencorev1alpha1.ConditionType][]string)
+	)
+
+	for i, healthCheck := range a.healthChecks {
+		conditionType := healthCheck.ConditionType
+		if _, ok := unhealthy[conditionType]; !ok {
+			conditionTypes = append(conditionTypes, conditionType)
+			unhealthy[conditionType] = nil
+		}
+
+		result := results[i]
+		switch {
+		case result.err != nil:
+			a.logger.Error(result.err, "Health check failed", "conditionType", conditionType, "namespace", request.Namespace, "name", request.Name)
+			failed[conditionType] = append(failed[conditionType], result.err.Error())
+		case result.result != nil && !result.result.IsHealthy:
+			unhealthy[conditionType] = append(unhealthy[conditionType], result.result.Detail)
+		}
+	}
+
+	aggregated := make([]Result, 0, len(conditionTypes))
+	for _, conditionType := range conditionTypes {
+		switch {
+		case len(failed[conditionType]) > 0:
+			aggregated = append(aggregated, Result{
+				ConditionType: conditionType,
+				Status:        gardencorev1alpha1.ConditionUnknown,
+				Detail:        strings.Join(append(failed[conditionType], unhealthy[conditionType]...), "; "),
+			})
+		case len(unhealthy[conditionType]) > 0:
+			aggregated = append(aggregated, Result{
+				ConditionType: conditionType,
+				Status:        gardencorev1alpha1.ConditionFalse,
+				Detail:        strings.Join(unhealthy[conditionType], "; "),
+			})
+		default:
+			aggregated = append(aggregated, Result{
+				ConditionType: conditionType,
+				Status:        gardencorev1alpha1.ConditionTrue,
+			})
+		}
+	}
+	return aggregated
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+const (
+	conditionTypeA gardencorev1alpha1.ConditionType = "A"
+	conditionTypeB gardencorev1alpha1.ConditionType = "B"
+)
+
+type fakeCheck struct {
+	result *SingleCheckResult
+	err    error
+}
+
+func (c *fakeCheck) Check(_ context.Context, _ types.NamespacedName) (*SingleCheckResult, error) {
+	return c.result, c.err
+}
+
+func (c *fakeCheck) DeepCopy() HealthCheck {
+	copied := *c
+	return &copied
+}
+
+type fakeShootCheck struct {
+	fakeCheck
+	shootClient client.Client
+}
+
+func (c *fakeShootCheck) InjectShootClient(shootClient client.Client) {
+	c.shootClient = shootClient
+}
+
+func (c *fakeShootCheck) DeepCopy() HealthCheck {
+	copied := *c
+	return &copied
+}
+
+func healthy() HealthCheck {
+	return &fakeCheck{result: &SingleCheckResult{IsHealthy: true}}
+}
+
+func unhealthy(detail string) HealthCheck {
+	return &fakeCheck{result: &SingleCheckResult{IsHealthy: false, Detail: detail}}
+}
+
+func failing(err error) HealthCheck {
+	return &fakeCheck{err: err}
+}
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctx     = context.TODO()
+		logger  = log.Log.WithName("test")
+		request = types.NamespacedName{Namespace: "shoot--foo--bar", Name: "bar"}
+
+		noShootClient = func(context.Context, client.Client, string) (client.Client, error) {
+			return nil, errors.New("unexpected call")
+		}
+	)
+
+	Describe("#ExecuteHealthCheckFunctions", func() {
+		It("should report a healthy condition if all checks are successful", func() {
+			actuator := NewActuatorWithShootClientFactory([]ConditionTypeToHealthCheck{
+				{ConditionType: conditionTypeA, HealthCheck: healthy()},
+				{ConditionType: conditionTypeA, HealthCheck: healthy()},
+			}, noShootClient, logger)
+
+			results, err := actuator.ExecuteHealthCheckFunctions(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(Equal([]Result{
+				{ConditionType: conditionTypeA, Status: gardencorev1alpha1.ConditionTrue},
+			}))
+		})
+
+		It("should aggregate the results per condition type", func() {
+			actuator := NewActuatorWithShootClientFactory([]ConditionTypeToHealthCheck{
+				{ConditionType: conditionTypeA, HealthCheck: healthy()},
+				{ConditionType: conditionTypeB, HealthCheck: unhealthy("foo")},
+				{ConditionType: conditionTypeA, HealthCheck: unhealthy("bar")},
+				{ConditionType: conditionTypeB, HealthCheck: unhealthy("baz")},
+			}, noShootClient, logger)
+
+			results, err := actuator.ExecuteHealthCheckFunctions(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(Equal([]Result{
+				{ConditionType: conditionTypeA, Status: gardencorev1alpha1.ConditionFalse, Detail: "bar"},
+				{ConditionType: conditionTypeB, Status: gardencorev1alpha1.ConditionFalse, Detail: "foo; baz"},
+			}))
+		})
+
+		It("should report an unknown condition if a check fails", func() {
+			actuator := NewActuatorWithShootClientFactory([]ConditionTypeToHealthCheck{
+				{ConditionType: conditionTypeA, HealthCheck: unhealthy("foo")},
+				{ConditionType: conditionTypeA, HealthCheck: failing(errors.New("bar"))},
+			}, noShootClient, logger)
+
+			results, err := actuator.ExecuteHealthCheckFunctions(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(Equal([]Result{
+				{ConditionType: conditionTypeA, Status: gardencorev1alpha1.ConditionUnknown, Detail: "bar; foo"},
+			}))
+		})
+
+		It("should inject the shoot client into the checks requiring it", func() {
+			var (
+				namespace string
+				check     = &fakeShootCheck{fakeCheck: fakeCheck{result: &SingleCheckResult{IsHealthy: true}}}
+			)
+
+			actuator := NewActuatorWithShootClientFactory([]ConditionTypeToHealthCheck{
+				{ConditionType: conditionTypeA, HealthCheck: check},
+			}, func(_ context.Context, _ client.Client, ns string) (client.Client, error) {
+				namespace = ns
+				return nil, nil
+			}, logger)
+
+			results, err := actuator.ExecuteHealthCheckFunctions(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(namespace).To(Equal(request.Namespace))
+			Expect(results).To(Equal([]Result{
+				{ConditionType: conditionTypeA, Status: gardencorev1alpha1.ConditionTrue},
+			}))
+		})
+
+		It("should report an unknown condition only for checks requiring an unavailable shoot client", func() {
+			actuator := NewActuatorWithShootClientFactory([]ConditionTypeToHealthCheck{
+				{ConditionType: conditionTypeA, HealthCheck: healthy()},
+				{ConditionType: conditionTypeB, HealthCheck: &fakeShootCheck{fakeCheck: fakeCheck{result: &SingleCheckResult{IsHealthy: true}}}},
+			}, func(context.Context, client.Client, string) (client.Client, error) {
+				return nil, errors.New("unreachable")
+			}, logger)
+
+			results, err := actuator.ExecuteHealthCheckFunctions(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(Equal([]Result{
+				{ConditionType: conditionTypeA, Status: gardencorev1alpha1.ConditionTrue},
+				{ConditionType: conditionTypeB, Status: gardencorev1alpha1.ConditionUnknown, Detail: "could not create shoot client: unreachable"},
+			}))
+		})
+	})
+
+	Describe("#ConditionFromResult", func() {
+		lastTransitionTime := metav1.Unix(10, 0)
+
+		It("should initialize the condition if it does not exist yet", func() {
+			condition := ConditionFromResult(nil, Result{ConditionType: conditionTypeA, Status: gardencorev1alpha1.ConditionTrue})
+
+			Expect(condition.Type).To(Equal(conditionTypeA))
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(ReasonHealthCheckSuccessful))
+		})
+
+		It("should keep the transition time if the status does not change", func() {
+			conditions := []gardencorev1alpha1.Condition{
+				{Type: conditionTypeA, Status: gardencorev1alpha1.ConditionFalse, Reason: ReasonHealthCheckUnsuccessful, LastTransitionTime: lastTransitionTime},
+			}
+
+			condition := ConditionFromResult(conditions, Result{ConditionType: conditionTypeA, Status: gardencorev1alpha1.ConditionFalse, Detail: "foo"})
+
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Message).To(Equal("foo"))
+			Expect(condition.LastTransitionTime).To(Equal(lastTransitionTime))
+		})
+
+		It("should report an unknown status with the error reason", func() {
+			condition := ConditionFromResult(nil, Result{ConditionType: conditionTypeA, Status: gardencorev1alpha1.ConditionUnknown, Detail: "foo"})
+
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionUnknown))
+			Expect(condition.Reason).To(Equal(ReasonHealthCheckError))
+			Expect(condition.Message).To(Equal("foo"))
+		})
+	})
+})
@@ -0,0 +1,103 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// ControllerName is the suffix of the names of health check controllers.
+	ControllerName = "healthcheck-controller"
+	// DefaultSyncPeriod is the default period in which the health checks are executed.
+	DefaultSyncPeriod = 30 * time.Second
+)
+
+// GetExtensionObjectFunc returns a new, empty instance of the extension resource whose health is checked.
+type GetExtensionObjectFunc func() runtime.Object
+
+// AddArgs are arguments for adding a health check controller to a manager.
+type AddArgs struct {
+	// Type is the type of the extension resources whose health is checked.
+	Type string
+	// GetExtensionObjectFunc returns a new, empty instance of the extension resource whose health is checked.
+	// Supported are all extension resources whose status contains conditions.
+	GetExtensionObjectFunc GetExtensionObjectFunc
+	// HealthChecks are the health checks that are executed for every extension resource.
+	HealthChecks []ConditionTypeToHealthCheck
+	// SyncPeriod is the period in which the health checks are executed.
+	// If unset, DefaultSyncPeriod will be used.
+	SyncPeriod time.Duration
+	// ControllerOptions are the controller options used for creating a controller.
+	// The options.Reconciler is always overridden with a reconciler created from the
+	// given health checks.
+	ControllerOptions controller.Options
+	// Predicates are the predicates to use.
+	// If unset, DefaultPredicates will be used.
+	Predicates []predicate.Predicate
+}
+
+// DefaultPredicates returns the default predicates for a health check reconciler.
+func DefaultPredicates(client client.Client) []predicate.Predicate {
+	return []predicate.Predicate{
+		extensionscontroller.ShootFailedPredicate(client),
+		extensionscontroller.GenerationChangedPredicate(),
+	}
+}
+
+// Name returns the name of the health check controller for the given extension resource.
+func Name(obj runtime.Object) string {
+	return fmt.Sprintf("%s-%s", strings.ToLower(extensionscontroller.UnsafeGuessKind(obj)), ControllerName)
+}
+
+// Add creates a new health check controller for the extension resources of the given type and adds it to the
+// manager. The controller periodically executes the given health checks and writes their results as conditions
+// into the status of the extension resources.
+func Add(mgr manager.Manager, args AddArgs) error {
+	if args.SyncPeriod == 0 {
+		args.SyncPeriod = DefaultSyncPeriod
+	}
+
+	var (
+		obj  = args.GetExtensionObjectFunc()
+		name = Name(obj)
+	)
+
+	args.ControllerOptions.Reconciler = NewReconciler(name, NewActuator(args.HealthChecks, logger(name)), args.GetExtensionObjectFunc, args.SyncPeriod)
+	ctrl, err := controller.New(name, mgr, args.ControllerOptions)
+	if err != nil {
+		return err
+	}
+
+	predicates := args.Predicates
+	if predicates == nil {
+		predicates = DefaultPredicates(mgr.GetClient())
+	}
+	predicates = append(predicates, extensionscontroller.TypePredicate(args.Type))
+
+	return ctrl.Watch(&source.Kind{Type: obj}, &handler.EnqueueRequestForObject{}, predicates...)
+}
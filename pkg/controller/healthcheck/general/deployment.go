@@ -0,0 +1,111 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package general
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SeedDeploymentHealthChecker checks the health of a deployment in the shoot namespace of the seed cluster.
+type SeedDeploymentHealthChecker struct {
+	name   string
+	client client.Client
+}
+
+var _ healthcheck.SeedClient = (*SeedDeploymentHealthChecker)(nil)
+
+// NewSeedDeploymentHealthChecker creates a new health check for the deployment with the given name in the
+// shoot namespace of the seed cluster.
+func NewSeedDeploymentHealthChecker(name string) healthcheck.HealthCheck {
+	return &SeedDeploymentHealthChecker{name: name}
+}
+
+// InjectSeedClient implements healthcheck.SeedClient.
+func (c *SeedDeploymentHealthChecker) InjectSeedClient(client client.Client) {
+	c.client = client
+}
+
+// DeepCopy implements healthcheck.HealthCheck.
+func (c *SeedDeploymentHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	copied := *c
+	return &copied
+}
+
+// Check implements healthcheck.HealthCheck.
+func (c *SeedDeploymentHealthChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	return checkDeployment(ctx, c.client, kutil.Key(request.Namespace, c.name), "seed")
+}
+
+// ShootDeploymentHealthChecker checks the health of a deployment in the kube-system namespace of the shoot cluster.
+type ShootDeploymentHealthChecker struct {
+	name   string
+	client client.Client
+}
+
+var _ healthcheck.ShootClient = (*ShootDeploymentHealthChecker)(nil)
+
+// NewShootDeploymentHealthChecker creates a new health check for the deployment with the given name in the
+// kube-system namespace of the shoot cluster.
+func NewShootDeploymentHealthChecker(name string) healthcheck.HealthCheck {
+	return &ShootDeploymentHealthChecker{name: name}
+}
+
+// InjectShootClient implements healthcheck.ShootClient.
+func (c *ShootDeploymentHealthChecker) InjectShootClient(client client.Client) {
+	c.client = client
+}
+
+// DeepCopy implements healthcheck.HealthCheck.
+func (c *ShootDeploymentHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	copied := *c
+	return &copied
+}
+
+// Check implements healthcheck.HealthCheck.
+func (c *ShootDeploymentHealthChecker) Check(ctx context.Context, _ types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	return checkDeployment(ctx, c.client, kutil.Key(metav1.NamespaceSystem, c.name), "shoot")
+}
+
+func checkDeployment(ctx context.Context, c client.Client, key client.ObjectKey, cluster string) (*healthcheck.SingleCheckResult, error) {
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(ctx, key, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &healthcheck.SingleCheckResult{
+				IsHealthy: false,
+				Detail:    fmt.Sprintf("deployment %s in namespace %s of the %s cluster not found", key.Name, key.Namespace, cluster),
+			}, nil
+		}
+		return nil, err
+	}
+
+	if err := health.CheckDeployment(deployment); err != nil {
+		return &healthcheck.SingleCheckResult{
+			IsHealthy: false,
+			Detail:    fmt.Sprintf("deployment %s in namespace %s of the %s cluster is unhealthy: %v", key.Name, key.Namespace, cluster, err),
+		}, nil
+	}
+	return &healthcheck.SingleCheckResult{IsHealthy: true}, nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package general_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+	. "github.com/gardener/gardener-extensions/pkg/controller/healthcheck/general"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Deployment", func() {
+	const name = "cloud-controller-manager"
+
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		ctx     = context.TODO()
+		request = types.NamespacedName{Namespace: "shoot--foo--bar", Name: "bar"}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newSeedCheck := func() healthcheck.HealthCheck {
+		check := NewSeedDeploymentHealthChecker(name).DeepCopy()
+		check.(healthcheck.SeedClient).InjectSeedClient(c)
+		return check
+	}
+
+	expectGetDeployment := func(namespace string, status appsv1.DeploymentStatus) {
+		c.EXPECT().
+			Get(ctx, kutil.Key(namespace, name), gomock.AssignableToTypeOf(&appsv1.Deployment{})).
+			DoAndReturn(func(_ context.Context, _ types.NamespacedName, deployment *appsv1.Deployment) error {
+				deployment.Status = status
+				return nil
+			})
+	}
+
+	Describe("#SeedDeploymentHealthChecker", func() {
+		It("should report a healthy deployment", func() {
+			expectGetDeployment(request.Namespace, appsv1.DeploymentStatus{
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+				},
+			})
+
+			result, err := newSeedCheck().Check(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.IsHealthy).To(BeTrue())
+		})
+
+		It("should report an unavailable deployment", func() {
+			expectGetDeployment(request.Namespace, appsv1.DeploymentStatus{
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse},
+				},
+			})
+
+			result, err := newSeedCheck().Check(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.IsHealthy).To(BeFalse())
+			Expect(result.Detail).To(ContainSubstring("deployment cloud-controller-manager in namespace shoot--foo--bar of the seed cluster is unhealthy"))
+		})
+
+		It("should report a missing deployment", func() {
+			c.EXPECT().
+				Get(ctx, kutil.Key(request.Namespace, name), gomock.AssignableToTypeOf(&appsv1.Deployment{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{Resource: "deployments"}, name))
+
+			result, err := newSeedCheck().Check(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.IsHealthy).To(BeFalse())
+			Expect(result.Detail).To(ContainSubstring("not found"))
+		})
+
+		It("should return the error if the deployment cannot be read", func() {
+			c.EXPECT().
+				Get(ctx, kutil.Key(request.Namespace, name), gomock.AssignableToTypeOf(&appsv1.Deployment{})).
+				Return(errors.New("error"))
+
+			_, err := newSeedCheck().Check(ctx, request)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#ShootDeploymentHealthChecker", func() {
+		It("should check the deployment in the kube-system namespace", func() {
+			expectGetDeployment(metav1.NamespaceSystem, appsv1.DeploymentStatus{
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+				},
+			})
+
+			check := NewShootDeploymentHealthChecker(name).DeepCopy()
+			check.(healthcheck.ShootClient).InjectShootClient(c)
+			result, err := check.Check(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.IsHealthy).To(BeTrue())
+		})
+	})
+})
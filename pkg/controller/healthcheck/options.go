@@ -0,0 +1,59 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// SyncPeriodFlag is the name of the command line flag to specify the period in which the health checks are executed.
+const SyncPeriodFlag = "sync-period"
+
+// Options are command line options that can be set for health check controllers.
+type Options struct {
+	// SyncPeriod is the period in which the health checks are executed.
+	SyncPeriod time.Duration
+
+	config *Config
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&o.SyncPeriod, SyncPeriodFlag, o.SyncPeriod, "Period in which the health checks are executed.")
+}
+
+// Complete implements Completer.Complete.
+func (o *Options) Complete() error {
+	o.config = &Config{o.SyncPeriod}
+	return nil
+}
+
+// Completed returns the completed Config. Only call this if `Complete` was successful.
+func (o *Options) Completed() *Config {
+	return o.config
+}
+
+// Config is a completed health check configuration.
+type Config struct {
+	// SyncPeriod is the period in which the health checks are executed.
+	SyncPeriod time.Duration
+}
+
+// Apply sets the values of this Config in the given sync period.
+func (c *Config) Apply(syncPeriod *time.Duration) {
+	*syncPeriod = c.SyncPeriod
+}
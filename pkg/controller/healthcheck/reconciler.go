@@ -0,0 +1,193 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+// reconciler periodically executes the health checks of extension resources of Gardener's
+// `extensions.gardener.cloud` API group and reports their results as conditions.
+type reconciler struct {
+	logger                 logr.Logger
+	actuator               Actuator
+	getExtensionObjectFunc GetExtensionObjectFunc
+	syncPeriod             time.Duration
+
+	ctx    context.Context
+	client client.Client
+}
+
+var _ reconcile.Reconciler = (*reconciler)(nil)
+
+// NewReconciler creates a new reconcile.Reconciler that executes the health checks of the given actuator for
+// extension resources of Gardener's `extensions.gardener.cloud` API group.
+func NewReconciler(name string, actuator Actuator, getExtensionObjectFunc GetExtensionObjectFunc, syncPeriod time.Duration) reconcile.Reconciler {
+	return &reconciler{
+		logger:                 logger(name),
+		actuator:               actuator,
+		getExtensionObjectFunc: getExtensionObjectFunc,
+		syncPeriod:             syncPeriod,
+	}
+}
+
+func logger(name string) logr.Logger {
+	return log.Log.WithName(name)
+}
+
+// InjectFunc enables dependency injection into the actuator.
+func (r *reconciler) InjectFunc(f inject.Func) error {
+	return f(r.actuator)
+}
+
+// InjectClient injects the controller runtime client into the reconciler.
+func (r *reconciler) InjectClient(client client.Client) error {
+	r.client = client
+	return nil
+}
+
+// InjectStopChannel is an implementation for getting the respective stop channel managed by the controller-runtime.
+func (r *reconciler) InjectStopChannel(stopCh <-chan struct{}) error {
+	r.ctx = util.ContextFromStopChannel(stopCh)
+	return nil
+}
+
+// Reconcile executes the health checks for the extension resource of the given request.
+func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	obj := r.getExtensionObjectFunc()
+	if err := r.client.Get(r.ctx, request.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		r.logger.Error(err, "Could not fetch extension resource", "namespace", request.Namespace, "name", request.Name)
+		return reconcile.Result{}, err
+	}
+
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if accessor.GetDeletionTimestamp() != nil {
+		r.logger.Info("Do not execute health checks as the extension resource is being deleted", "namespace", request.Namespace, "name", request.Name)
+		return reconcile.Result{}, nil
+	}
+
+	status, err := DefaultStatus(obj)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if status.LastOperation == nil {
+		r.logger.Info("Do not execute health checks as the extension resource has not been reconciled yet", "namespace", request.Namespace, "name", request.Name)
+		return r.resultWithRequeue(), nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(r.ctx, r.client, request.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if extensionscontroller.IsHibernated(cluster.Shoot) {
+		r.logger.Info("Do not execute health checks as the shoot is hibernated", "namespace", request.Namespace, "name", request.Name)
+		return r.resultWithRequeue(), nil
+	}
+
+	results, err := r.actuator.ExecuteHealthCheckFunctions(r.ctx, request.NamespacedName)
+	if err != nil {
+		r.logger.Error(err, "Could not execute health checks", "namespace", request.Namespace, "name", request.Name)
+		return reconcile.Result{}, err
+	}
+
+	if err := extensionscontroller.TryUpdateStatus(r.ctx, retry.DefaultBackoff, r.client, obj, func() error {
+		status, err := DefaultStatus(obj)
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			status.Conditions = gardencorev1alpha1helper.MergeConditions(status.Conditions, ConditionFromResult(status.Conditions, result))
+		}
+		return nil
+	}); err != nil {
+		r.logger.Error(err, "Could not update the health conditions", "namespace", request.Namespace, "name", request.Name)
+		return reconcile.Result{}, err
+	}
+
+	return r.resultWithRequeue(), nil
+}
+
+func (r *reconciler) resultWithRequeue() reconcile.Result {
+	return reconcile.Result{RequeueAfter: r.syncPeriod}
+}
+
+// ConditionFromResult computes the condition for the given health check result out of the given existing
+// conditions.
+func ConditionFromResult(conditions []gardencorev1alpha1.Condition, result Result) gardencorev1alpha1.Condition {
+	condition := gardencorev1alpha1helper.GetCondition(conditions, result.ConditionType)
+	if condition == nil {
+		initialized := gardencorev1alpha1helper.InitCondition(result.ConditionType)
+		condition = &initialized
+	}
+
+	switch result.Status {
+	case gardencorev1alpha1.ConditionTrue:
+		return gardencorev1alpha1helper.UpdatedCondition(*condition, gardencorev1alpha1.ConditionTrue, ReasonHealthCheckSuccessful, "All health checks successful.")
+	case gardencorev1alpha1.ConditionFalse:
+		return gardencorev1alpha1helper.UpdatedCondition(*condition, gardencorev1alpha1.ConditionFalse, ReasonHealthCheckUnsuccessful, result.Detail)
+	default:
+		return gardencorev1alpha1helper.UpdatedCondition(*condition, gardencorev1alpha1.ConditionUnknown, ReasonHealthCheckError, result.Detail)
+	}
+}
+
+// DefaultStatus returns the default status of the given extension resource.
+func DefaultStatus(obj runtime.Object) (*extensionsv1alpha1.DefaultStatus, error) {
+	switch o := obj.(type) {
+	case *extensionsv1alpha1.BackupBucket:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.BackupEntry:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.ControlPlane:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.Extension:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.Infrastructure:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.Network:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.OperatingSystemConfig:
+		return &o.Status.DefaultStatus, nil
+	case *extensionsv1alpha1.Worker:
+		return &o.Status.DefaultStatus, nil
+	default:
+		return nil, fmt.Errorf("unsupported extension resource %T", obj)
+	}
+}
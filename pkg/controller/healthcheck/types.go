@@ -0,0 +1,81 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ReasonHealthCheckSuccessful is the reason of a health condition if all of its health checks were successful.
+	ReasonHealthCheckSuccessful = "HealthCheckSuccessful"
+	// ReasonHealthCheckUnsuccessful is the reason of a health condition if at least one of its health checks
+	// detected an unhealthy component.
+	ReasonHealthCheckUnsuccessful = "HealthCheckUnsuccessful"
+	// ReasonHealthCheckError is the reason of a health condition if at least one of its health checks could
+	// not be executed.
+	ReasonHealthCheckError = "HealthCheckError"
+)
+
+// HealthCheck checks the health of a single component that is managed by an extension controller.
+type HealthCheck interface {
+	// Check executes the health check for the extension resource identified by the given request.
+	Check(ctx context.Context, request types.NamespacedName) (*SingleCheckResult, error)
+	// DeepCopy returns a copy of the health check. Clients are injected into the copy for every execution
+	// so that health checks can be executed concurrently.
+	DeepCopy() HealthCheck
+}
+
+// SeedClient is implemented by health checks that require a client for the seed cluster.
+type SeedClient interface {
+	// InjectSeedClient injects the seed client.
+	InjectSeedClient(client.Client)
+}
+
+// ShootClient is implemented by health checks that require a client for the shoot cluster.
+type ShootClient interface {
+	// InjectShootClient injects the shoot client.
+	InjectShootClient(client.Client)
+}
+
+// ConditionTypeToHealthCheck registers a health check for the given condition type.
+type ConditionTypeToHealthCheck struct {
+	// ConditionType is the type of the condition the result of the health check is reported in.
+	ConditionType gardencorev1alpha1.ConditionType
+	// HealthCheck is the health check.
+	HealthCheck HealthCheck
+}
+
+// SingleCheckResult is the result of a single health check.
+type SingleCheckResult struct {
+	// IsHealthy is true if the checked component is healthy.
+	IsHealthy bool
+	// Detail contains the reason why the checked component is unhealthy.
+	Detail string
+}
+
+// Result is the aggregated result of all health checks of one condition type.
+type Result struct {
+	// ConditionType is the type of the condition.
+	ConditionType gardencorev1alpha1.ConditionType
+	// Status is the aggregated status of all health checks of the condition type.
+	Status gardencorev1alpha1.ConditionStatus
+	// Detail contains the details of all unsuccessful or failed health checks of the condition type.
+	Detail string
+}
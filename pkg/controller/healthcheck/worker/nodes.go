@@ -0,0 +1,109 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NodesHealthChecker checks whether all machine deployments in the shoot namespace of the seed cluster are
+// healthy and whether all of their machines have joined the shoot cluster as ready nodes.
+type NodesHealthChecker struct {
+	seedClient  client.Client
+	shootClient client.Client
+}
+
+var (
+	_ healthcheck.SeedClient  = (*NodesHealthChecker)(nil)
+	_ healthcheck.ShootClient = (*NodesHealthChecker)(nil)
+)
+
+// NewNodesChecker creates a new health check for the nodes of a shoot cluster.
+func NewNodesChecker() healthcheck.HealthCheck {
+	return &NodesHealthChecker{}
+}
+
+// InjectSeedClient implements healthcheck.SeedClient.
+func (c *NodesHealthChecker) InjectSeedClient(client client.Client) {
+	c.seedClient = client
+}
+
+// InjectShootClient implements healthcheck.ShootClient.
+func (c *NodesHealthChecker) InjectShootClient(client client.Client) {
+	c.shootClient = client
+}
+
+// DeepCopy implements healthcheck.HealthCheck.
+func (c *NodesHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	copied := *c
+	return &copied
+}
+
+// Check implements healthcheck.HealthCheck.
+func (c *NodesHealthChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	machineDeploymentList := &machinev1alpha1.MachineDeploymentList{}
+	if err := c.seedClient.List(ctx, client.InNamespace(request.Namespace), machineDeploymentList); err != nil {
+		return nil, err
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := c.shootClient.List(ctx, &client.ListOptions{}, nodeList); err != nil {
+		return nil, err
+	}
+
+	var (
+		details         []string
+		desiredReplicas int32
+	)
+
+	for _, machineDeployment := range machineDeploymentList.Items {
+		if machineDeployment.DeletionTimestamp != nil {
+			continue
+		}
+		desiredReplicas += machineDeployment.Spec.Replicas
+
+		if err := health.CheckMachineDeployment(&machineDeployment); err != nil {
+			details = append(details, fmt.Sprintf("machine deployment %s is unhealthy: %v", machineDeployment.Name, err))
+		}
+	}
+
+	for _, node := range nodeList.Items {
+		if err := health.CheckNode(&node); err != nil {
+			details = append(details, fmt.Sprintf("node %s is unhealthy: %v", node.Name, err))
+		}
+	}
+
+	if registeredNodes := int32(len(nodeList.Items)); registeredNodes < desiredReplicas {
+		details = append(details, fmt.Sprintf("not enough registered nodes (%d/%d)", registeredNodes, desiredReplicas))
+	}
+
+	if len(details) > 0 {
+		return &healthcheck.SingleCheckResult{
+			IsHealthy: false,
+			Detail:    strings.Join(details, "; "),
+		}, nil
+	}
+	return &healthcheck.SingleCheckResult{IsHealthy: true}, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/healthcheck"
+	. "github.com/gardener/gardener-extensions/pkg/controller/healthcheck/worker"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Nodes", func() {
+	var (
+		ctrl        *gomock.Controller
+		seedClient  *mockclient.MockClient
+		shootClient *mockclient.MockClient
+		check       healthcheck.HealthCheck
+
+		ctx     = context.TODO()
+		request = types.NamespacedName{Namespace: "shoot--foo--bar", Name: "bar"}
+
+		availableMachineDeployment = func(name string, replicas int32) machinev1alpha1.MachineDeployment {
+			return machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: replicas},
+				Status: machinev1alpha1.MachineDeploymentStatus{
+					Conditions: []machinev1alpha1.MachineDeploymentCondition{
+						{Type: machinev1alpha1.MachineDeploymentAvailable, Status: machinev1alpha1.ConditionTrue},
+					},
+				},
+			}
+		}
+		node = func(name string, ready corev1.ConditionStatus) corev1.Node {
+			return corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{Type: corev1.NodeReady, Status: ready},
+					},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		seedClient = mockclient.NewMockClient(ctrl)
+		shootClient = mockclient.NewMockClient(ctrl)
+
+		check = NewNodesChecker().DeepCopy()
+		check.(healthcheck.SeedClient).InjectSeedClient(seedClient)
+		check.(healthcheck.ShootClient).InjectShootClient(shootClient)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectList := func(machineDeployments []machinev1alpha1.MachineDeployment, nodes []corev1.Node) {
+		seedClient.EXPECT().
+			List(ctx, client.InNamespace(request.Namespace), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{})).
+			DoAndReturn(func(_ context.Context, _ *client.ListOptions, list *machinev1alpha1.MachineDeploymentList) error {
+				list.Items = machineDeployments
+				return nil
+			})
+		shootClient.EXPECT().
+			List(ctx, gomock.Any(), gomock.AssignableToTypeOf(&corev1.NodeList{})).
+			DoAndReturn(func(_ context.Context, _ *client.ListOptions, list *corev1.NodeList) error {
+				list.Items = nodes
+				return nil
+			})
+	}
+
+	It("should report healthy nodes", func() {
+		expectList(
+			[]machinev1alpha1.MachineDeployment{availableMachineDeployment("a", 1), availableMachineDeployment("b", 1)},
+			[]corev1.Node{node("a", corev1.ConditionTrue), node("b", corev1.ConditionTrue)},
+		)
+
+		result, err := check.Check(ctx, request)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.IsHealthy).To(BeTrue())
+	})
+
+	It("should report nodes that are not ready", func() {
+		expectList(
+			[]machinev1alpha1.MachineDeployment{availableMachineDeployment("a", 1)},
+			[]corev1.Node{node("a", corev1.ConditionFalse)},
+		)
+
+		result, err := check.Check(ctx, request)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.IsHealthy).To(BeFalse())
+		Expect(result.Detail).To(ContainSubstring("node a is unhealthy"))
+	})
+
+	It("should report missing nodes", func() {
+		expectList(
+			[]machinev1alpha1.MachineDeployment{availableMachineDeployment("a", 3)},
+			[]corev1.Node{node("a", corev1.ConditionTrue)},
+		)
+
+		result, err := check.Check(ctx, request)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.IsHealthy).To(BeFalse())
+		Expect(result.Detail).To(Equal("not enough registered nodes (1/3)"))
+	})
+})
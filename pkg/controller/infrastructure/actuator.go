@@ -18,6 +18,8 @@ import (
 	"context"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -33,6 +35,18 @@ type Actuator interface {
 	Delete(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
 }
 
+// Planner is implemented by actuators that are able to compute the changes a reconciliation
+// of an Infrastructure would cause, without actually performing them.
+type Planner interface {
+	// CanPlan returns true if the changes of the given Infrastructure can be planned. Infrastructures
+	// that cannot be planned are neither planned on request nor checked for drift.
+	CanPlan(*extensionsv1alpha1.Infrastructure) bool
+	// Plan the changes of the Infrastructure config. Planning may take a while, hence Plan may return a
+	// RequeueAfterError without cause if the plan has not completed yet. Plan is called again after the given
+	// duration to collect the result.
+	Plan(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) (*terraformer.Plan, error)
+}
+
 type operationAnnotationWrapper struct {
 	Actuator
 	client client.Client
@@ -42,8 +56,13 @@ type operationAnnotationWrapper struct {
 // removes the Gardener operation annotation.
 //
 // This is useful in conjunction with the OperationAnnotationPredicate.
+// The wrapper only implements Planner if the given actuator does.
 func OperationAnnotationWrapper(actuator Actuator) Actuator {
-	return &operationAnnotationWrapper{Actuator: actuator}
+	wrapper := &operationAnnotationWrapper{Actuator: actuator}
+	if planner, ok := actuator.(Planner); ok {
+		return &operationAnnotationPlanningWrapper{wrapper, planner}
+	}
+	return wrapper
 }
 
 // InjectClient implements inject.Client.
@@ -68,3 +87,18 @@ func (o *operationAnnotationWrapper) Reconcile(ctx context.Context, infra *exten
 
 	return o.Actuator.Reconcile(ctx, infra, cluster)
 }
+
+type operationAnnotationPlanningWrapper struct {
+	*operationAnnotationWrapper
+	planner Planner
+}
+
+// CanPlan implements Planner.
+func (o *operationAnnotationPlanningWrapper) CanPlan(infra *extensionsv1alpha1.Infrastructure) bool {
+	return o.planner.CanPlan(infra)
+}
+
+// Plan implements Planner.
+func (o *operationAnnotationPlanningWrapper) Plan(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (*terraformer.Plan, error) {
+	return o.planner.Plan(ctx, infra, cluster)
+}
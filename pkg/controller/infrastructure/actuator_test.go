@@ -0,0 +1,76 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	. "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeActuator struct{}
+
+func (fakeActuator) Reconcile(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error {
+	return nil
+}
+
+func (fakeActuator) Delete(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error {
+	return nil
+}
+
+type fakePlanningActuator struct {
+	fakeActuator
+	plan *terraformer.Plan
+}
+
+func (fakePlanningActuator) CanPlan(infra *extensionsv1alpha1.Infrastructure) bool {
+	return infra.Name != "native"
+}
+
+func (a fakePlanningActuator) Plan(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) (*terraformer.Plan, error) {
+	return a.plan, nil
+}
+
+var _ = Describe("Actuator", func() {
+	Describe("#OperationAnnotationWrapper", func() {
+		It("should not implement Planner if the wrapped actuator does not", func() {
+			_, ok := OperationAnnotationWrapper(fakeActuator{}).(Planner)
+
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should delegate the plan to the wrapped actuator", func() {
+			plan := &terraformer.Plan{ToAdd: 1}
+
+			planner, ok := OperationAnnotationWrapper(fakePlanningActuator{plan: plan}).(Planner)
+			Expect(ok).To(BeTrue())
+			Expect(planner.CanPlan(&extensionsv1alpha1.Infrastructure{})).To(BeTrue())
+			Expect(planner.Plan(context.TODO(), &extensionsv1alpha1.Infrastructure{}, nil)).To(BeIdenticalTo(plan))
+		})
+
+		It("should delegate whether an infrastructure can be planned to the wrapped actuator", func() {
+			planner := OperationAnnotationWrapper(fakePlanningActuator{}).(Planner)
+
+			Expect(planner.CanPlan(&extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "native"}})).To(BeFalse())
+		})
+	})
+})
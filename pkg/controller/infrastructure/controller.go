@@ -34,6 +34,10 @@ const (
 	FinalizerName = "extensions.gardener.cloud/infrastructure"
 	// ControllerName is the name of the controller.
 	ControllerName = "infrastructure-controller"
+	// PlanOnlyAnnotation is the annotation that makes the controller only plan the changes of an
+	// Infrastructure instead of applying them. As long as it is set to "true", the planned changes
+	// are published as events and the infrastructure is not touched.
+	PlanOnlyAnnotation = "infrastructure.extensions.gardener.cloud/plan-only"
 )
 
 // AddArgs are arguments for adding an infrastructure controller to a manager.
@@ -49,22 +53,34 @@ type AddArgs struct {
 	Predicates []predicate.Predicate
 	// WatchBuilder defines additional watches on controllers that should be set up.
 	WatchBuilder extensionscontroller.WatchBuilder
+	// DriftDetection is the configuration of the drift detection. It is only effective
+	// if the given actuator implements Planner.
+	DriftDetection DriftDetectionConfig
 }
 
 // DefaultPredicates returns the default predicates for an infrastructure reconciler.
+//
+// If the operation annotation is not ignored, the DriftDetectionPredicate makes sure that
+// infrastructures which are checked for drift are requeued after a restart of the controller.
 func DefaultPredicates(client client.Client, typeName string, ignoreOperationAnnotation bool) []predicate.Predicate {
 	if ignoreOperationAnnotation {
 		return []predicate.Predicate{
 			extensionscontroller.TypePredicate(typeName),
 			extensionscontroller.ShootFailedPredicate(client),
-			extensionscontroller.GenerationChangedPredicate(),
+			extensionscontroller.OrPredicate(
+				extensionscontroller.GenerationChangedPredicate(),
+				PlanOnlyAnnotationPredicate(),
+			),
 		}
 	}
 
 	return []predicate.Predicate{
 		extensionscontroller.TypePredicate(typeName),
 		extensionscontroller.ShootFailedPredicate(client),
-		OperationAnnotationPredicate(),
+		extensionscontroller.OrPredicate(
+			OperationAnnotationPredicate(),
+			DriftDetectionPredicate(),
+		),
 		extensionscontroller.OrPredicate(
 			extensionscontroller.GenerationChangedPredicate(),
 			extensionscontroller.AnnotationsChangedPredicate(),
@@ -75,7 +91,7 @@ func DefaultPredicates(client client.Client, typeName string, ignoreOperationAnn
 // Add creates a new Infrastructure Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, args.DriftDetection)
 	return add(mgr, args)
 }
 
@@ -0,0 +1,144 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/retry"
+
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ConditionTypeInfrastructureDrifted is the type of the condition that reports whether the actual
+	// infrastructure has drifted from its last applied configuration.
+	ConditionTypeInfrastructureDrifted gardencorev1alpha1.ConditionType = "InfrastructureDrifted"
+	// EventInfrastructureDrift an event reason to describe a detected infrastructure drift.
+	EventInfrastructureDrift string = "InfrastructureDrift"
+
+	// ConditionReasonDriftDetected is the reason of the drifted condition if a drift has been detected.
+	ConditionReasonDriftDetected = "DriftDetected"
+	// ConditionReasonNoDrift is the reason of the drifted condition if no drift has been detected.
+	ConditionReasonNoDrift = "NoDrift"
+)
+
+// DriftedCondition computes the InfrastructureDrifted condition out of the given existing conditions and the
+// result of planning the infrastructure. A plan with changes means that the actual infrastructure has drifted
+// from its last applied configuration.
+func DriftedCondition(conditions []gardencorev1alpha1.Condition, plan *terraformer.Plan, err error) gardencorev1alpha1.Condition {
+	condition := gardencorev1alpha1helper.GetCondition(conditions, ConditionTypeInfrastructureDrifted)
+	if condition == nil {
+		initialized := gardencorev1alpha1helper.InitCondition(ConditionTypeInfrastructureDrifted)
+		condition = &initialized
+	}
+
+	if err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(*condition, err)
+	}
+	if !plan.HasChanges() {
+		return gardencorev1alpha1helper.UpdatedCondition(*condition, gardencorev1alpha1.ConditionFalse, ConditionReasonNoDrift, "The infrastructure matches its last applied configuration.")
+	}
+	return gardencorev1alpha1helper.UpdatedCondition(*condition, gardencorev1alpha1.ConditionTrue, ConditionReasonDriftDetected, formatPlan(plan))
+}
+
+// needsReconcile returns true if the given infrastructure has changes or operations that have not been
+// reconciled yet, i.e. if it is not sufficient to check it for drift only.
+func needsReconcile(infrastructure *extensionsv1alpha1.Infrastructure) bool {
+	lastOperation := infrastructure.Status.LastOperation
+	return infrastructure.Generation != infrastructure.Status.ObservedGeneration ||
+		lastOperation == nil ||
+		lastOperation.Type == gardencorev1alpha1.LastOperationTypeDelete ||
+		lastOperation.State != gardencorev1alpha1.LastOperationStateSucceeded ||
+		kutil.HasMetaDataAnnotation(&infrastructure.ObjectMeta, gardencorev1alpha1.GardenerOperation, gardencorev1alpha1.GardenerOperationReconcile)
+}
+
+// DriftDetectionPredicate is a predicate that matches the creation of Infrastructures that are checked for drift,
+// i.e. that have the InfrastructureDrifted condition. As the controller is informed about the creation of every
+// existing Infrastructure when it is started, the periodic drift checks are resumed after a restart.
+func DriftDetectionPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event event.CreateEvent) bool {
+			infrastructure, ok := event.Object.(*extensionsv1alpha1.Infrastructure)
+			if !ok {
+				return false
+			}
+			return gardencorev1alpha1helper.GetCondition(infrastructure.Status.Conditions, ConditionTypeInfrastructureDrifted) != nil
+		},
+		UpdateFunc: func(event event.UpdateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(event event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(event event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+func (r *reconciler) driftDetectionEnabled(infrastructure *extensionsv1alpha1.Infrastructure) bool {
+	_, ok := r.planner(infrastructure)
+	return ok && r.driftDetection.Enabled()
+}
+
+func (r *reconciler) detectDrift(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	r.logger.Info("Checking the infrastructure for drift", "infrastructure", infrastructure.Name)
+	planner, _ := r.planner(infrastructure)
+	plan, err := planner.Plan(ctx, infrastructure, cluster)
+	if requeueAfter, pending := planPending(err); pending {
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+	if err != nil {
+		r.logger.Error(err, "Error checking the infrastructure for drift", "infrastructure", infrastructure.Name)
+	}
+
+	oldCondition := gardencorev1alpha1helper.GetCondition(infrastructure.Status.Conditions, ConditionTypeInfrastructureDrifted)
+	condition := DriftedCondition(infrastructure.Status.Conditions, plan, err)
+	if err := r.updateDriftedCondition(ctx, infrastructure, condition); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if condition.Status == gardencorev1alpha1.ConditionTrue {
+		if oldCondition == nil || oldCondition.Status != condition.Status || oldCondition.Message != condition.Message {
+			r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureDrift, "Infrastructure has drifted from its last applied configuration: %s", condition.Message)
+		}
+
+		if r.driftDetection.AutoApply {
+			r.logger.Info("Correcting the drift of infrastructure", "infrastructure", infrastructure.Name)
+			return r.reconcile(ctx, infrastructure, cluster)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.driftDetection.SyncPeriod}, nil
+}
+
+func (r *reconciler) updateDriftedCondition(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, condition gardencorev1alpha1.Condition) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, infrastructure, func() error {
+		infrastructure.Status.Conditions = gardencorev1alpha1helper.MergeConditions(infrastructure.Status.Conditions, condition)
+		return nil
+	})
+}
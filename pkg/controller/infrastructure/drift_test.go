@@ -0,0 +1,118 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure_test
+
+import (
+	"errors"
+
+	. "github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("Drift", func() {
+	Describe("#DriftedCondition", func() {
+		var (
+			lastTransitionTime = metav1.Unix(10, 0)
+			conditions         []gardencorev1alpha1.Condition
+		)
+
+		BeforeEach(func() {
+			conditions = []gardencorev1alpha1.Condition{
+				{
+					Type:               ConditionTypeInfrastructureDrifted,
+					Status:             gardencorev1alpha1.ConditionFalse,
+					Reason:             ConditionReasonNoDrift,
+					LastTransitionTime: lastTransitionTime,
+				},
+			}
+		})
+
+		It("should initialize the condition if it does not exist yet", func() {
+			condition := DriftedCondition(nil, &terraformer.Plan{}, nil)
+
+			Expect(condition.Type).To(Equal(ConditionTypeInfrastructureDrifted))
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ConditionReasonNoDrift))
+		})
+
+		It("should keep the transition time if the plan has no changes", func() {
+			condition := DriftedCondition(conditions, &terraformer.Plan{}, nil)
+
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.LastTransitionTime).To(Equal(lastTransitionTime))
+		})
+
+		It("should list the drifted resources if the plan has changes", func() {
+			condition := DriftedCondition(conditions, &terraformer.Plan{
+				ToChange: 1,
+				ResourceChanges: []terraformer.ResourceChange{
+					{Address: "aws_vpc.vpc", Action: terraformer.ChangeActionUpdate},
+				},
+			}, nil)
+
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(ConditionReasonDriftDetected))
+			Expect(condition.Message).To(Equal("Plan: 0 to add, 1 to change, 0 to destroy. Changes: update aws_vpc.vpc"))
+			Expect(condition.LastTransitionTime).NotTo(Equal(lastTransitionTime))
+		})
+
+		It("should set the condition to unknown if the plan failed", func() {
+			condition := DriftedCondition(conditions, nil, errors.New("foo"))
+
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionUnknown))
+			Expect(condition.Message).To(Equal("foo"))
+		})
+	})
+
+	Describe("#DriftDetectionPredicate", func() {
+		var (
+			predicate = DriftDetectionPredicate()
+			checked   = &extensionsv1alpha1.Infrastructure{
+				Status: extensionsv1alpha1.InfrastructureStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						Conditions: []gardencorev1alpha1.Condition{{Type: ConditionTypeInfrastructureDrifted}},
+					},
+				},
+			}
+		)
+
+		It("should match the creation of infrastructures that are checked for drift", func() {
+			Expect(predicate.Create(event.CreateEvent{Object: checked})).To(BeTrue())
+		})
+
+		It("should not match the creation of infrastructures that are not checked for drift", func() {
+			Expect(predicate.Create(event.CreateEvent{Object: &extensionsv1alpha1.Infrastructure{}})).To(BeFalse())
+		})
+
+		It("should not match other events", func() {
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: checked, ObjectNew: checked})).To(BeFalse())
+			Expect(predicate.Generic(event.GenericEvent{Object: checked})).To(BeFalse())
+		})
+	})
+
+	Describe("DriftDetectionConfig", func() {
+		It("should only be enabled with a positive sync period", func() {
+			Expect((&DriftDetectionConfig{}).Enabled()).To(BeFalse())
+			Expect((&DriftDetectionConfig{SyncPeriod: 1}).Enabled()).To(BeTrue())
+		})
+	})
+})
@@ -15,6 +15,8 @@
 package infrastructure
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 )
 
@@ -22,6 +24,12 @@ const (
 	// IgnoreOperationAnnotationFlag is the name of the command line flag to specify whether the operation annotation
 	// is ignored or not.
 	IgnoreOperationAnnotationFlag = "ignore-operation-annotation"
+	// DriftDetectionSyncPeriodFlag is the name of the command line flag to specify the period in which
+	// infrastructures are checked for drift.
+	DriftDetectionSyncPeriodFlag = "drift-detection-sync-period"
+	// DriftDetectionAutoApplyFlag is the name of the command line flag to specify whether a detected drift
+	// is corrected automatically or not.
+	DriftDetectionAutoApplyFlag = "drift-detection-auto-apply"
 )
 
 // ReconcilerOptions are command line options that can be set for controller.Options.
@@ -58,3 +66,48 @@ type ReconcilerConfig struct {
 func (c *ReconcilerConfig) Apply(ignore *bool) {
 	*ignore = c.IgnoreOperationAnnotation
 }
+
+// DriftDetectionOptions are command line options that configure the drift detection of infrastructures.
+type DriftDetectionOptions struct {
+	// SyncPeriod is the period in which infrastructures are checked for drift. Zero disables the drift detection.
+	SyncPeriod time.Duration
+	// AutoApply defines whether a detected drift is corrected automatically or not.
+	AutoApply bool
+
+	config *DriftDetectionConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (c *DriftDetectionOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.SyncPeriod, DriftDetectionSyncPeriodFlag, c.SyncPeriod, "Period in which infrastructures are checked for drift. Zero disables the drift detection.")
+	fs.BoolVar(&c.AutoApply, DriftDetectionAutoApplyFlag, c.AutoApply, "Correct a detected infrastructure drift automatically or not.")
+}
+
+// Complete implements Completer.Complete.
+func (c *DriftDetectionOptions) Complete() error {
+	c.config = &DriftDetectionConfig{c.SyncPeriod, c.AutoApply}
+	return nil
+}
+
+// Completed returns the completed DriftDetectionConfig. Only call this if `Complete` was successful.
+func (c *DriftDetectionOptions) Completed() *DriftDetectionConfig {
+	return c.config
+}
+
+// DriftDetectionConfig is a completed drift detection configuration.
+type DriftDetectionConfig struct {
+	// SyncPeriod is the period in which infrastructures are checked for drift. Zero disables the drift detection.
+	SyncPeriod time.Duration
+	// AutoApply defines whether a detected drift is corrected automatically or not.
+	AutoApply bool
+}
+
+// Apply sets the values of this DriftDetectionConfig in the given DriftDetectionConfig.
+func (c *DriftDetectionConfig) Apply(config *DriftDetectionConfig) {
+	*config = *c
+}
+
+// Enabled returns true if the drift detection is enabled.
+func (c *DriftDetectionConfig) Enabled() bool {
+	return c.SyncPeriod > 0
+}
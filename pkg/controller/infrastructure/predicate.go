@@ -19,6 +19,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -48,6 +49,32 @@ func OperationAnnotationPredicate() predicate.Predicate {
 	}
 }
 
+// PlanOnlyAnnotationPredicate is a predicate that matches if the plan-only annotation has been added
+// to an Infrastructure or its value has changed.
+func PlanOnlyAnnotationPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event event.CreateEvent) bool {
+			return false
+		},
+		UpdateFunc: func(event event.UpdateEvent) bool {
+			if event.MetaOld == nil || event.MetaNew == nil {
+				return false
+			}
+			return planOnly(event.MetaNew) && event.MetaOld.GetAnnotations()[PlanOnlyAnnotation] != event.MetaNew.GetAnnotations()[PlanOnlyAnnotation]
+		},
+		DeleteFunc: func(event event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(event event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+func planOnly(obj metav1.Object) bool {
+	return obj.GetAnnotations()[PlanOnlyAnnotation] == "true"
+}
+
 func mayReconcile(infrastructure *extensionsv1alpha1.Infrastructure) bool {
 	return infrastructure.DeletionTimestamp != nil ||
 		infrastructure.Generation != infrastructure.Status.ObservedGeneration ||
@@ -55,5 +82,6 @@ func mayReconcile(infrastructure *extensionsv1alpha1.Infrastructure) bool {
 		infrastructure.Status.LastOperation.Type == gardencorev1alpha1.LastOperationTypeCreate ||
 		infrastructure.Status.LastOperation.Type == gardencorev1alpha1.LastOperationTypeDelete ||
 		infrastructure.Status.LastOperation.State != gardencorev1alpha1.LastOperationStateSucceeded ||
-		kutil.HasMetaDataAnnotation(&infrastructure.ObjectMeta, gardencorev1alpha1.GardenerOperation, gardencorev1alpha1.GardenerOperationReconcile)
+		kutil.HasMetaDataAnnotation(&infrastructure.ObjectMeta, gardencorev1alpha1.GardenerOperation, gardencorev1alpha1.GardenerOperationReconcile) ||
+		planOnly(infrastructure)
 }
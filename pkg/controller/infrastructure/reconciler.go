@@ -17,8 +17,13 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
+	"github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -45,11 +50,14 @@ const (
 	EventInfrastructureReconciliation string = "InfrastructureReconciliation"
 	// EventInfrastructureDeleton an event reason to describe infrastructure deletion.
 	EventInfrastructureDeleton string = "InfrastructureDeleton"
+	// EventInfrastructurePlan an event reason to describe infrastructure planning.
+	EventInfrastructurePlan string = "InfrastructurePlan"
 )
 
 type reconciler struct {
-	logger   logr.Logger
-	actuator Actuator
+	logger         logr.Logger
+	actuator       Actuator
+	driftDetection DriftDetectionConfig
 
 	ctx      context.Context
 	client   client.Client
@@ -58,11 +66,14 @@ type reconciler struct {
 
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // infrastructure resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+// If the drift detection is enabled and the actuator implements Planner, reconciled
+// infrastructures that can be planned are periodically checked for drift.
+func NewReconciler(mgr manager.Manager, actuator Actuator, driftDetection DriftDetectionConfig) reconcile.Reconciler {
 	return &reconciler{
-		logger:   log.Log.WithName(ControllerName),
-		actuator: actuator,
-		recorder: mgr.GetRecorder(ControllerName),
+		logger:         log.Log.WithName(ControllerName),
+		actuator:       actuator,
+		driftDetection: driftDetection,
+		recorder:       mgr.GetRecorder(ControllerName),
 	}
 }
 
@@ -80,7 +91,7 @@ func (r *reconciler) InjectStopChannel(stopCh <-chan struct{}) error {
 	return nil
 }
 
-func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+func (r *reconciler) Reconcile(request reconcile.Request) (result reconcile.Result, err error) {
 	infrastructure := &extensionsv1alpha1.Infrastructure{}
 	if err := r.client.Get(r.ctx, request.NamespacedName, infrastructure); err != nil {
 		if errors.IsNotFound(err) {
@@ -89,6 +100,8 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
+	defer func(start time.Time) { metrics.ObserveReconcile(infrastructure, start, err) }(time.Now())
+
 	cluster, err := extensionscontroller.GetCluster(r.ctx, r.client, infrastructure.Namespace)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -97,9 +110,62 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 	if infrastructure.DeletionTimestamp != nil {
 		return r.delete(r.ctx, infrastructure, cluster)
 	}
+	if planOnly(infrastructure) {
+		return r.plan(r.ctx, infrastructure, cluster)
+	}
+	if r.driftDetectionEnabled(infrastructure) && !needsReconcile(infrastructure) {
+		return r.detectDrift(r.ctx, infrastructure, cluster)
+	}
 	return r.reconcile(r.ctx, infrastructure, cluster)
 }
 
+func (r *reconciler) plan(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	planner, ok := r.planner(infrastructure)
+	if !ok {
+		msg := "Infrastructure shall only be planned but the actuator does not support planning it"
+		r.logger.Info(msg, "infrastructure", infrastructure.Name)
+		r.recorder.Event(infrastructure, corev1.EventTypeWarning, EventInfrastructurePlan, msg)
+		return reconcile.Result{}, nil
+	}
+
+	r.logger.Info("Starting the plan of infrastructure", "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructurePlan, "Planning the infrastructure")
+	plan, err := planner.Plan(ctx, infrastructure, cluster)
+	if requeueAfter, pending := planPending(err); pending {
+		r.logger.Info("Waiting for the plan of infrastructure", "infrastructure", infrastructure.Name)
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+	if err != nil {
+		msg := "Error planning infrastructure"
+		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructurePlan, "%s: %+v", msg, err)
+		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
+		return extensionscontroller.ReconcileErr(err)
+	}
+
+	msg := fmt.Sprintf("Successfully planned infrastructure: %s", formatPlan(plan))
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructurePlan, msg)
+	return reconcile.Result{}, nil
+}
+
+// planPending returns true and the duration after which the plan shall be checked again if the given error of
+// a Planner indicates that the plan has not completed yet.
+func planPending(err error) (time.Duration, bool) {
+	if requeueAfter, ok := err.(*controllererror.RequeueAfterError); ok && requeueAfter.Cause == nil {
+		return requeueAfter.RequeueAfter, true
+	}
+	return 0, false
+}
+
+// planner returns the actuator as Planner if it is able to plan the given infrastructure.
+func (r *reconciler) planner(infrastructure *extensionsv1alpha1.Infrastructure) (Planner, bool) {
+	planner, ok := r.actuator.(Planner)
+	if !ok || !planner.CanPlan(infrastructure) {
+		return nil, false
+	}
+	return planner, true
+}
+
 func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
 	if err := extensionscontroller.EnsureFinalizer(ctx, r.client, FinalizerName, infrastructure); err != nil {
 		return reconcile.Result{}, err
@@ -112,7 +178,10 @@ func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1
 
 	r.logger.Info("Starting the reconciliation of infrastructure", "infrastructure", infrastructure.Name)
 	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureReconciliation, "Reconciling the infrastructure")
-	if err := r.actuator.Reconcile(ctx, infrastructure, cluster); err != nil {
+	start := time.Now()
+	err := r.actuator.Reconcile(ctx, infrastructure, cluster)
+	metrics.ObserveActuatorOperation(infrastructure, metrics.OperationReconcile, start, err)
+	if err != nil {
 		msg := "Error reconciling infrastructure"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
 		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
@@ -126,6 +195,14 @@ func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1
 		return reconcile.Result{}, err
 	}
 
+	if r.driftDetectionEnabled(infrastructure) {
+		condition := DriftedCondition(infrastructure.Status.Conditions, &terraformer.Plan{}, nil)
+		if err := r.updateDriftedCondition(ctx, infrastructure, condition); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: r.driftDetection.SyncPeriod}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -147,7 +224,10 @@ func (r *reconciler) delete(ctx context.Context, infrastructure *extensionsv1alp
 
 	r.logger.Info("Starting the deletion of infrastructure", "infrastructure", infrastructure.Name)
 	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureDeleton, "Deleting the infrastructure")
-	if err := r.actuator.Delete(r.ctx, infrastructure, cluster); err != nil {
+	start := time.Now()
+	err = r.actuator.Delete(r.ctx, infrastructure, cluster)
+	metrics.ObserveActuatorOperation(infrastructure, metrics.OperationDelete, start, err)
+	if err != nil {
 		msg := "Error deleting infrastructure"
 		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureDeleton, "%s: %+v", msg, err)
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
@@ -193,3 +273,20 @@ func (r *reconciler) updateStatusSuccess(ctx context.Context, infrastructure *ex
 		return nil
 	})
 }
+
+// formatPlan returns a human readable summary of the given plan that lists all planned resource changes.
+func formatPlan(plan *terraformer.Plan) string {
+	if !plan.HasChanges() {
+		return "No changes, the infrastructure is up-to-date."
+	}
+
+	changes := make([]string, 0, len(plan.ResourceChanges))
+	for _, change := range plan.ResourceChanges {
+		changes = append(changes, fmt.Sprintf("%s %s", change.Action, change.Address))
+	}
+
+	if len(changes) == 0 {
+		return plan.String()
+	}
+	return fmt.Sprintf("%s Changes: %s", plan.String(), strings.Join(changes, ", "))
+}
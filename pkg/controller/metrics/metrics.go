@@ -0,0 +1,208 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "gardener_extensions"
+
+	// ResultSuccess is the value of the result label for operations that succeeded.
+	ResultSuccess = "success"
+	// ResultError is the value of the result label for operations that failed.
+	ResultError = "error"
+	// ResultRequeue is the value of the result label for operations that requested to be requeued without error.
+	ResultRequeue = "requeue"
+
+	// OperationReconcile is the value of the operation label for reconciliations.
+	OperationReconcile = "reconcile"
+	// OperationDelete is the value of the operation label for deletions.
+	OperationDelete = "delete"
+	// OperationApply is the value of the operation label for Terraformer applies.
+	OperationApply = "apply"
+	// OperationDestroy is the value of the operation label for Terraformer destroys.
+	OperationDestroy = "destroy"
+	// OperationPlan is the value of the operation label for Terraformer plans.
+	OperationPlan = "plan"
+)
+
+var (
+	// ReconcileDuration is the duration of the reconciliations of extension resources, partitioned
+	// by the kind and type of the resource and the result of the reconciliation.
+	ReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "controller",
+			Name:      "reconcile_duration_seconds",
+			Help:      "Duration of the reconciliations of extension resources.",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600, 1200},
+		},
+		[]string{"kind", "type", "result"},
+	)
+
+	// ReconcileTotal is the number of reconciliations of extension resources, partitioned by the kind
+	// and type of the resource and the result of the reconciliation.
+	ReconcileTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "controller",
+			Name:      "reconcile_total",
+			Help:      "Total number of reconciliations of extension resources.",
+		},
+		[]string{"kind", "type", "result"},
+	)
+
+	// ActuatorOperationDuration is the time spent in the Reconcile and Delete operations of actuators, partitioned
+	// by the kind and type of the resource, the operation, and the result of the operation.
+	ActuatorOperationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "actuator",
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of the reconcile and delete operations of actuators.",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600, 1200},
+		},
+		[]string{"kind", "type", "operation", "result"},
+	)
+
+	// TerraformerOperationDuration is the duration of Terraformer runs, partitioned by the purpose of
+	// the Terraformer, the operation, and the result of the operation.
+	TerraformerOperationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "terraformer",
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of the apply, destroy and plan runs of the Terraformer.",
+			Buckets:   []float64{5, 10, 30, 60, 120, 300, 600, 1200, 1800},
+		},
+		[]string{"purpose", "operation", "result"},
+	)
+
+	// TerraformerOperationFailuresTotal is the number of failed Terraformer runs, partitioned by the purpose
+	// of the Terraformer and the operation.
+	TerraformerOperationFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "terraformer",
+			Name:      "operation_failures_total",
+			Help:      "Total number of failed apply, destroy and plan runs of the Terraformer.",
+		},
+		[]string{"purpose", "operation"},
+	)
+
+	// MachineDeploymentsWaitDuration is the time spent waiting for the machine deployments of a worker to
+	// become available, partitioned by the worker type and the result of the wait.
+	MachineDeploymentsWaitDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "worker",
+			Name:      "machine_deployments_wait_duration_seconds",
+			Help:      "Duration of waiting for the machine deployments of a worker to become available.",
+			Buckets:   []float64{5, 10, 30, 60, 120, 300, 600, 1200, 1800},
+		},
+		[]string{"type", "result"},
+	)
+
+	// WebhookMutationDuration is the duration of the mutations done by the control plane webhooks, partitioned
+	// by the kind of the mutated resource and the result of the mutation.
+	WebhookMutationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "webhook",
+			Name:      "mutation_duration_seconds",
+			Help:      "Duration of the mutations done by the control plane webhooks.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"kind", "result"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		ReconcileDuration,
+		ReconcileTotal,
+		ActuatorOperationDuration,
+		TerraformerOperationDuration,
+		TerraformerOperationFailuresTotal,
+		MachineDeploymentsWaitDuration,
+		WebhookMutationDuration,
+	)
+}
+
+// Result returns the value of the result label for the given error.
+func Result(err error) string {
+	if requeueAfter, ok := err.(*controllererror.RequeueAfterError); ok && requeueAfter.Cause == nil {
+		return ResultRequeue
+	}
+	if err != nil {
+		return ResultError
+	}
+	return ResultSuccess
+}
+
+// Object is an extension resource whose kind and type are used as labels of the metrics.
+type Object interface {
+	runtime.Object
+	extensionsv1alpha1.ExtensionType
+}
+
+// ObserveReconcile records the duration and the outcome of a reconciliation of the given extension resource
+// that started at <start>.
+func ObserveReconcile(obj Object, start time.Time, err error) {
+	var (
+		kind   = extensionscontroller.UnsafeGuessKind(obj)
+		result = Result(err)
+	)
+
+	ReconcileDuration.WithLabelValues(kind, obj.GetExtensionType(), result).Observe(time.Since(start).Seconds())
+	ReconcileTotal.WithLabelValues(kind, obj.GetExtensionType(), result).Inc()
+}
+
+// ObserveActuatorOperation records the duration of the given actuator operation on the given extension
+// resource that started at <start>.
+func ObserveActuatorOperation(obj Object, operation string, start time.Time, err error) {
+	ActuatorOperationDuration.WithLabelValues(extensionscontroller.UnsafeGuessKind(obj), obj.GetExtensionType(), operation, Result(err)).Observe(time.Since(start).Seconds())
+}
+
+// ObserveTerraformerOperation records the duration of the given Terraformer operation that started at <start>
+// and counts it as failure if <err> is not nil.
+func ObserveTerraformerOperation(purpose, operation string, start time.Time, err error) {
+	TerraformerOperationDuration.WithLabelValues(purpose, operation, Result(err)).Observe(time.Since(start).Seconds())
+	if err != nil {
+		TerraformerOperationFailuresTotal.WithLabelValues(purpose, operation).Inc()
+	}
+}
+
+// ObserveMachineDeploymentsWait records the time spent waiting for the machine deployments of a worker
+// of the given type that started at <start>.
+func ObserveMachineDeploymentsWait(workerType string, start time.Time, err error) {
+	MachineDeploymentsWaitDuration.WithLabelValues(workerType, Result(err)).Observe(time.Since(start).Seconds())
+}
+
+// ObserveWebhookMutation records the duration of a mutation of a resource of the given kind that started at <start>.
+func ObserveWebhookMutation(kind string, start time.Time, err error) {
+	WebhookMutationDuration.WithLabelValues(kind, Result(err)).Observe(time.Since(start).Seconds())
+}
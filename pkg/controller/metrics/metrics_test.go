@@ -0,0 +1,93 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"errors"
+	"time"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	. "github.com/gardener/gardener-extensions/pkg/controller/metrics"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func counterValue(counter prometheus.Counter) float64 {
+	metric := &dto.Metric{}
+	Expect(counter.Write(metric)).To(Succeed())
+	return metric.GetCounter().GetValue()
+}
+
+func histogramCount(observer prometheus.Observer) uint64 {
+	metric := &dto.Metric{}
+	Expect(observer.(prometheus.Metric).Write(metric)).To(Succeed())
+	return metric.GetHistogram().GetSampleCount()
+}
+
+var _ = Describe("Metrics", func() {
+	Describe("#Result", func() {
+		It("should return success if there is no error", func() {
+			Expect(Result(nil)).To(Equal(ResultSuccess))
+		})
+
+		It("should return error if there is an error", func() {
+			Expect(Result(errors.New("foo"))).To(Equal(ResultError))
+		})
+
+		It("should return requeue if there is a requeue error without cause", func() {
+			Expect(Result(&controllererror.RequeueAfterError{RequeueAfter: time.Second})).To(Equal(ResultRequeue))
+		})
+
+		It("should return error if there is a requeue error with cause", func() {
+			Expect(Result(&controllererror.RequeueAfterError{Cause: errors.New("foo"), RequeueAfter: time.Second})).To(Equal(ResultError))
+		})
+	})
+
+	Describe("#ObserveReconcile", func() {
+		It("should record the reconciliation labeled with the kind, type and result", func() {
+			var (
+				infrastructure = &extensionsv1alpha1.Infrastructure{Spec: extensionsv1alpha1.InfrastructureSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "foo"}}}
+				total          = ReconcileTotal.WithLabelValues("Infrastructure", "foo", ResultError)
+				duration       = ReconcileDuration.WithLabelValues("Infrastructure", "foo", ResultError)
+				totalBefore    = counterValue(total)
+				countBefore    = histogramCount(duration)
+			)
+
+			ObserveReconcile(infrastructure, time.Now(), errors.New("foo"))
+
+			Expect(counterValue(total)).To(Equal(totalBefore + 1))
+			Expect(histogramCount(duration)).To(Equal(countBefore + 1))
+		})
+	})
+
+	Describe("#ObserveTerraformerOperation", func() {
+		It("should only count failed operations as failures", func() {
+			var (
+				failures       = TerraformerOperationFailuresTotal.WithLabelValues("infra", OperationApply)
+				failuresBefore = counterValue(failures)
+			)
+
+			ObserveTerraformerOperation("infra", OperationApply, time.Now(), nil)
+			Expect(counterValue(failures)).To(Equal(failuresBefore))
+
+			ObserveTerraformerOperation("infra", OperationApply, time.Now(), errors.New("foo"))
+			Expect(counterValue(failures)).To(Equal(failuresBefore + 1))
+		})
+	})
+})
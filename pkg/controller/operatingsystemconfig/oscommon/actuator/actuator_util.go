@@ -30,6 +30,7 @@ package actuator
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/cloudinit"
 	commonosgenerator "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
@@ -44,14 +45,9 @@ import (
 // CloudConfigFromOperatingSystemConfig generates a CloudConfig from an OperatingSystemConfig
 // using a Generator
 func CloudConfigFromOperatingSystemConfig(ctx context.Context, cli runtimeclient.Client, config *extensionsv1alpha1.OperatingSystemConfig, generator commonosgenerator.Generator) ([]byte, *string, error) {
-	files := make([]*commonosgenerator.File, 0, len(config.Spec.Files))
-	for _, file := range config.Spec.Files {
-		data, err := DataForFileContent(ctx, cli, config.Namespace, &file.Content)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		files = append(files, &commonosgenerator.File{Path: file.Path, Content: data, Permissions: file.Permissions})
+	files, err := FilesFromOperatingSystemConfig(ctx, cli, config)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	units := make([]*commonosgenerator.Unit, 0, len(config.Spec.Units))
@@ -76,6 +72,26 @@ func CloudConfigFromOperatingSystemConfig(ctx context.Context, cli runtimeclient
 	})
 }
 
+// FilesFromOperatingSystemConfig returns the files of an OperatingSystemConfig with their decoded content, retrieving
+// it from Secrets if necessary. Files without permissions get the default permissions.
+func FilesFromOperatingSystemConfig(ctx context.Context, cli runtimeclient.Client, config *extensionsv1alpha1.OperatingSystemConfig) ([]*commonosgenerator.File, error) {
+	files := make([]*commonosgenerator.File, 0, len(config.Spec.Files))
+	for _, file := range config.Spec.Files {
+		data, err := DataForFileContent(ctx, cli, config.Namespace, &file.Content)
+		if err != nil {
+			return nil, err
+		}
+
+		permissions := extensionsv1alpha1.OperatingSystemConfigDefaultFilePermission
+		if p := file.Permissions; p != nil {
+			permissions = *p
+		}
+
+		files = append(files, &commonosgenerator.File{Path: file.Path, Content: data, Permissions: &permissions})
+	}
+	return files, nil
+}
+
 // DataForFileContent returns the content for a FileContent, retrieving from a Secret if necessary.
 func DataForFileContent(ctx context.Context, cli runtimeclient.Client, namespace string, content *extensionsv1alpha1.FileContent) ([]byte, error) {
 	if inline := content.Inline; inline != nil {
@@ -85,12 +101,21 @@ func DataForFileContent(ctx context.Context, cli runtimeclient.Client, namespace
 		return cloudinit.Decode(inline.Encoding, []byte(inline.Data))
 	}
 
+	if content.SecretRef == nil {
+		return nil, fmt.Errorf("file content must either be inlined or reference a secret")
+	}
+
 	key := runtimeclient.ObjectKey{Namespace: namespace, Name: content.SecretRef.Name}
 	secret := &corev1.Secret{}
 	if err := cli.Get(ctx, key, secret); err != nil {
 		return nil, err
 	}
-	return secret.Data[content.SecretRef.DataKey], nil
+
+	data, ok := secret.Data[content.SecretRef.DataKey]
+	if !ok {
+		return nil, fmt.Errorf("could not find key %q in data of secret %q", content.SecretRef.DataKey, content.SecretRef.Name)
+	}
+	return data, nil
 }
 
 // OperatingSystemConfigUnitNames returns the names of the units in the OperatingSystemConfig
@@ -0,0 +1,124 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actuator_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/actuator"
+	commonosgenerator "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Actuator Util", func() {
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		ctx       = context.TODO()
+		secretKey = client.ObjectKey{Namespace: "shoot--foo--bar", Name: "secret"}
+		secret    = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: secretKey.Namespace, Name: secretKey.Name},
+			Data:       map[string][]byte{"key": []byte("from-secret")},
+		}
+		onlyOwnerPerm = int32(0600)
+		defaultPerm   = extensionsv1alpha1.OperatingSystemConfigDefaultFilePermission
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newConfig := func(files ...extensionsv1alpha1.File) *extensionsv1alpha1.OperatingSystemConfig {
+		return &extensionsv1alpha1.OperatingSystemConfig{
+			ObjectMeta: metav1.ObjectMeta{Namespace: secretKey.Namespace, Name: "osc"},
+			Spec:       extensionsv1alpha1.OperatingSystemConfigSpec{Files: files},
+		}
+	}
+
+	expectGetSecret := func() {
+		c.EXPECT().Get(ctx, secretKey, &corev1.Secret{}).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+			secret.DeepCopyInto(obj.(*corev1.Secret))
+			return nil
+		})
+	}
+
+	Describe("#FilesFromOperatingSystemConfig", func() {
+		It("should decode inline content, resolve secret references and default the permissions", func() {
+			expectGetSecret()
+
+			files, err := actuator.FilesFromOperatingSystemConfig(ctx, c, newConfig(
+				extensionsv1alpha1.File{
+					Path:    "/plain",
+					Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "plain"}},
+				},
+				extensionsv1alpha1.File{
+					Path:        "/encoded",
+					Permissions: &onlyOwnerPerm,
+					Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: "ZW5jb2RlZA=="}},
+				},
+				extensionsv1alpha1.File{
+					Path:    "/secret",
+					Content: extensionsv1alpha1.FileContent{SecretRef: &extensionsv1alpha1.FileContentSecretRef{Name: secretKey.Name, DataKey: "key"}},
+				},
+			))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(Equal([]*commonosgenerator.File{
+				{Path: "/plain", Content: []byte("plain"), Permissions: &defaultPerm},
+				{Path: "/encoded", Content: []byte("encoded"), Permissions: &onlyOwnerPerm},
+				{Path: "/secret", Content: []byte("from-secret"), Permissions: &defaultPerm},
+			}))
+		})
+
+		It("should fail if the referenced secret does not contain the data key", func() {
+			expectGetSecret()
+
+			_, err := actuator.FilesFromOperatingSystemConfig(ctx, c, newConfig(
+				extensionsv1alpha1.File{
+					Path:    "/secret",
+					Content: extensionsv1alpha1.FileContent{SecretRef: &extensionsv1alpha1.FileContentSecretRef{Name: secretKey.Name, DataKey: "other"}},
+				},
+			))
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for unknown inline encodings", func() {
+			_, err := actuator.FilesFromOperatingSystemConfig(ctx, c, newConfig(
+				extensionsv1alpha1.File{
+					Path:    "/encoded",
+					Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "rot13", Data: "sbb"}},
+				},
+			))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
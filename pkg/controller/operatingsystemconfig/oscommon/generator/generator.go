@@ -26,6 +26,11 @@ type File struct {
 	Path        string
 	Content     []byte
 	Permissions *int32
+	// Owner is the owner of the file in the form "<user>[:<group>]". If empty, the generator's default is used.
+	Owner string
+	// TransmitUnencoded defines whether the content is written into the generated configuration as is instead of
+	// being encoded.
+	TransmitUnencoded bool
 }
 
 // Unit is a unit to be created during the cloud init script.
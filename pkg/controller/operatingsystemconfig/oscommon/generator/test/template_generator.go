@@ -62,5 +62,57 @@ var DescribeTest = func(g generator.Generator, box packr.Box) func() {
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 			gomega.Expect(cloudInit).To(gomega.Equal(expectedCloudInit))
 		})
+
+		ginkgo.It("should render multiple units and drop-ins correctly", func() {
+			expectedCloudInit, err := box.Find("cloud-init-units")
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			cloudInit, _, err := g.Generate(&generator.OperatingSystemConfig{
+				Files: []*generator.File{
+					{
+						Path:        "/foo",
+						Content:     []byte("bar"),
+						Permissions: &onlyOwnerPerm,
+					},
+					{
+						Path:    "/baz",
+						Content: []byte("qux"),
+					},
+				},
+
+				Units: []*generator.Unit{
+					{
+						Name:    "docker.service",
+						Content: []byte("unit"),
+						DropIns: []*generator.DropIn{
+							{
+								Name:    "10-docker-opts.conf",
+								Content: []byte("override"),
+							},
+							{
+								Name:    "20-docker-limits.conf",
+								Content: []byte("limits"),
+							},
+						},
+					},
+					{
+						Name: "kubelet.service",
+						DropIns: []*generator.DropIn{
+							{
+								Name:    "10-kubelet-opts.conf",
+								Content: []byte("opts"),
+							},
+						},
+					},
+					{
+						Name:    "cloud-config-downloader.service",
+						Content: []byte("downloader"),
+					},
+				},
+			})
+
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(cloudInit).To(gomega.Equal(expectedCloudInit))
+		})
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
 	"path"
+	"strconv"
 	"text/template"
 )
 
@@ -31,6 +32,10 @@ type fileData struct {
 	Content     string
 	Dirname     string
 	Permissions *string
+	Owner       string
+	// TransmitUnencoded defines whether Content is a double-quoted string that can be embedded into the
+	// generated configuration as is, instead of being base64 encoded.
+	TransmitUnencoded bool
 }
 
 type unitData struct {
@@ -72,9 +77,14 @@ func (t *CloudInitGenerator) Generate(data *generator.OperatingSystemConfig) ([]
 	var tFiles []*fileData
 	for _, file := range data.Files {
 		tFile := &fileData{
-			Path:    file.Path,
-			Content: b64(file.Content),
-			Dirname: path.Dir(file.Path),
+			Path:              file.Path,
+			Content:           b64(file.Content),
+			Dirname:           path.Dir(file.Path),
+			Owner:             file.Owner,
+			TransmitUnencoded: file.TransmitUnencoded,
+		}
+		if file.TransmitUnencoded {
+			tFile.Content = strconv.Quote(string(file.Content))
 		}
 		if file.Permissions != nil {
 			permissions := fmt.Sprintf("%04o", *file.Permissions)
@@ -0,0 +1,69 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template_test
+
+import (
+	"text/template"
+
+	commonosgenerator "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/generator"
+	templategenerator "github.com/gardener/gardener-extensions/pkg/controller/operatingsystemconfig/oscommon/template"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CloudInitGenerator", func() {
+	const filesTemplate = `{{ range $_, $file := .Files -}}
+{{ $file.Path }} {{ $file.Permissions }} {{ $file.Owner }} {{ $file.TransmitUnencoded }} {{ $file.Content }}
+{{ end -}}`
+
+	var (
+		generator *templategenerator.CloudInitGenerator
+		perm      = int32(0600)
+	)
+
+	BeforeEach(func() {
+		generator = templategenerator.NewCloudInitGenerator(template.Must(template.New("cloud-init").Parse(filesTemplate)), templategenerator.DefaultUnitsPath, "reload %s")
+	})
+
+	Describe("#Generate", func() {
+		It("should encode the file content by default", func() {
+			data, _, err := generator.Generate(&commonosgenerator.OperatingSystemConfig{
+				Files: []*commonosgenerator.File{{Path: "/foo", Content: []byte("bar"), Permissions: &perm}},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal("/foo 0600  false YmFy\n"))
+		})
+
+		It("should pass the owner and quote the content if it is transmitted unencoded", func() {
+			data, _, err := generator.Generate(&commonosgenerator.OperatingSystemConfig{
+				Files: []*commonosgenerator.File{{Path: "/foo", Content: []byte("bar\nbaz"), Permissions: &perm, Owner: "root:root", TransmitUnencoded: true}},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal("/foo 0600 root:root true \"bar\\nbaz\"\n"))
+		})
+
+		It("should return the reload command if a path is given", func() {
+			path := "/var/lib/config"
+			_, command, err := generator.Generate(&commonosgenerator.OperatingSystemConfig{Path: &path})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(command).NotTo(BeNil())
+			Expect(*command).To(Equal("reload /var/lib/config"))
+		})
+	})
+})
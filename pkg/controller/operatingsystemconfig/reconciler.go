@@ -18,9 +18,11 @@ import (
 	"context"
 	"fmt"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"time"
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -83,7 +85,7 @@ func (r *reconciler) InjectStopChannel(stopCh <-chan struct{}) error {
 
 // Reconcile is the reconciler function that gets executed in case there are new events for the `OperatingSystemConfig`
 // resources.
-func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+func (r *reconciler) Reconcile(request reconcile.Request) (result reconcile.Result, err error) {
 	osc := &extensionsv1alpha1.OperatingSystemConfig{}
 	if err := r.client.Get(r.ctx, request.NamespacedName, osc); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -93,6 +95,8 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
+	defer func(start time.Time) { metrics.ObserveReconcile(osc, start, err) }(time.Now())
+
 	if osc.DeletionTimestamp != nil {
 		return r.delete(r.ctx, osc)
 	}
@@ -110,7 +114,9 @@ func (r *reconciler) reconcile(ctx context.Context, osc *extensionsv1alpha1.Oper
 	}
 
 	r.logger.Info("Starting the reconciliation of operating system config", "osc", osc.Name)
+	start := time.Now()
 	userData, command, units, err := r.actuator.Reconcile(ctx, osc)
+	metrics.ObserveActuatorOperation(osc, metrics.OperationReconcile, start, err)
 	if err != nil {
 		msg := "Error reconciling operating system config"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), osc, operationType, msg))
@@ -170,7 +176,10 @@ func (r *reconciler) delete(ctx context.Context, osc *extensionsv1alpha1.Operati
 	}
 
 	r.logger.Info("Starting the deletion of operating system config", "osc", osc.Name)
-	if err := r.actuator.Delete(ctx, osc); err != nil {
+	start := time.Now()
+	err = r.actuator.Delete(ctx, osc)
+	metrics.ObserveActuatorOperation(osc, metrics.OperationDelete, start, err)
+	if err != nil {
 		msg := "Error deleting operating system config"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), osc, operationType, msg))
 		r.logger.Error(err, msg, "osc", osc.Name)
@@ -19,25 +19,42 @@ import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 )
 
-// GetPodNetwork returns the pod network CIDR of the given Shoot.
-func GetPodNetwork(shoot *gardenv1beta1.Shoot) gardencorev1alpha1.CIDR {
+// getK8SNetworks returns the Kubernetes networks of the given Shoot, or nil if the Shoot has no cloud
+// configuration.
+func getK8SNetworks(shoot *gardenv1beta1.Shoot) *gardencorev1alpha1.K8SNetworks {
 	cloud := shoot.Spec.Cloud
 	switch {
 	case cloud.AWS != nil:
-		return *cloud.AWS.Networks.K8SNetworks.Pods
+		return &cloud.AWS.Networks.K8SNetworks
 	case cloud.Azure != nil:
-		return *cloud.Azure.Networks.K8SNetworks.Pods
+		return &cloud.Azure.Networks.K8SNetworks
 	case cloud.GCP != nil:
-		return *cloud.GCP.Networks.K8SNetworks.Pods
+		return &cloud.GCP.Networks.K8SNetworks
 	case cloud.OpenStack != nil:
-		return *cloud.OpenStack.Networks.K8SNetworks.Pods
+		return &cloud.OpenStack.Networks.K8SNetworks
 	case cloud.Alicloud != nil:
-		return *cloud.Alicloud.Networks.K8SNetworks.Pods
+		return &cloud.Alicloud.Networks.K8SNetworks
 	case cloud.Packet != nil:
-		return *cloud.Packet.Networks.K8SNetworks.Pods
+		return &cloud.Packet.Networks.K8SNetworks
 	default:
-		return ""
+		return nil
+	}
+}
+
+// GetPodNetwork returns the pod network CIDR of the given Shoot, or an empty CIDR if it is not set.
+func GetPodNetwork(shoot *gardenv1beta1.Shoot) gardencorev1alpha1.CIDR {
+	if networks := getK8SNetworks(shoot); networks != nil && networks.Pods != nil {
+		return *networks.Pods
+	}
+	return ""
+}
+
+// GetServiceNetwork returns the service network CIDR of the given Shoot, or an empty CIDR if it is not set.
+func GetServiceNetwork(shoot *gardenv1beta1.Shoot) gardencorev1alpha1.CIDR {
+	if networks := getK8SNetworks(shoot); networks != nil && networks.Services != nil {
+		return *networks.Services
 	}
+	return ""
 }
 
 // IsHibernated returns true if the shoot is hibernated, or false otherwise.
@@ -76,6 +76,86 @@ var _ = Describe("Shoot", func() {
 				},
 			},
 		}, cidr),
+
+		Entry("cloud is Packet", gardenv1beta1.Cloud{
+			Packet: &gardenv1beta1.PacketCloud{
+				Networks: gardenv1beta1.PacketNetworks{
+					K8SNetworks: gardencorev1alpha1.K8SNetworks{Pods: &cidr},
+				},
+			},
+		}, cidr),
+
+		Entry("network is not set", gardenv1beta1.Cloud{
+			AWS: &gardenv1beta1.AWSCloud{},
+		}, gardencorev1alpha1.CIDR("")),
+
+		Entry("cloud is not set", gardenv1beta1.Cloud{}, gardencorev1alpha1.CIDR("")),
+	)
+
+	DescribeTable("#GetServiceNetwork",
+		func(cloud gardenv1beta1.Cloud, cidr gardencorev1alpha1.CIDR) {
+			shoot := &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: cloud,
+				},
+			}
+
+			Expect(GetServiceNetwork(shoot)).To(Equal(cidr))
+		},
+
+		Entry("cloud is AWS", gardenv1beta1.Cloud{
+			AWS: &gardenv1beta1.AWSCloud{
+				Networks: gardenv1beta1.AWSNetworks{
+					K8SNetworks: gardencorev1alpha1.K8SNetworks{Services: &cidr},
+				},
+			},
+		}, cidr),
+
+		Entry("cloud is Azure", gardenv1beta1.Cloud{
+			Azure: &gardenv1beta1.AzureCloud{
+				Networks: gardenv1beta1.AzureNetworks{
+					K8SNetworks: gardencorev1alpha1.K8SNetworks{Services: &cidr},
+				},
+			},
+		}, cidr),
+
+		Entry("cloud is GCP", gardenv1beta1.Cloud{
+			GCP: &gardenv1beta1.GCPCloud{
+				Networks: gardenv1beta1.GCPNetworks{
+					K8SNetworks: gardencorev1alpha1.K8SNetworks{Services: &cidr},
+				},
+			},
+		}, cidr),
+
+		Entry("cloud is OpenStack", gardenv1beta1.Cloud{
+			OpenStack: &gardenv1beta1.OpenStackCloud{
+				Networks: gardenv1beta1.OpenStackNetworks{
+					K8SNetworks: gardencorev1alpha1.K8SNetworks{Services: &cidr},
+				},
+			},
+		}, cidr),
+
+		Entry("cloud is Alicloud", gardenv1beta1.Cloud{
+			Alicloud: &gardenv1beta1.Alicloud{
+				Networks: gardenv1beta1.AlicloudNetworks{
+					K8SNetworks: gardencorev1alpha1.K8SNetworks{Services: &cidr},
+				},
+			},
+		}, cidr),
+
+		Entry("cloud is Packet", gardenv1beta1.Cloud{
+			Packet: &gardenv1beta1.PacketCloud{
+				Networks: gardenv1beta1.PacketNetworks{
+					K8SNetworks: gardencorev1alpha1.K8SNetworks{Services: &cidr},
+				},
+			},
+		}, cidr),
+
+		Entry("network is not set", gardenv1beta1.Cloud{
+			AWS: &gardenv1beta1.AWSCloud{},
+		}, gardencorev1alpha1.CIDR("")),
+
+		Entry("cloud is not set", gardenv1beta1.Cloud{}, gardencorev1alpha1.CIDR("")),
 	)
 
 	DescribeTable("#IsHibernated",
@@ -18,6 +18,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -78,6 +79,11 @@ func add(mgr manager.Manager, options controller.Options, predicates []predicate
 	}); err != nil {
 		return err
 	}
+	if err := ctrl.Watch(&source.Kind{Type: &machinev1alpha1.MachineDeployment{}}, &extensionshandler.EnqueueRequestsFromMapFunc{
+		ToRequests: extensionshandler.SimpleMapper(MachineDeploymentToWorkerMapper(mgr.GetClient(), predicates), extensionshandler.UpdateWithNew),
+	}); err != nil {
+		return err
+	}
 	return ctrl.Watch(
 		&source.Kind{Type: &extensionsv1alpha1.Cluster{}},
 		&extensionshandler.EnqueueRequestsFromMapFunc{
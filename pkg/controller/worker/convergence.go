@@ -0,0 +1,88 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+)
+
+const (
+	// ConditionTypeMachinesConverged is the type of the condition that reports whether the machines of a Worker
+	// have converged to their desired state. While they are converging, the time of the last transition marks the
+	// start of the wait.
+	ConditionTypeMachinesConverged gardencorev1alpha1.ConditionType = "MachinesConverged"
+
+	// ConditionReasonRolloutInProgress is the reason of the converged condition while the machine deployments
+	// are rolled out.
+	ConditionReasonRolloutInProgress = "RolloutInProgress"
+	// ConditionReasonDeletionInProgress is the reason of the converged condition while the machine resources
+	// are deleted.
+	ConditionReasonDeletionInProgress = "DeletionInProgress"
+	// ConditionReasonConverged is the reason of the converged condition if the machines have converged.
+	ConditionReasonConverged = "Converged"
+	// ConditionReasonFailed is the reason of the converged condition if the machines failed to converge.
+	ConditionReasonFailed = "Failed"
+)
+
+func getOrInitCondition(conditions []gardencorev1alpha1.Condition, conditionType gardencorev1alpha1.ConditionType) gardencorev1alpha1.Condition {
+	if condition := gardencorev1alpha1helper.GetCondition(conditions, conditionType); condition != nil {
+		return *condition
+	}
+	return gardencorev1alpha1helper.InitCondition(conditionType)
+}
+
+func convergedCondition(conditions []gardencorev1alpha1.Condition) gardencorev1alpha1.Condition {
+	return getOrInitCondition(conditions, ConditionTypeMachinesConverged)
+}
+
+// ConvergingCondition computes the converged condition for machines that are still converging for the given
+// reason, i.e. ConditionReasonRolloutInProgress or ConditionReasonDeletionInProgress. The wait starts anew if the
+// machines were not converging for the same reason before.
+func ConvergingCondition(conditions []gardencorev1alpha1.Condition, reason, message string) gardencorev1alpha1.Condition {
+	oldCondition := convergedCondition(conditions)
+
+	condition := gardencorev1alpha1helper.UpdatedCondition(oldCondition, gardencorev1alpha1.ConditionFalse, reason, message)
+	if oldCondition.Reason != reason {
+		condition.LastTransitionTime = condition.LastUpdateTime
+	}
+	return condition
+}
+
+// ConvergedCondition computes the converged condition for machines that have converged.
+func ConvergedCondition(conditions []gardencorev1alpha1.Condition, message string) gardencorev1alpha1.Condition {
+	return gardencorev1alpha1helper.UpdatedCondition(convergedCondition(conditions), gardencorev1alpha1.ConditionTrue, ConditionReasonConverged, message)
+}
+
+// FailedCondition computes the converged condition for machines that failed to converge.
+func FailedCondition(conditions []gardencorev1alpha1.Condition, message string) gardencorev1alpha1.Condition {
+	return gardencorev1alpha1helper.UpdatedCondition(convergedCondition(conditions), gardencorev1alpha1.ConditionFalse, ConditionReasonFailed, message)
+}
+
+// IsConverging returns true if the given conditions report that the machines are converging.
+func IsConverging(conditions []gardencorev1alpha1.Condition) bool {
+	condition := gardencorev1alpha1helper.GetCondition(conditions, ConditionTypeMachinesConverged)
+	return condition != nil &&
+		condition.Status == gardencorev1alpha1.ConditionFalse &&
+		(condition.Reason == ConditionReasonRolloutInProgress || condition.Reason == ConditionReasonDeletionInProgress)
+}
+
+// ConvergenceTimedOut returns true if the machines of the given converging condition have been converging for
+// longer than the given timeout. A timeout of zero never expires.
+func ConvergenceTimedOut(condition gardencorev1alpha1.Condition, timeout time.Duration, now time.Time) bool {
+	return timeout > 0 && now.Sub(condition.LastTransitionTime.Time) > timeout
+}
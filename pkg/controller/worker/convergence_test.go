@@ -0,0 +1,135 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Convergence", func() {
+	var (
+		start = metav1.NewTime(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		convergingCondition = func(reason string) gardencorev1alpha1.Condition {
+			return gardencorev1alpha1.Condition{
+				Type:               worker.ConditionTypeMachinesConverged,
+				Status:             gardencorev1alpha1.ConditionFalse,
+				Reason:             reason,
+				LastTransitionTime: start,
+				LastUpdateTime:     start,
+			}
+		}
+	)
+
+	Describe("#ConvergingCondition", func() {
+		It("should start the wait if there is no condition", func() {
+			condition := worker.ConvergingCondition(nil, worker.ConditionReasonRolloutInProgress, "foo")
+
+			Expect(condition.Type).To(Equal(worker.ConditionTypeMachinesConverged))
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(worker.ConditionReasonRolloutInProgress))
+			Expect(condition.Message).To(Equal("foo"))
+			Expect(condition.LastTransitionTime.After(start.Time)).To(BeTrue())
+		})
+
+		It("should keep the start of the wait if the machines are converging for the same reason", func() {
+			conditions := []gardencorev1alpha1.Condition{convergingCondition(worker.ConditionReasonRolloutInProgress)}
+
+			condition := worker.ConvergingCondition(conditions, worker.ConditionReasonRolloutInProgress, "bar")
+
+			Expect(condition.Message).To(Equal("bar"))
+			Expect(condition.LastTransitionTime).To(Equal(start))
+			Expect(condition.LastUpdateTime.After(start.Time)).To(BeTrue())
+		})
+
+		It("should start the wait anew if the machines were converging for a different reason", func() {
+			conditions := []gardencorev1alpha1.Condition{convergingCondition(worker.ConditionReasonRolloutInProgress)}
+
+			condition := worker.ConvergingCondition(conditions, worker.ConditionReasonDeletionInProgress, "bar")
+
+			Expect(condition.Reason).To(Equal(worker.ConditionReasonDeletionInProgress))
+			Expect(condition.LastTransitionTime.After(start.Time)).To(BeTrue())
+		})
+
+		It("should start the wait anew if the machines failed to converge before", func() {
+			conditions := []gardencorev1alpha1.Condition{convergingCondition(worker.ConditionReasonFailed)}
+
+			condition := worker.ConvergingCondition(conditions, worker.ConditionReasonRolloutInProgress, "bar")
+
+			Expect(condition.LastTransitionTime.After(start.Time)).To(BeTrue())
+		})
+	})
+
+	Describe("#ConvergedCondition", func() {
+		It("should report that the machines have converged", func() {
+			conditions := []gardencorev1alpha1.Condition{convergingCondition(worker.ConditionReasonRolloutInProgress)}
+
+			condition := worker.ConvergedCondition(conditions, "foo")
+
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(worker.ConditionReasonConverged))
+			Expect(condition.Message).To(Equal("foo"))
+		})
+	})
+
+	Describe("#IsConverging", func() {
+		It("should return false if there is no condition", func() {
+			Expect(worker.IsConverging(nil)).To(BeFalse())
+		})
+
+		It("should return true if the machines are rolled out", func() {
+			Expect(worker.IsConverging([]gardencorev1alpha1.Condition{convergingCondition(worker.ConditionReasonRolloutInProgress)})).To(BeTrue())
+		})
+
+		It("should return true if the machine resources are deleted", func() {
+			Expect(worker.IsConverging([]gardencorev1alpha1.Condition{convergingCondition(worker.ConditionReasonDeletionInProgress)})).To(BeTrue())
+		})
+
+		It("should return false if the machines failed to converge", func() {
+			conditions := []gardencorev1alpha1.Condition{worker.FailedCondition(nil, "foo")}
+			Expect(worker.IsConverging(conditions)).To(BeFalse())
+		})
+
+		It("should return false if the machines have converged", func() {
+			conditions := []gardencorev1alpha1.Condition{worker.ConvergedCondition(nil, "foo")}
+			Expect(worker.IsConverging(conditions)).To(BeFalse())
+		})
+	})
+
+	Describe("#ConvergenceTimedOut", func() {
+		condition := convergingCondition(worker.ConditionReasonRolloutInProgress)
+
+		It("should return false if the timeout has not expired", func() {
+			Expect(worker.ConvergenceTimedOut(condition, time.Hour, start.Add(time.Minute))).To(BeFalse())
+		})
+
+		It("should return true if the timeout has expired", func() {
+			Expect(worker.ConvergenceTimedOut(condition, time.Hour, start.Add(2*time.Hour))).To(BeTrue())
+		})
+
+		It("should never expire a zero timeout", func() {
+			Expect(worker.ConvergenceTimedOut(condition, 0, start.Add(24*time.Hour))).To(BeFalse())
+		})
+	})
+})
@@ -44,6 +44,7 @@ type genericActuator struct {
 	mcmSeedChart    util.Chart
 	mcmShootChart   util.Chart
 	imageVector     imagevector.ImageVector
+	timeouts        worker.TimeoutConfig
 
 	client            client.Client
 	clientset         kubernetes.Interface
@@ -53,8 +54,9 @@ type genericActuator struct {
 
 // NewActuator creates a new Actuator that reconciles
 // Worker resources of Gardener's `extensions.gardener.cloud` API group.
-// It provides a default implementation that allows easier integration of providers.
-func NewActuator(logger logr.Logger, delegateFactory DelegateFactory, mcmName string, mcmSeedChart, mcmShootChart util.Chart, imageVector imagevector.ImageVector) worker.Actuator {
+// It provides a default implementation that allows easier integration of providers. The given timeouts
+// limit how long the machines may take to converge.
+func NewActuator(logger logr.Logger, delegateFactory DelegateFactory, mcmName string, mcmSeedChart, mcmShootChart util.Chart, imageVector imagevector.ImageVector, timeouts worker.TimeoutConfig) worker.Actuator {
 	return &genericActuator{
 		logger: logger.WithName("worker-actuator"),
 
@@ -63,6 +65,7 @@ func NewActuator(logger logr.Logger, delegateFactory DelegateFactory, mcmName st
 		mcmSeedChart:    mcmSeedChart,
 		mcmShootChart:   mcmShootChart,
 		imageVector:     imageVector,
+		timeouts:        timeouts,
 	}
 }
 
@@ -88,7 +91,7 @@ func (a *genericActuator) InjectConfig(config *rest.Config) error {
 		return errors.Wrap(err, "could not create Gardener client")
 	}
 
-	a.chartApplier, err = gardenerkubernetes.NewChartApplierForConfig(config)
+	a.chartApplier, err = util.NewChartApplierForConfig(config)
 	if err != nil {
 		return errors.Wrap(err, "could not create chart applier")
 	}
@@ -17,14 +17,11 @@ package genericactuator
 import (
 	"context"
 	"fmt"
-	"strings"
 	"sync"
-	"time"
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
-	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
@@ -32,7 +29,6 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -89,12 +85,9 @@ func (a *genericActuator) Delete(ctx context.Context, worker *extensionsv1alpha1
 		return errors.Wrapf(err, "cleaning up machine class secrets failed")
 	}
 
-	// Wait until all machine resources have been properly deleted.
-	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Minute)
-	defer cancel()
-
-	if err := a.waitUntilMachineResourcesDeleted(timeoutCtx, worker, workerDelegate); err != nil {
-		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed while waiting for all machine resources to be deleted: '%s'", err.Error()))
+	// Check whether all machine resources have been properly deleted. The Worker is requeued until they are.
+	if err := a.requeueUntilMachineResourcesDeleted(ctx, worker, workerDelegate); err != nil {
+		return err
 	}
 
 	// Delete the machine-controller-manager.
@@ -159,92 +152,62 @@ func (a *genericActuator) markMachineForcefulDeletion(ctx context.Context, machi
 	return a.client.Update(ctx, machine)
 }
 
-// waitUntilMachineResourcesDeleted waits for a maximum of 30 minutes until all machine resources have been properly
-// deleted by the machine-controller-manager. It polls the status every 5 seconds.
-// TODO: Parallelise this?
-func (a *genericActuator) waitUntilMachineResourcesDeleted(ctx context.Context, worker *extensionsv1alpha1.Worker, workerDelegate WorkerDelegate) error {
-	var (
-		countMachines            = -1
-		countMachineSets         = -1
-		countMachineDeployments  = -1
-		countMachineClasses      = -1
-		countMachineClassSecrets = -1
-	)
+// machineResourcesDeleted checks whether all machine resources have been deleted. It returns a message describing
+// the progress.
+func (a *genericActuator) machineResourcesDeleted(ctx context.Context, worker *extensionsv1alpha1.Worker, workerDelegate WorkerDelegate) (bool, string, error) {
+	// Check whether all machines have been deleted.
+	existingMachines := &machinev1alpha1.MachineList{}
+	if err := a.client.List(ctx, client.InNamespace(worker.Namespace), existingMachines); err != nil {
+		return false, "", err
+	}
+	countMachines := len(existingMachines.Items)
 
-	return wait.PollUntil(5*time.Second, func() (bool, error) {
-		msg := ""
+	// Check whether all machine sets have been deleted.
+	existingMachineSets := &machinev1alpha1.MachineSetList{}
+	if err := a.client.List(ctx, client.InNamespace(worker.Namespace), existingMachineSets); err != nil {
+		return false, "", err
+	}
+	countMachineSets := len(existingMachineSets.Items)
 
-		// Check whether all machines have been deleted.
-		if countMachines != 0 {
-			existingMachines := &machinev1alpha1.MachineList{}
-			if err := a.client.List(ctx, client.InNamespace(worker.Namespace), existingMachines); err != nil {
-				return false, err
-			}
-			countMachines = len(existingMachines.Items)
-			msg += fmt.Sprintf("%d machines, ", countMachines)
-		}
+	// Check whether all machine deployments have been deleted.
+	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.client.List(ctx, client.InNamespace(worker.Namespace), existingMachineDeployments); err != nil {
+		return false, "", err
+	}
+	countMachineDeployments := len(existingMachineDeployments.Items)
 
-		// Check whether all machine sets have been deleted.
-		if countMachineSets != 0 {
-			existingMachineSets := &machinev1alpha1.MachineSetList{}
-			if err := a.client.List(ctx, client.InNamespace(worker.Namespace), existingMachineSets); err != nil {
-				return false, err
-			}
-			countMachineSets = len(existingMachineSets.Items)
-			msg += fmt.Sprintf("%d machine sets, ", countMachineSets)
-		}
-
-		// Check whether all machine deployments have been deleted.
-		if countMachineDeployments != 0 {
-			existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
-			if err := a.client.List(ctx, client.InNamespace(worker.Namespace), existingMachineDeployments); err != nil {
-				return false, err
-			}
-			countMachineDeployments = len(existingMachineDeployments.Items)
-			msg += fmt.Sprintf("%d machine deployments, ", countMachineDeployments)
-
-			// Check whether an operation failed during the deletion process.
-			for _, existingMachineDeployment := range existingMachineDeployments.Items {
-				for _, failedMachine := range existingMachineDeployment.Status.FailedMachines {
-					return false, fmt.Errorf("Machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description)
-				}
-			}
+	// Check whether an operation failed during the deletion process.
+	for _, existingMachineDeployment := range existingMachineDeployments.Items {
+		for _, failedMachine := range existingMachineDeployment.Status.FailedMachines {
+			return false, "", fmt.Errorf("Machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description)
 		}
+	}
 
-		// Check whether all machine classes have been deleted.
-		if countMachineClasses != 0 {
-			machineClassList := workerDelegate.MachineClassList()
-			if err := a.client.List(ctx, client.InNamespace(worker.Namespace), machineClassList); err != nil {
-				return false, err
-			}
-			machineClasses, err := meta.ExtractList(machineClassList)
-			if err != nil {
-				return false, err
-			}
-			countMachineClasses = len(machineClasses)
-			msg += fmt.Sprintf("%d machine classes, ", countMachineClasses)
-		}
+	// Check whether all machine classes have been deleted.
+	machineClassList := workerDelegate.MachineClassList()
+	if err := a.client.List(ctx, client.InNamespace(worker.Namespace), machineClassList); err != nil {
+		return false, "", err
+	}
+	machineClasses, err := meta.ExtractList(machineClassList)
+	if err != nil {
+		return false, "", err
+	}
+	countMachineClasses := len(machineClasses)
 
-		// Check whether all machine class secrets have been deleted.
-		if countMachineClassSecrets != 0 {
-			count := 0
-			existingMachineClassSecrets, err := a.listMachineClassSecrets(ctx, worker.Namespace)
-			if err != nil {
-				return false, err
-			}
-			for _, machineClassSecret := range existingMachineClassSecrets.Items {
-				if len(machineClassSecret.Finalizers) != 0 {
-					count++
-				}
-			}
-			countMachineClassSecrets = count
-			msg += fmt.Sprintf("%d machine class secrets, ", countMachineClassSecrets)
+	// Check whether all machine class secrets have been deleted.
+	existingMachineClassSecrets, err := a.listMachineClassSecrets(ctx, worker.Namespace)
+	if err != nil {
+		return false, "", err
+	}
+	countMachineClassSecrets := 0
+	for _, machineClassSecret := range existingMachineClassSecrets.Items {
+		if len(machineClassSecret.Finalizers) != 0 {
+			countMachineClassSecrets++
 		}
+	}
 
-		if countMachines != 0 || countMachineSets != 0 || countMachineDeployments != 0 || countMachineClasses != 0 || countMachineClassSecrets != 0 {
-			a.logger.Info(fmt.Sprintf("Waiting until the following machine resources have been processed: %s", strings.TrimSuffix(msg, ", ")), "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-			return false, nil
-		}
-		return true, nil
-	}, ctx.Done())
+	if countMachines != 0 || countMachineSets != 0 || countMachineDeployments != 0 || countMachineClasses != 0 || countMachineClassSecrets != 0 {
+		return false, fmt.Sprintf("Waiting until the following machine resources have been processed: %d machines, %d machine sets, %d machine deployments, %d machine classes, %d machine class secrets", countMachines, countMachineSets, countMachineDeployments, countMachineClasses, countMachineClassSecrets), nil
+	}
+	return true, "All machine resources have been deleted.", nil
 }
@@ -17,26 +17,24 @@ package genericactuator
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
-	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+	extensionsworker "github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	"github.com/pkg/errors"
 
-	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -65,30 +63,12 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 		return err
 	}
 
-	// During the time a rolling update happens we do not want the cluster autoscaler to interfer, hence it
-	// is removed for now.
+	// During the time a rolling update happens we do not want the cluster autoscaler to interfere with the
+	// machine deployments of the affected pools, hence their scale-down is disabled until the machines have converged.
 	clusterAutoscalerRequired := extensionsv1alpha1helper.ClusterAutoscalerRequired(worker.Spec.Pools)
+	scaleDownDisabledPools := sets.NewString()
 	if clusterAutoscalerRequired {
-		rollingUpdate := false
-		// Check whether new machine classes have been computed (resulting in a rolling update of the nodes).
-		for _, machineDeployment := range wantedMachineDeployments {
-			if !existingMachineClassNames.Has(machineDeployment.ClassName) {
-				rollingUpdate = true
-				break
-			}
-		}
-
-		// When the Shoot gets hibernated we want to remove the cluster auto scaler so that it does not interfer
-		// with Gardeners modifications on the machine deployment's replicas fields.
-		if controller.IsHibernated(cluster.Shoot) || rollingUpdate {
-			deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: worker.Namespace, Name: gardencorev1alpha1.DeploymentNameClusterAutoscaler}}
-			if err := a.client.Delete(ctx, deployment); err != nil && !apierrors.IsNotFound(err) {
-				return err
-			}
-			if err := util.WaitUntilResourceDeleted(ctx, a.client, deployment, 5*time.Second); err != nil {
-				return err
-			}
-		}
+		scaleDownDisabledPools = rolloutPools(existingMachineClassNames, wantedMachineDeployments)
 	}
 
 	// Deploy generated machine classes.
@@ -105,16 +85,25 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 
 	// Generate machine deployment configuration based on previously computed list of deployments and deploy them.
 	a.logger.Info("Deploying the machine deployments", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-	if err := a.deployMachineDeployments(ctx, cluster, worker, existingMachineDeployments, wantedMachineDeployments, workerDelegate.MachineClassKind(), clusterAutoscalerRequired); err != nil {
+	if err := a.deployMachineDeployments(ctx, cluster, worker, existingMachineDeployments, wantedMachineDeployments, workerDelegate.MachineClassKind(), clusterAutoscalerRequired, scaleDownDisabledPools); err != nil {
 		return errors.Wrapf(err, "failed to generate the machine deployment config")
 	}
 
-	// Wait until all generated machine deployments are healthy/available.
-	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Minute)
-	defer cancel()
+	// Update the machine deployments in the Worker status right away so that the minimum and maximum of the worker
+	// pools used by the cluster-autoscaler are in sync with the pools.
+	if err := a.updateWorkerStatus(ctx, worker, wantedMachineDeployments); err != nil {
+		return errors.Wrapf(err, "failed to update the status in the Worker resource")
+	}
+
+	// Aggregate the state of the machines into the Worker status so that their health is visible while they converge.
+	machinesStatus, err := a.updateMachinesStatus(ctx, worker, wantedMachineDeployments)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update the machines status in the Worker resource")
+	}
 
-	if err := a.waitUntilMachineDeploymentsAvailable(timeoutCtx, cluster, worker, wantedMachineDeployments); err != nil {
-		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed while waiting for all machine deployments to be ready: '%s'", err.Error()))
+	// Check whether all generated machine deployments are healthy/available. The Worker is requeued until they are.
+	if err := a.requeueUntilMachineDeploymentsAvailable(ctx, cluster, worker, wantedMachineDeployments, machinesStatus); err != nil {
+		return err
 	}
 
 	// Delete all old machine deployments (i.e. those which were not previously computed but exist in the cluster).
@@ -141,16 +130,18 @@ func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alp
 		if err := util.ScaleDeployment(ctx, a.client, deployment, 0); err != nil {
 			return err
 		}
+		return nil
 	}
 
-	if err := a.updateWorkerStatus(ctx, worker, wantedMachineDeployments); err != nil {
-		return errors.Wrapf(err, "failed to update the status in the Worker resource")
+	// Let the cluster-autoscaler scale down the machine deployments of pools that have been rolled out again.
+	if err := a.enableScaleDown(ctx, worker, wantedMachineDeployments); err != nil {
+		return errors.Wrapf(err, "failed to enable the scale-down of the machine deployments")
 	}
 
 	return nil
 }
 
-func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments worker.MachineDeployments, classKind string, clusterAutoscalerRequired bool) error {
+func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments extensionsworker.MachineDeployments, classKind string, clusterAutoscalerRequired bool, scaleDownDisabledPools sets.String) error {
 	for _, deployment := range wantedMachineDeployments {
 		var (
 			labels                    = map[string]string{"name": deployment.Name}
@@ -158,6 +149,11 @@ func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster
 			replicas                  int
 		)
 
+		minReadySeconds, strategy, nodeAnnotations, err := machineDeploymentRollout(deployment)
+		if err != nil {
+			return errors.Wrapf(err, "invalid rollout settings for machine deployment %s", deployment.Name)
+		}
+
 		switch {
 		// If the Shoot is hibernated then the machine deployment's replicas should be zero.
 		case controller.IsHibernated(cluster.Shoot):
@@ -192,6 +188,16 @@ func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster
 			}
 		}
 
+		// Describe the nodes of the machine deployment to the cluster-autoscaler so that it can scale it from zero.
+		var machineType *gardenv1beta1.MachineType
+		for _, pool := range worker.Spec.Pools {
+			if pool.Name == deployment.PoolName {
+				machineType = extensionsworker.FindMachineType(cluster.CloudProfile, pool.MachineType)
+				break
+			}
+		}
+		nodeTemplateAnnotations := extensionsworker.NodeTemplateAnnotations(machineType, deployment.Labels, deployment.Taints)
+
 		machineDeployment := &machinev1alpha1.MachineDeployment{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      deployment.Name,
@@ -200,16 +206,19 @@ func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster
 		}
 
 		if err := controller.CreateOrUpdate(ctx, a.client, machineDeployment, func() error {
+			machineDeployment.Annotations = extensionsworker.MergeNodeTemplateAnnotations(machineDeployment.Annotations, nodeTemplateAnnotations)
+			// Record the pool and zone of the machine deployment so that it keeps its name if the zones of the pool
+			// change, see extensionsworker.DeploymentNames.
+			machineDeployment.Annotations[extensionsworker.PoolAnnotation] = deployment.PoolName
+			machineDeployment.Annotations[extensionsworker.ZoneAnnotation] = deployment.Zone
+			// The annotation is removed by enableScaleDown once the machines of the pool have converged.
+			if scaleDownDisabledPools.Has(deployment.PoolName) {
+				machineDeployment.Annotations[ScaleDownDisabledAnnotation] = "true"
+			}
 			machineDeployment.Spec = machinev1alpha1.MachineDeploymentSpec{
 				Replicas:        int32(replicas),
-				MinReadySeconds: 500,
-				Strategy: machinev1alpha1.MachineDeploymentStrategy{
-					Type: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType,
-					RollingUpdate: &machinev1alpha1.RollingUpdateMachineDeployment{
-						MaxSurge:       &deployment.MaxSurge,
-						MaxUnavailable: &deployment.MaxUnavailable,
-					},
-				},
+				MinReadySeconds: minReadySeconds,
+				Strategy:        strategy,
 				Selector: &metav1.LabelSelector{
 					MatchLabels: labels,
 				},
@@ -224,7 +233,7 @@ func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster
 						},
 						NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
 							ObjectMeta: metav1.ObjectMeta{
-								Annotations: deployment.Annotations,
+								Annotations: nodeAnnotations,
 								Labels:      deployment.Labels,
 							},
 							Spec: corev1.NodeSpec{
@@ -244,64 +253,115 @@ func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster
 	return nil
 }
 
-// waitUntilMachineDeploymentsAvailable waits for a maximum of 30 minutes until all the desired <machineDeployments>
-// were marked as healthy/available by the machine-controller-manager. It polls the status every 5 seconds.
-func (a *genericActuator) waitUntilMachineDeploymentsAvailable(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, wantedMachineDeployments worker.MachineDeployments) error {
-	return wait.PollUntil(5*time.Second, func() (bool, error) {
-		var numHealthyDeployments, numUpdated, numDesired, numberOfAwakeMachines int32
+// machineDeploymentRollout returns the min ready seconds and the strategy of the given machine deployment, as defined
+// by the rollout annotations of its worker pool, together with the annotations for its nodes.
+func machineDeploymentRollout(deployment extensionsworker.MachineDeployment) (int32, machinev1alpha1.MachineDeploymentStrategy, map[string]string, error) {
+	rollout, nodeAnnotations, err := extensionsworker.RolloutFromAnnotations(deployment.Annotations)
+	if err != nil {
+		return 0, machinev1alpha1.MachineDeploymentStrategy{}, nil, err
+	}
 
-		// Get the list of all existing machine deployments
-		existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
-		if err := a.client.List(ctx, &client.ListOptions{Namespace: worker.Namespace}, existingMachineDeployments); err != nil {
-			return false, err
+	strategy := machinev1alpha1.MachineDeploymentStrategy{Type: rollout.Strategy}
+	if rollout.Strategy == machinev1alpha1.RollingUpdateMachineDeploymentStrategyType {
+		strategy.RollingUpdate = &machinev1alpha1.RollingUpdateMachineDeployment{
+			MaxSurge:       &deployment.MaxSurge,
+			MaxUnavailable: &deployment.MaxUnavailable,
 		}
+	}
 
-		// Collect the numbers of ready and desired replicas.
-		for _, existingMachineDeployment := range existingMachineDeployments.Items {
-			// If the shoot get hibernated we want to wait until all machine deployments have been deleted entirely.
-			if controller.IsHibernated(cluster.Shoot) {
-				numberOfAwakeMachines += existingMachineDeployment.Status.Replicas
-				continue
-			}
+	return rollout.MinReadySeconds, strategy, nodeAnnotations, nil
+}
 
-			// If the Shoot is not hibernated we want to wait until all machine deployments have been as many ready
-			// replicas as desired (specified in the .spec.replicas). However, if we see any error in the status of
-			// the deployment then we return it.
-			for _, failedMachine := range existingMachineDeployment.Status.FailedMachines {
-				return false, fmt.Errorf("Machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description)
-			}
+// machineDeploymentsAvailable checks whether all wanted machine deployments are available, or whether all machines
+// have been hibernated if the Shoot is hibernated. It returns a message describing the progress, or an error
+// describing the failed machines of the given machines status.
+func (a *genericActuator) machineDeploymentsAvailable(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, wantedMachineDeployments extensionsworker.MachineDeployments, machinesStatus extensionsworker.MachinesStatus) (bool, string, error) {
+	var numHealthyDeployments, numUpdated, numDesired, numberOfAwakeMachines int32
 
-			// If the Shoot is not hibernated we want to wait until all machine deployments have been as many ready
-			// replicas as desired (specified in the .spec.replicas).
-			for _, machineDeployment := range wantedMachineDeployments {
-				if machineDeployment.Name == existingMachineDeployment.Name {
-					if health.CheckMachineDeployment(&existingMachineDeployment) == nil {
-						numHealthyDeployments++
-					}
-					numDesired += existingMachineDeployment.Spec.Replicas
-					numUpdated += existingMachineDeployment.Status.UpdatedReplicas
+	// Get the list of all existing machine deployments
+	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.client.List(ctx, &client.ListOptions{Namespace: worker.Namespace}, existingMachineDeployments); err != nil {
+		return false, "", err
+	}
+
+	// If the Shoot is not hibernated and we see any failed machine then we return the failures, including those of
+	// machines that could not be created.
+	if !controller.IsHibernated(cluster.Shoot) {
+		if err := machinesStatus.FailedMachinesError(); err != nil {
+			return false, "", err
+		}
+	}
+
+	// Collect the numbers of ready and desired replicas.
+	for _, existingMachineDeployment := range existingMachineDeployments.Items {
+		// If the shoot get hibernated we want to wait until all machine deployments have been deleted entirely.
+		if controller.IsHibernated(cluster.Shoot) {
+			numberOfAwakeMachines += existingMachineDeployment.Status.Replicas
+			continue
+		}
+
+		// If the Shoot is not hibernated we want to wait until all machine deployments have been as many ready
+		// replicas as desired (specified in the .spec.replicas).
+		for _, machineDeployment := range wantedMachineDeployments {
+			if machineDeployment.Name == existingMachineDeployment.Name {
+				if health.CheckMachineDeployment(&existingMachineDeployment) == nil {
+					numHealthyDeployments++
 				}
+				numDesired += existingMachineDeployment.Spec.Replicas
+				numUpdated += existingMachineDeployment.Status.UpdatedReplicas
 			}
 		}
+	}
 
-		switch {
-		case !controller.IsHibernated(cluster.Shoot):
-			a.logger.Info(fmt.Sprintf("Waiting until all desired machines are ready (%d/%d machine objects up-to-date, %d/%d machinedeployments available)...", numUpdated, numDesired, numHealthyDeployments, len(wantedMachineDeployments)), "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-			if numUpdated >= numDesired && int(numHealthyDeployments) == len(wantedMachineDeployments) {
-				return true, nil
-			}
-		default:
-			if numberOfAwakeMachines == 0 {
-				return true, nil
-			}
-			a.logger.Info(fmt.Sprintf("Waiting until all machines have been hibernated (%d still awake)...", numberOfAwakeMachines), "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	if controller.IsHibernated(cluster.Shoot) {
+		if numberOfAwakeMachines == 0 {
+			return true, "All machines have been hibernated.", nil
 		}
+		return false, fmt.Sprintf("Waiting until all machines have been hibernated (%d still awake)...", numberOfAwakeMachines), nil
+	}
+
+	if numUpdated >= numDesired && int(numHealthyDeployments) == len(wantedMachineDeployments) {
+		return true, "All desired machines are ready.", nil
+	}
+	return false, fmt.Sprintf("Waiting until all desired machines are ready (%d/%d machine objects up-to-date, %d/%d machinedeployments available)...", numUpdated, numDesired, numHealthyDeployments, len(wantedMachineDeployments)), nil
+}
+
+// updateMachinesStatus aggregates the state of the machine deployments, machine sets and machines of the given
+// wanted machine deployments and stores it in the state and the conditions of the Worker status.
+func (a *genericActuator) updateMachinesStatus(ctx context.Context, worker *extensionsv1alpha1.Worker, wantedMachineDeployments extensionsworker.MachineDeployments) (extensionsworker.MachinesStatus, error) {
+	var (
+		existingMachineDeployments = &machinev1alpha1.MachineDeploymentList{}
+		existingMachineSets        = &machinev1alpha1.MachineSetList{}
+		existingMachines           = &machinev1alpha1.MachineList{}
+	)
+
+	if err := a.client.List(ctx, &client.ListOptions{Namespace: worker.Namespace}, existingMachineDeployments); err != nil {
+		return extensionsworker.MachinesStatus{}, err
+	}
+	if err := a.client.List(ctx, &client.ListOptions{Namespace: worker.Namespace}, existingMachineSets); err != nil {
+		return extensionsworker.MachinesStatus{}, err
+	}
+	if err := a.client.List(ctx, &client.ListOptions{Namespace: worker.Namespace}, existingMachines); err != nil {
+		return extensionsworker.MachinesStatus{}, err
+	}
+
+	machinesStatus := extensionsworker.ComputeMachinesStatus(worker.Spec.Pools, wantedMachineDeployments, existingMachineDeployments.Items, existingMachineSets.Items, existingMachines.Items)
+	state, err := machinesStatus.State()
+	if err != nil {
+		return extensionsworker.MachinesStatus{}, err
+	}
 
-		return false, nil
-	}, ctx.Done())
+	return machinesStatus, extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, worker, func() error {
+		worker.Status.State = state
+		worker.Status.Conditions = gardencorev1alpha1helper.MergeConditions(worker.Status.Conditions,
+			extensionsworker.MachinesHealthyCondition(worker.Status.Conditions, machinesStatus),
+			extensionsworker.RollingUpdateCondition(worker.Status.Conditions, machinesStatus),
+		)
+		return nil
+	})
 }
 
-func (a *genericActuator) updateWorkerStatus(ctx context.Context, worker *extensionsv1alpha1.Worker, machineDeployments worker.MachineDeployments) error {
+func (a *genericActuator) updateWorkerStatus(ctx context.Context, worker *extensionsv1alpha1.Worker, machineDeployments extensionsworker.MachineDeployments) error {
 	var statusMachineDeployments []extensionsv1alpha1.MachineDeployment
 
 	for _, machineDeployment := range machineDeployments {
@@ -0,0 +1,71 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+
+	extensionsworker "github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ScaleDownDisabledAnnotation is the annotation of a machine deployment that prevents the cluster-autoscaler from
+// scaling it down. It is set on the machine deployments of the pools that are rolled out, so that the
+// cluster-autoscaler does not interfere with the machine-controller-manager replacing their machines, while it keeps
+// scaling all other pools. The cluster-autoscaler does not run for hibernated Shoots, hence it needs no coordination
+// during the hibernation.
+const ScaleDownDisabledAnnotation = "cluster-autoscaler.kubernetes.io/scale-down-disabled"
+
+// rolloutPools returns the names of the pools whose machines are rolled out, i.e. that have a wanted machine
+// deployment with a machine class that does not exist yet.
+func rolloutPools(existingMachineClassNames sets.String, wantedMachineDeployments extensionsworker.MachineDeployments) sets.String {
+	pools := sets.NewString()
+	for _, machineDeployment := range wantedMachineDeployments {
+		if !existingMachineClassNames.Has(machineDeployment.ClassName) {
+			pools.Insert(machineDeployment.PoolName)
+		}
+	}
+	return pools
+}
+
+// enableScaleDown removes the ScaleDownDisabledAnnotation from the given machine deployments of the given Worker once
+// their machines have converged.
+func (a *genericActuator) enableScaleDown(ctx context.Context, worker *extensionsv1alpha1.Worker, wantedMachineDeployments extensionsworker.MachineDeployments) error {
+	for _, deployment := range wantedMachineDeployments {
+		machineDeployment := &machinev1alpha1.MachineDeployment{}
+		if err := a.client.Get(ctx, kutil.Key(worker.Namespace, deployment.Name), machineDeployment); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		if _, ok := machineDeployment.Annotations[ScaleDownDisabledAnnotation]; !ok {
+			continue
+		}
+
+		a.logger.Info("Enabling the scale-down of the machine deployment by the cluster-autoscaler", "machinedeployment", machineDeployment.Name)
+		delete(machineDeployment.Annotations, ScaleDownDisabledAnnotation)
+		if err := a.client.Update(ctx, machineDeployment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+
+	extensionsworker "github.com/gardener/gardener-extensions/pkg/controller/worker"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var _ = Describe("ClusterAutoscaler", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		ctx    = context.TODO()
+		worker = &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "worker"}}
+
+		wantedMachineDeployments = extensionsworker.MachineDeployments{
+			{Name: "pool-a-z1", ClassName: "pool-a-z1-1234", PoolName: "pool-a"},
+			{Name: "pool-a-z2", ClassName: "pool-a-z2-1234", PoolName: "pool-a"},
+			{Name: "pool-b-z1", ClassName: "pool-b-z1-5678", PoolName: "pool-b"},
+		}
+
+		a *genericActuator
+
+		// stored are the machine deployments as they are stored in the seed, keyed by their names.
+		stored map[string]*machinev1alpha1.MachineDeployment
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+
+		a = &genericActuator{logger: log.Log.WithName("test"), client: c}
+
+		stored = map[string]*machinev1alpha1.MachineDeployment{}
+		for _, deployment := range wantedMachineDeployments {
+			stored[deployment.Name] = &machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: deployment.Name},
+			}
+		}
+
+		c.EXPECT().Get(ctx, gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeployment{})).DoAndReturn(func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+			machineDeployment, ok := stored[key.Name]
+			if !ok {
+				return apierrors.NewNotFound(schema.GroupResource{Group: "machine.sapcloud.io", Resource: "machinedeployments"}, key.Name)
+			}
+			machineDeployment.DeepCopyInto(obj.(*machinev1alpha1.MachineDeployment))
+			return nil
+		}).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectUpdate := func(name string) {
+		c.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeployment{})).DoAndReturn(func(_ context.Context, obj runtime.Object) error {
+			machineDeployment := obj.(*machinev1alpha1.MachineDeployment)
+			Expect(machineDeployment.Name).To(Equal(name))
+			stored[name] = machineDeployment.DeepCopy()
+			return nil
+		})
+	}
+
+	Describe("#enableScaleDown", func() {
+		It("should only remove the scale-down-disabled annotation from the machine deployments that have it", func() {
+			stored["pool-a-z2"].Annotations = map[string]string{
+				ScaleDownDisabledAnnotation:     "true",
+				extensionsworker.PoolAnnotation: "pool-a",
+			}
+			expectUpdate("pool-a-z2")
+
+			Expect(a.enableScaleDown(ctx, worker, wantedMachineDeployments)).To(Succeed())
+			Expect(stored["pool-a-z2"].Annotations).To(Equal(map[string]string{extensionsworker.PoolAnnotation: "pool-a"}))
+		})
+
+		It("should ignore machine deployments that do not exist", func() {
+			delete(stored, "pool-b-z1")
+
+			Expect(a.enableScaleDown(ctx, worker, wantedMachineDeployments)).To(Succeed())
+		})
+
+		It("should fail if a machine deployment cannot be updated", func() {
+			stored["pool-b-z1"].Annotations = map[string]string{ScaleDownDisabledAnnotation: "true"}
+			c.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeployment{})).Return(apierrors.NewConflict(schema.GroupResource{}, "pool-b-z1", nil))
+
+			Expect(a.enableScaleDown(ctx, worker, wantedMachineDeployments)).NotTo(Succeed())
+			Expect(stored["pool-b-z1"].Annotations).To(HaveKey(ScaleDownDisabledAnnotation))
+		})
+	})
+
+	DescribeTable("#rolloutPools",
+		func(existingMachineClassNames []string, expected []string) {
+			Expect(rolloutPools(sets.NewString(existingMachineClassNames...), wantedMachineDeployments)).To(Equal(sets.NewString(expected...)))
+		},
+
+		Entry("no rollout", []string{"pool-a-z1-1234", "pool-a-z2-1234", "pool-b-z1-5678"}, nil),
+		Entry("rollout of a pool", []string{"pool-a-z1-1234", "pool-a-z2-1234", "pool-b-z1-0000"}, []string{"pool-b"}),
+		Entry("rollout of a single zone of a pool", []string{"pool-a-z1-0000", "pool-a-z2-1234", "pool-b-z1-5678"}, []string{"pool-a"}),
+		Entry("initial creation", nil, []string{"pool-a", "pool-b"}),
+	)
+})
@@ -0,0 +1,125 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
+	extensionsworker "github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/client-go/util/retry"
+)
+
+// RequeueInterval is the interval in which the machines of a Worker are checked again while they are converging.
+// Changes of its machine deployments trigger an earlier check.
+const RequeueInterval = 30 * time.Second
+
+func (a *genericActuator) requeueUntilMachineDeploymentsAvailable(ctx context.Context, cluster *extensionscontroller.Cluster, worker *extensionsv1alpha1.Worker, wantedMachineDeployments extensionsworker.MachineDeployments, machinesStatus extensionsworker.MachinesStatus) error {
+	return a.requeueUntilConverged(
+		ctx,
+		worker,
+		extensionsworker.ConditionReasonRolloutInProgress,
+		a.timeouts.RolloutTimeout,
+		"Failed while waiting for all machine deployments to be ready",
+		func() (bool, string, error) {
+			return a.machineDeploymentsAvailable(ctx, cluster, worker, wantedMachineDeployments, machinesStatus)
+		},
+		func(start time.Time, err error) {
+			metrics.ObserveMachineDeploymentsWait(worker.Spec.Type, start, err)
+		},
+	)
+}
+
+func (a *genericActuator) requeueUntilMachineResourcesDeleted(ctx context.Context, worker *extensionsv1alpha1.Worker, workerDelegate WorkerDelegate) error {
+	return a.requeueUntilConverged(
+		ctx,
+		worker,
+		extensionsworker.ConditionReasonDeletionInProgress,
+		a.timeouts.DeletionTimeout,
+		"Failed while waiting for all machine resources to be deleted",
+		func() (bool, string, error) {
+			return a.machineResourcesDeleted(ctx, worker, workerDelegate)
+		},
+		nil,
+	)
+}
+
+// requeueUntilConverged checks whether the machines of the given Worker have converged and records the result in
+// its MachinesConverged condition. Instead of blocking until they have converged, it returns a RequeueAfterError
+// without cause while they are converging for the given reason. It returns an error if they failed to converge or
+// did not converge within the given timeout. The optional observe function is called with the start of the wait
+// once the machines have converged or failed to converge.
+func (a *genericActuator) requeueUntilConverged(
+	ctx context.Context,
+	worker *extensionsv1alpha1.Worker,
+	reason string,
+	timeout time.Duration,
+	failureMessage string,
+	check func() (bool, string, error),
+	observe func(time.Time, error),
+) error {
+	start := time.Now()
+	if extensionsworker.IsConverging(worker.Status.Conditions) {
+		if oldCondition := gardencorev1alpha1helper.GetCondition(worker.Status.Conditions, extensionsworker.ConditionTypeMachinesConverged); oldCondition.Reason == reason {
+			start = oldCondition.LastTransitionTime.Time
+		}
+	}
+
+	var (
+		converged, message, err = check()
+		condition               gardencorev1alpha1.Condition
+	)
+
+	switch {
+	case err != nil:
+		condition = extensionsworker.FailedCondition(worker.Status.Conditions, err.Error())
+	case converged:
+		condition = extensionsworker.ConvergedCondition(worker.Status.Conditions, message)
+	default:
+		condition = extensionsworker.ConvergingCondition(worker.Status.Conditions, reason, message)
+		if extensionsworker.ConvergenceTimedOut(condition, timeout, time.Now()) {
+			err = fmt.Errorf("timed out after %s: %s", timeout, message)
+			condition = extensionsworker.FailedCondition(worker.Status.Conditions, err.Error())
+		}
+	}
+
+	if updateErr := extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, worker, func() error {
+		worker.Status.Conditions = gardencorev1alpha1helper.MergeConditions(worker.Status.Conditions, condition)
+		return nil
+	}); updateErr != nil {
+		return updateErr
+	}
+
+	if (converged || err != nil) && observe != nil {
+		observe(start, err)
+	}
+
+	if err != nil {
+		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("%s: '%s'", failureMessage, err.Error()))
+	}
+	if !converged {
+		a.logger.Info(message, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		return &controllererror.RequeueAfterError{RequeueAfter: RequeueInterval}
+	}
+	return nil
+}
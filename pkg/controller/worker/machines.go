@@ -35,9 +35,12 @@ func init() {
 }
 
 // MachineDeployment holds information about the name, class, replicas of a MachineDeployment
-// managed by the machine-controller-manager.
+// managed by the machine-controller-manager. PoolName and Zone identify the worker pool and the
+// zone the machines of the MachineDeployment belong to.
 type MachineDeployment struct {
 	Name           string
+	PoolName       string
+	Zone           string
 	ClassName      string
 	SecretName     string
 	Minimum        int
@@ -20,6 +20,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 
 	extensions1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -84,3 +85,50 @@ func SecretToWorkerMapper(client client.Client, predicates []predicate.Predicate
 func ClusterToWorkerMapper(client client.Client, predicates []predicate.Predicate) handler.Mapper {
 	return extensionscontroller.ClusterToObjectMapper(client, func() runtime.Object { return &extensions1alpha1.WorkerList{} }, predicates)
 }
+
+type machineDeploymentToWorkerMapper struct {
+	client     client.Client
+	predicates []predicate.Predicate
+}
+
+func (m *machineDeploymentToWorkerMapper) Map(obj handler.MapObject) []reconcile.Request {
+	ctx := context.TODO()
+
+	if obj.Object == nil {
+		return nil
+	}
+
+	machineDeployment, ok := obj.Object.(*machinev1alpha1.MachineDeployment)
+	if !ok {
+		return nil
+	}
+
+	workerList := &extensions1alpha1.WorkerList{}
+	if err := m.client.List(ctx, client.InNamespace(machineDeployment.Namespace), workerList); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, worker := range workerList.Items {
+		if !extensionscontroller.EvalGenericPredicate(&worker, m.predicates...) {
+			continue
+		}
+
+		if IsConverging(worker.Status.Conditions) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: worker.Namespace,
+					Name:      worker.Name,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// MachineDeploymentToWorkerMapper returns a mapper that returns requests for Workers whose
+// machines are converging and whose machine deployments have been modified.
+func MachineDeploymentToWorkerMapper(client client.Client, predicates []predicate.Predicate) handler.Mapper {
+	return &machineDeploymentToWorkerMapper{client, predicates}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// NodeTemplateCPUAnnotation is the annotation of a machine deployment that tells the cluster-autoscaler the
+	// number of CPUs of its nodes so that it can scale the machine deployment from zero.
+	NodeTemplateCPUAnnotation = "capacity.cluster-autoscaler.kubernetes.io/cpu"
+	// NodeTemplateMemoryAnnotation is the annotation of a machine deployment that tells the cluster-autoscaler the
+	// amount of memory of its nodes.
+	NodeTemplateMemoryAnnotation = "capacity.cluster-autoscaler.kubernetes.io/memory"
+	// NodeTemplateGPUAnnotation is the annotation of a machine deployment that tells the cluster-autoscaler the
+	// number of GPUs of its nodes.
+	NodeTemplateGPUAnnotation = "capacity.cluster-autoscaler.kubernetes.io/gpu-count"
+	// NodeTemplateLabelsAnnotation is the annotation of a machine deployment that tells the cluster-autoscaler the
+	// labels of its nodes as comma-separated list of key=value pairs.
+	NodeTemplateLabelsAnnotation = "capacity.cluster-autoscaler.kubernetes.io/labels"
+	// NodeTemplateTaintsAnnotation is the annotation of a machine deployment that tells the cluster-autoscaler the
+	// taints of its nodes as comma-separated list of key=value:effect triples.
+	NodeTemplateTaintsAnnotation = "capacity.cluster-autoscaler.kubernetes.io/taints"
+)
+
+var nodeTemplateAnnotations = []string{
+	NodeTemplateCPUAnnotation,
+	NodeTemplateMemoryAnnotation,
+	NodeTemplateGPUAnnotation,
+	NodeTemplateLabelsAnnotation,
+	NodeTemplateTaintsAnnotation,
+}
+
+// FindMachineType returns the machine type with the given name from the given cloud profile, or nil if it is not
+// defined in the cloud profile.
+func FindMachineType(cloudProfile *gardenv1beta1.CloudProfile, name string) *gardenv1beta1.MachineType {
+	if cloudProfile == nil {
+		return nil
+	}
+
+	var machineTypes []gardenv1beta1.MachineType
+	switch spec := cloudProfile.Spec; {
+	case spec.AWS != nil:
+		machineTypes = spec.AWS.Constraints.MachineTypes
+	case spec.Azure != nil:
+		machineTypes = spec.Azure.Constraints.MachineTypes
+	case spec.GCP != nil:
+		machineTypes = spec.GCP.Constraints.MachineTypes
+	case spec.OpenStack != nil:
+		for _, machineType := range spec.OpenStack.Constraints.MachineTypes {
+			machineTypes = append(machineTypes, machineType.MachineType)
+		}
+	case spec.Alicloud != nil:
+		for _, machineType := range spec.Alicloud.Constraints.MachineTypes {
+			machineTypes = append(machineTypes, machineType.MachineType)
+		}
+	case spec.Packet != nil:
+		machineTypes = spec.Packet.Constraints.MachineTypes
+	}
+
+	for _, machineType := range machineTypes {
+		if machineType.Name == name {
+			return &machineType
+		}
+	}
+	return nil
+}
+
+// NodeTemplateAnnotations computes the annotations of a machine deployment that describe its nodes to the
+// cluster-autoscaler, i.e. the resources of the given machine type (if known) and the given labels and taints.
+func NodeTemplateAnnotations(machineType *gardenv1beta1.MachineType, labels map[string]string, taints []corev1.Taint) map[string]string {
+	annotations := map[string]string{}
+
+	if machineType != nil {
+		annotations[NodeTemplateCPUAnnotation] = machineType.CPU.String()
+		annotations[NodeTemplateMemoryAnnotation] = machineType.Memory.String()
+		if !machineType.GPU.IsZero() {
+			annotations[NodeTemplateGPUAnnotation] = machineType.GPU.String()
+		}
+	}
+
+	if len(labels) > 0 {
+		pairs := make([]string, 0, len(labels))
+		for key, value := range labels {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", key, value))
+		}
+		sort.Strings(pairs)
+		annotations[NodeTemplateLabelsAnnotation] = strings.Join(pairs, ",")
+	}
+
+	if len(taints) > 0 {
+		triples := make([]string, 0, len(taints))
+		for _, taint := range taints {
+			triples = append(triples, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+		}
+		annotations[NodeTemplateTaintsAnnotation] = strings.Join(triples, ",")
+	}
+
+	return annotations
+}
+
+// MergeNodeTemplateAnnotations replaces the node template annotations in the given annotations of a machine
+// deployment with the given node template annotations. All other annotations are kept.
+func MergeNodeTemplateAnnotations(annotations, nodeTemplate map[string]string) map[string]string {
+	merged := make(map[string]string, len(annotations)+len(nodeTemplate))
+	for key, value := range annotations {
+		merged[key] = value
+	}
+	for _, key := range nodeTemplateAnnotations {
+		delete(merged, key)
+	}
+	for key, value := range nodeTemplate {
+		merged[key] = value
+	}
+	return merged
+}
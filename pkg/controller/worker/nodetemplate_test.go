@@ -0,0 +1,119 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("NodeTemplate", func() {
+	var (
+		large = gardenv1beta1.MachineType{
+			Name:   "large",
+			CPU:    resource.MustParse("4"),
+			GPU:    resource.MustParse("0"),
+			Memory: resource.MustParse("16Gi"),
+		}
+		gpu = gardenv1beta1.MachineType{
+			Name:   "gpu",
+			CPU:    resource.MustParse("8"),
+			GPU:    resource.MustParse("2"),
+			Memory: resource.MustParse("64Gi"),
+		}
+	)
+
+	Describe("#FindMachineType", func() {
+		It("should find the machine type in the cloud profile of the provider", func() {
+			cloudProfile := &gardenv1beta1.CloudProfile{
+				Spec: gardenv1beta1.CloudProfileSpec{
+					OpenStack: &gardenv1beta1.OpenStackProfile{
+						Constraints: gardenv1beta1.OpenStackConstraints{
+							MachineTypes: []gardenv1beta1.OpenStackMachineType{{MachineType: large}, {MachineType: gpu}},
+						},
+					},
+				},
+			}
+
+			Expect(worker.FindMachineType(cloudProfile, "gpu")).To(Equal(&gpu))
+		})
+
+		It("should return nil if the machine type is unknown", func() {
+			cloudProfile := &gardenv1beta1.CloudProfile{
+				Spec: gardenv1beta1.CloudProfileSpec{
+					AWS: &gardenv1beta1.AWSProfile{
+						Constraints: gardenv1beta1.AWSConstraints{
+							MachineTypes: []gardenv1beta1.MachineType{large},
+						},
+					},
+				},
+			}
+
+			Expect(worker.FindMachineType(cloudProfile, "gpu")).To(BeNil())
+			Expect(worker.FindMachineType(nil, "large")).To(BeNil())
+		})
+	})
+
+	Describe("#NodeTemplateAnnotations", func() {
+		It("should describe the resources, labels and taints of the nodes", func() {
+			annotations := worker.NodeTemplateAnnotations(
+				&gpu,
+				map[string]string{"foo": "bar", "baz": "qux"},
+				[]corev1.Taint{{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}},
+			)
+
+			Expect(annotations).To(Equal(map[string]string{
+				worker.NodeTemplateCPUAnnotation:    "8",
+				worker.NodeTemplateMemoryAnnotation: "64Gi",
+				worker.NodeTemplateGPUAnnotation:    "2",
+				worker.NodeTemplateLabelsAnnotation: "baz=qux,foo=bar",
+				worker.NodeTemplateTaintsAnnotation: "dedicated=gpu:NoSchedule",
+			}))
+		})
+
+		It("should omit the GPUs and unknown resources", func() {
+			Expect(worker.NodeTemplateAnnotations(&large, nil, nil)).To(Equal(map[string]string{
+				worker.NodeTemplateCPUAnnotation:    "4",
+				worker.NodeTemplateMemoryAnnotation: "16Gi",
+			}))
+			Expect(worker.NodeTemplateAnnotations(nil, nil, nil)).To(BeEmpty())
+		})
+	})
+
+	Describe("#MergeNodeTemplateAnnotations", func() {
+		It("should replace the node template annotations and keep the others", func() {
+			annotations := map[string]string{
+				"deployment.kubernetes.io/revision": "2",
+				worker.NodeTemplateCPUAnnotation:    "4",
+				worker.NodeTemplateGPUAnnotation:    "2",
+			}
+
+			merged := worker.MergeNodeTemplateAnnotations(annotations, map[string]string{worker.NodeTemplateCPUAnnotation: "8"})
+
+			Expect(merged).To(Equal(map[string]string{
+				"deployment.kubernetes.io/revision": "2",
+				worker.NodeTemplateCPUAnnotation:    "8",
+			}))
+			Expect(annotations).To(HaveKey(worker.NodeTemplateGPUAnnotation))
+		})
+	})
+})
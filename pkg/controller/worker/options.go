@@ -15,6 +15,8 @@
 package worker
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 )
 
@@ -22,6 +24,17 @@ const (
 	// DeployCRDsFlag is the name of the command line flag to specify whether the worker CRDs
 	// should be deployed or not.
 	DeployCRDsFlag = "deploy-crds"
+	// RolloutTimeoutFlag is the name of the command line flag to specify how long the machines of a worker
+	// may take to roll out.
+	RolloutTimeoutFlag = "rollout-timeout"
+	// DeletionTimeoutFlag is the name of the command line flag to specify how long the machine resources of
+	// a worker may take to be deleted.
+	DeletionTimeoutFlag = "deletion-timeout"
+
+	// DefaultRolloutTimeout is the default timeout for the rollout of the machines of a worker.
+	DefaultRolloutTimeout = 30 * time.Minute
+	// DefaultDeletionTimeout is the default timeout for the deletion of the machine resources of a worker.
+	DefaultDeletionTimeout = 30 * time.Minute
 )
 
 // Options are command line options that can be set for controller.Options.
@@ -58,3 +71,48 @@ type Config struct {
 func (c *Config) Apply(ignore *bool) {
 	*ignore = c.DeployCRDs
 }
+
+// TimeoutOptions are command line options that configure how long the machines of a worker may take to converge.
+type TimeoutOptions struct {
+	// RolloutTimeout is the time the machines of a worker may take to roll out. Zero disables the timeout.
+	RolloutTimeout time.Duration
+	// DeletionTimeout is the time the machine resources of a worker may take to be deleted. Zero disables the timeout.
+	DeletionTimeout time.Duration
+
+	config *TimeoutConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (c *TimeoutOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.RolloutTimeout, RolloutTimeoutFlag, c.RolloutTimeout, "Time the machines of a worker may take to roll out. Zero disables the timeout.")
+	fs.DurationVar(&c.DeletionTimeout, DeletionTimeoutFlag, c.DeletionTimeout, "Time the machine resources of a worker may take to be deleted. Zero disables the timeout.")
+}
+
+// Complete implements Completer.Complete.
+func (c *TimeoutOptions) Complete() error {
+	c.config = &TimeoutConfig{c.RolloutTimeout, c.DeletionTimeout}
+	return nil
+}
+
+// Completed returns the completed TimeoutConfig. Only call this if `Complete` was successful.
+func (c *TimeoutOptions) Completed() *TimeoutConfig {
+	return c.config
+}
+
+// TimeoutConfig is a completed timeout configuration.
+type TimeoutConfig struct {
+	// RolloutTimeout is the time the machines of a worker may take to roll out. Zero disables the timeout.
+	RolloutTimeout time.Duration
+	// DeletionTimeout is the time the machine resources of a worker may take to be deleted. Zero disables the timeout.
+	DeletionTimeout time.Duration
+}
+
+// DefaultTimeoutConfig returns the TimeoutConfig with the default timeouts.
+func DefaultTimeoutConfig() TimeoutConfig {
+	return TimeoutConfig{DefaultRolloutTimeout, DefaultDeletionTimeout}
+}
+
+// Apply sets the values of this TimeoutConfig in the given TimeoutConfig.
+func (c *TimeoutConfig) Apply(config *TimeoutConfig) {
+	*config = *c
+}
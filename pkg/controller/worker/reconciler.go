@@ -17,9 +17,12 @@ package worker
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -70,7 +73,7 @@ func (r *reconciler) InjectStopChannel(stopCh <-chan struct{}) error {
 	return nil
 }
 
-func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+func (r *reconciler) Reconcile(request reconcile.Request) (result reconcile.Result, err error) {
 	worker := &extensionsv1alpha1.Worker{}
 	if err := r.client.Get(r.ctx, request.NamespacedName, worker); err != nil {
 		if errors.IsNotFound(err) {
@@ -79,6 +82,8 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
+	defer func(start time.Time) { metrics.ObserveReconcile(worker, start, err) }(time.Now())
+
 	cluster, err := extensionscontroller.GetCluster(r.ctx, r.client, worker.Namespace)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -102,7 +107,14 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		}
 
 		r.logger.Info("Starting the deletion of worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-		if err := r.actuator.Delete(r.ctx, worker, cluster); err != nil {
+		start := time.Now()
+		err = r.actuator.Delete(r.ctx, worker, cluster)
+		metrics.ObserveActuatorOperation(worker, metrics.OperationDelete, start, err)
+		if isConverging(err) {
+			r.logger.Info("Waiting until the machine resources of the worker have been deleted", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+			return extensionscontroller.ReconcileErr(err)
+		}
+		if err != nil {
 			msg := "Error deleting worker"
 			utilruntime.HandleError(r.updateStatusError(r.ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
 			r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
@@ -134,7 +146,14 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
-	if err := r.actuator.Reconcile(r.ctx, worker, cluster); err != nil {
+	start := time.Now()
+	err = r.actuator.Reconcile(r.ctx, worker, cluster)
+	metrics.ObserveActuatorOperation(worker, metrics.OperationReconcile, start, err)
+	if isConverging(err) {
+		r.logger.Info("Waiting until the machines of the worker have been rolled out", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		return extensionscontroller.ReconcileErr(err)
+	}
+	if err != nil {
 		msg := "Error reconciling worker"
 		utilruntime.HandleError(r.updateStatusError(r.ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
 		r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
@@ -150,6 +169,13 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 	return reconcile.Result{}, nil
 }
 
+// isConverging returns true if the given error is a RequeueAfterError without cause, i.e. if the actuator
+// requests to be called again as the machines are still converging. The operation is still processing then.
+func isConverging(err error) bool {
+	requeueAfter, ok := err.(*controllererror.RequeueAfterError)
+	return ok && requeueAfter.Cause == nil
+}
+
 func (r *reconciler) updateStatusProcessing(ctx context.Context, worker *extensionsv1alpha1.Worker, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, worker, func() error {
 		worker.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
@@ -0,0 +1,91 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"strconv"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+)
+
+const (
+	// MinReadySecondsAnnotation is the annotation of a worker pool that defines for how many seconds a newly created
+	// machine must be ready before it is considered available during a rollout.
+	MinReadySecondsAnnotation = "worker.extensions.gardener.cloud/min-ready-seconds"
+	// RolloutStrategyAnnotation is the annotation of a worker pool that defines how the machines of the pool are
+	// replaced during a rollout. Its value is either "RollingUpdate" (default) or "Recreate". Both strategies replace
+	// the machines; "Recreate" deletes all old machines before it creates new ones. Updating machines in place is
+	// not supported.
+	RolloutStrategyAnnotation = "worker.extensions.gardener.cloud/rollout-strategy"
+
+	// TODO: Add annotations for the drain timeout, the machine creation timeout and the machine health timeout once
+	// the MachineDeployment of the machine-controller-manager supports them.
+
+	// DefaultMinReadySeconds is the default number of seconds a newly created machine must be ready before it is
+	// considered available.
+	DefaultMinReadySeconds int32 = 500
+)
+
+// Rollout contains the settings for rolling out the machines of a machine deployment.
+type Rollout struct {
+	// MinReadySeconds is the number of seconds a newly created machine must be ready before it is considered available.
+	MinReadySeconds int32
+	// Strategy is the type of the machine deployment strategy.
+	Strategy machinev1alpha1.MachineDeploymentStrategyType
+}
+
+// RolloutFromAnnotations returns the rollout settings defined by the given worker pool annotations. It also returns
+// the annotations without the rollout and zone weights annotations, i.e. those that are meant for the nodes of the pool.
+func RolloutFromAnnotations(annotations map[string]string) (*Rollout, map[string]string, error) {
+	rollout := &Rollout{
+		MinReadySeconds: DefaultMinReadySeconds,
+		Strategy:        machinev1alpha1.RollingUpdateMachineDeploymentStrategyType,
+	}
+
+	minReadySeconds, hasMinReadySeconds := annotations[MinReadySecondsAnnotation]
+	strategy, hasStrategy := annotations[RolloutStrategyAnnotation]
+	_, hasZoneWeights := annotations[ZoneWeightsAnnotation]
+	if !hasMinReadySeconds && !hasStrategy && !hasZoneWeights {
+		return rollout, annotations, nil
+	}
+
+	if hasMinReadySeconds {
+		seconds, err := strconv.ParseInt(minReadySeconds, 10, 32)
+		if err != nil || seconds < 0 {
+			return nil, nil, fmt.Errorf("annotation %s must be a non-negative number of seconds, got %q", MinReadySecondsAnnotation, minReadySeconds)
+		}
+		rollout.MinReadySeconds = int32(seconds)
+	}
+
+	if hasStrategy {
+		switch strategyType := machinev1alpha1.MachineDeploymentStrategyType(strategy); strategyType {
+		case machinev1alpha1.RollingUpdateMachineDeploymentStrategyType, machinev1alpha1.RecreateMachineDeploymentStrategyType:
+			rollout.Strategy = strategyType
+		default:
+			return nil, nil, fmt.Errorf("annotation %s must be one of %q or %q, got %q", RolloutStrategyAnnotation,
+				machinev1alpha1.RollingUpdateMachineDeploymentStrategyType, machinev1alpha1.RecreateMachineDeploymentStrategyType, strategy)
+		}
+	}
+
+	nodeAnnotations := make(map[string]string, len(annotations))
+	for key, value := range annotations {
+		if key != MinReadySecondsAnnotation && key != RolloutStrategyAnnotation && key != ZoneWeightsAnnotation {
+			nodeAnnotations[key] = value
+		}
+	}
+
+	return rollout, nodeAnnotations, nil
+}
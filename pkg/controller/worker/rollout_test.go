@@ -0,0 +1,69 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rollout", func() {
+	DescribeTable("#RolloutFromAnnotations",
+		func(annotations map[string]string, expectedRollout *worker.Rollout, expectedNodeAnnotations map[string]string) {
+			rollout, nodeAnnotations, err := worker.RolloutFromAnnotations(annotations)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollout).To(Equal(expectedRollout))
+			Expect(nodeAnnotations).To(Equal(expectedNodeAnnotations))
+		},
+
+		Entry("no annotations", nil,
+			&worker.Rollout{MinReadySeconds: worker.DefaultMinReadySeconds, Strategy: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType},
+			nil),
+		Entry("only node annotations", map[string]string{"foo": "bar"},
+			&worker.Rollout{MinReadySeconds: worker.DefaultMinReadySeconds, Strategy: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType},
+			map[string]string{"foo": "bar"}),
+		Entry("rollout annotations",
+			map[string]string{
+				"foo":                            "bar",
+				worker.MinReadySecondsAnnotation: "1800",
+				worker.RolloutStrategyAnnotation: "Recreate",
+			},
+			&worker.Rollout{MinReadySeconds: 1800, Strategy: machinev1alpha1.RecreateMachineDeploymentStrategyType},
+			map[string]string{"foo": "bar"}),
+		Entry("zone weights annotation",
+			map[string]string{
+				"foo":                        "bar",
+				worker.ZoneWeightsAnnotation: "zone-1=2",
+			},
+			&worker.Rollout{MinReadySeconds: worker.DefaultMinReadySeconds, Strategy: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType},
+			map[string]string{"foo": "bar"}),
+	)
+
+	DescribeTable("#RolloutFromAnnotations with invalid values",
+		func(annotations map[string]string) {
+			_, _, err := worker.RolloutFromAnnotations(annotations)
+			Expect(err).To(HaveOccurred())
+		},
+
+		Entry("min ready seconds not a number", map[string]string{worker.MinReadySecondsAnnotation: "10m"}),
+		Entry("negative min ready seconds", map[string]string{worker.MinReadySecondsAnnotation: "-1"}),
+		Entry("unknown strategy", map[string]string{worker.RolloutStrategyAnnotation: "InPlace"}),
+	)
+})
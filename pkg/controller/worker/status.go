@@ -0,0 +1,282 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConditionTypeMachinesHealthy is the type of the condition that reports whether the machines and nodes of all
+	// machine deployments of a Worker are healthy.
+	ConditionTypeMachinesHealthy gardencorev1alpha1.ConditionType = "MachinesHealthy"
+	// ConditionTypeRollingUpdate is the type of the condition that reports whether a rolling update of the machines
+	// of a Worker is in progress.
+	ConditionTypeRollingUpdate gardencorev1alpha1.ConditionType = "RollingUpdate"
+
+	// ConditionReasonMachinesHealthy is the reason of the healthy condition if all machines and nodes are healthy.
+	ConditionReasonMachinesHealthy = "MachinesHealthy"
+	// ConditionReasonMachinesUnavailable is the reason of the healthy condition if not all desired machines are
+	// available or their nodes are not ready.
+	ConditionReasonMachinesUnavailable = "MachinesUnavailable"
+	// ConditionReasonMachinesFailed is the reason of the healthy condition if machines have failed.
+	ConditionReasonMachinesFailed = "MachinesFailed"
+	// ConditionReasonRollingUpdateInProgress is the reason of the rolling update condition while machine
+	// deployments are rolled out.
+	ConditionReasonRollingUpdateInProgress = "RollingUpdateInProgress"
+	// ConditionReasonNoRollingUpdate is the reason of the rolling update condition if no machine deployment is
+	// rolled out.
+	ConditionReasonNoRollingUpdate = "NoRollingUpdate"
+)
+
+// MachinesStatus is the status of the machines of a Worker, aggregated from its machine deployments, machine sets
+// and machines. As the Worker status has no dedicated fields for it, it is stored as JSON in its state. The generic
+// worker actuator overwrites the state on every reconciliation, hence `Status.State` of Workers is reserved for this
+// JSON and must not be used by the provider extensions for other data.
+type MachinesStatus struct {
+	// MachineDeployments are the statuses of the machine deployments of the Worker.
+	MachineDeployments []MachineDeploymentStatus `json:"machineDeployments"`
+}
+
+// MachineDeploymentStatus is the status of the machines of a machine deployment, i.e. of a worker pool in a zone.
+type MachineDeploymentStatus struct {
+	// Name is the name of the machine deployment.
+	Name string `json:"name"`
+	// Pool is the name of the worker pool of the machine deployment.
+	Pool string `json:"pool,omitempty"`
+	// Zone is the zone of the machine deployment.
+	Zone string `json:"zone,omitempty"`
+	// MachineClass is the name of the machine class used by the machine deployment.
+	MachineClass string `json:"machineClass,omitempty"`
+	// MachineType is the machine type of the worker pool.
+	MachineType string `json:"machineType,omitempty"`
+	// MachineImage is the name and version of the machine image of the worker pool.
+	MachineImage string `json:"machineImage,omitempty"`
+	// Desired is the number of desired machines.
+	Desired int32 `json:"desired"`
+	// Ready is the number of ready machines.
+	Ready int32 `json:"ready"`
+	// Updated is the number of machines that have the desired template.
+	Updated int32 `json:"updated"`
+	// Available is the number of available machines.
+	Available int32 `json:"available"`
+	// Unavailable is the number of unavailable machines.
+	Unavailable int32 `json:"unavailable"`
+	// ReadyNodes is the number of machines whose nodes are ready.
+	ReadyNodes int32 `json:"readyNodes"`
+	// RollingUpdate is true if a rolling update of the machines is in progress.
+	RollingUpdate bool `json:"rollingUpdate"`
+	// FailedMachines are the machines whose last operation failed.
+	FailedMachines []FailedMachine `json:"failedMachines,omitempty"`
+}
+
+// FailedMachine is a machine whose last operation failed.
+type FailedMachine struct {
+	// Name is the name of the machine.
+	Name string `json:"name"`
+	// Operation is the type of the failed operation, e.g. Create.
+	Operation machinev1alpha1.MachineOperationType `json:"operation,omitempty"`
+	// Description is the description of the failed operation as reported by the machine-controller-manager.
+	Description string `json:"description,omitempty"`
+	// LastUpdateTime is the time of the last update of the failed operation.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// Healthy returns true if all desired machines of the machine deployment are available, their nodes are ready, and
+// no machine has failed.
+func (s MachineDeploymentStatus) Healthy() bool {
+	return len(s.FailedMachines) == 0 && s.Available >= s.Desired && s.ReadyNodes >= s.Desired
+}
+
+// String returns a summary of the machine deployment status.
+func (s MachineDeploymentStatus) String() string {
+	summary := fmt.Sprintf("%s (pool %q, zone %q): %d/%d ready, %d/%d updated, %d/%d nodes ready, %d unavailable",
+		s.Name, s.Pool, s.Zone, s.Ready, s.Desired, s.Updated, s.Desired, s.ReadyNodes, s.Desired, s.Unavailable)
+	if s.RollingUpdate {
+		summary += ", rolling update in progress"
+	}
+	if len(s.FailedMachines) > 0 {
+		summary += fmt.Sprintf(", %d failed", len(s.FailedMachines))
+	}
+	return summary
+}
+
+// ComputeMachinesStatus aggregates the status of the given wanted machine deployments of the given worker pools from
+// the existing machine deployments, machine sets and machines. Machine sets and machines are assigned to a machine
+// deployment by the name label of its template.
+func ComputeMachinesStatus(
+	pools []extensionsv1alpha1.WorkerPool,
+	wantedMachineDeployments MachineDeployments,
+	machineDeployments []machinev1alpha1.MachineDeployment,
+	machineSets []machinev1alpha1.MachineSet,
+	machines []machinev1alpha1.Machine,
+) MachinesStatus {
+	status := MachinesStatus{MachineDeployments: []MachineDeploymentStatus{}}
+
+	for _, wantedMachineDeployment := range wantedMachineDeployments {
+		deploymentStatus := MachineDeploymentStatus{
+			Name:         wantedMachineDeployment.Name,
+			Pool:         wantedMachineDeployment.PoolName,
+			Zone:         wantedMachineDeployment.Zone,
+			MachineClass: wantedMachineDeployment.ClassName,
+		}
+
+		for _, pool := range pools {
+			if pool.Name == wantedMachineDeployment.PoolName {
+				deploymentStatus.MachineType = pool.MachineType
+				deploymentStatus.MachineImage = fmt.Sprintf("%s:%s", pool.MachineImage.Name, pool.MachineImage.Version)
+				break
+			}
+		}
+
+		for _, machineDeployment := range machineDeployments {
+			if machineDeployment.Name == wantedMachineDeployment.Name {
+				deploymentStatus.Desired = machineDeployment.Spec.Replicas
+				deploymentStatus.Ready = machineDeployment.Status.ReadyReplicas
+				deploymentStatus.Updated = machineDeployment.Status.UpdatedReplicas
+				deploymentStatus.Available = machineDeployment.Status.AvailableReplicas
+				deploymentStatus.Unavailable = machineDeployment.Status.UnavailableReplicas
+				break
+			}
+		}
+
+		var activeMachineSets int
+		for _, machineSet := range machineSets {
+			if machineSet.Labels["name"] == wantedMachineDeployment.Name && (machineSet.Spec.Replicas > 0 || machineSet.Status.Replicas > 0) {
+				activeMachineSets++
+			}
+		}
+		deploymentStatus.RollingUpdate = activeMachineSets > 1
+
+		for _, machine := range machines {
+			if machine.Labels["name"] != wantedMachineDeployment.Name {
+				continue
+			}
+			if machineNodeReady(machine) {
+				deploymentStatus.ReadyNodes++
+			}
+			if machine.Status.LastOperation.State == machinev1alpha1.MachineStateFailed || machine.Status.CurrentStatus.Phase == machinev1alpha1.MachineFailed {
+				deploymentStatus.FailedMachines = append(deploymentStatus.FailedMachines, FailedMachine{
+					Name:           machine.Name,
+					Operation:      machine.Status.LastOperation.Type,
+					Description:    machine.Status.LastOperation.Description,
+					LastUpdateTime: machine.Status.LastOperation.LastUpdateTime,
+				})
+			}
+		}
+		sort.Slice(deploymentStatus.FailedMachines, func(i, j int) bool {
+			return deploymentStatus.FailedMachines[i].Name < deploymentStatus.FailedMachines[j].Name
+		})
+
+		status.MachineDeployments = append(status.MachineDeployments, deploymentStatus)
+	}
+
+	return status
+}
+
+func machineNodeReady(machine machinev1alpha1.Machine) bool {
+	for _, condition := range machine.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// MachinesStatusFromState decodes the machines status from the given state of a Worker status.
+func MachinesStatusFromState(state string) (*MachinesStatus, error) {
+	status := &MachinesStatus{}
+	if err := json.Unmarshal([]byte(state), status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// State encodes the machines status for the state of a Worker status.
+func (s MachinesStatus) State() (string, error) {
+	state, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(state), nil
+}
+
+// FailedMachinesError returns an error describing all failed machines, or nil if no machine has failed. The
+// descriptions of the failed operations are kept so that error codes can be determined from them.
+func (s MachinesStatus) FailedMachinesError() error {
+	var failures []string
+	for _, deploymentStatus := range s.MachineDeployments {
+		for _, failedMachine := range deploymentStatus.FailedMachines {
+			failures = append(failures, fmt.Sprintf("Machine %s failed (operation %s): %s", failedMachine.Name, failedMachine.Operation, failedMachine.Description))
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s", strings.Join(failures, "; "))
+}
+
+// MachinesHealthyCondition computes the healthy condition for the given machines status. Its message summarizes
+// the machine deployments that are not healthy.
+func MachinesHealthyCondition(conditions []gardencorev1alpha1.Condition, status MachinesStatus) gardencorev1alpha1.Condition {
+	var (
+		reason    = ConditionReasonMachinesHealthy
+		summaries []string
+	)
+
+	for _, deploymentStatus := range status.MachineDeployments {
+		if deploymentStatus.Healthy() {
+			continue
+		}
+		summaries = append(summaries, deploymentStatus.String())
+		if len(deploymentStatus.FailedMachines) > 0 {
+			reason = ConditionReasonMachinesFailed
+		} else if reason != ConditionReasonMachinesFailed {
+			reason = ConditionReasonMachinesUnavailable
+		}
+	}
+
+	if len(summaries) == 0 {
+		return gardencorev1alpha1helper.UpdatedCondition(getOrInitCondition(conditions, ConditionTypeMachinesHealthy), gardencorev1alpha1.ConditionTrue, reason, "All machines and nodes are healthy.")
+	}
+	return gardencorev1alpha1helper.UpdatedCondition(getOrInitCondition(conditions, ConditionTypeMachinesHealthy), gardencorev1alpha1.ConditionFalse, reason, strings.Join(summaries, "; "))
+}
+
+// RollingUpdateCondition computes the rolling update condition for the given machines status. Its message names
+// the machine deployments that are rolled out.
+func RollingUpdateCondition(conditions []gardencorev1alpha1.Condition, status MachinesStatus) gardencorev1alpha1.Condition {
+	var names []string
+	for _, deploymentStatus := range status.MachineDeployments {
+		if deploymentStatus.RollingUpdate {
+			names = append(names, deploymentStatus.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		return gardencorev1alpha1helper.UpdatedCondition(getOrInitCondition(conditions, ConditionTypeRollingUpdate), gardencorev1alpha1.ConditionFalse, ConditionReasonNoRollingUpdate, "No rolling update is in progress.")
+	}
+	return gardencorev1alpha1helper.UpdatedCondition(getOrInitCondition(conditions, ConditionTypeRollingUpdate), gardencorev1alpha1.ConditionTrue, ConditionReasonRollingUpdateInProgress,
+		fmt.Sprintf("Rolling update in progress for machine deployments %s.", strings.Join(names, ", ")))
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Status", func() {
+	var (
+		pools = []extensionsv1alpha1.WorkerPool{
+			{
+				Name:         "pool-1",
+				MachineType:  "large",
+				MachineImage: extensionsv1alpha1.MachineImage{Name: "coreos", Version: "2023.5.0"},
+			},
+		}
+		wantedMachineDeployments = worker.MachineDeployments{
+			{Name: "shoot--foo--bar-pool-1-z1", PoolName: "pool-1", Zone: "zone-1", ClassName: "shoot--foo--bar-pool-1-z1-abcde"},
+		}
+
+		machineDeployment = func(replicas, ready, updated, available, unavailable int32) machinev1alpha1.MachineDeployment {
+			return machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar-pool-1-z1"},
+				Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: replicas},
+				Status: machinev1alpha1.MachineDeploymentStatus{
+					ReadyReplicas:       ready,
+					UpdatedReplicas:     updated,
+					AvailableReplicas:   available,
+					UnavailableReplicas: unavailable,
+				},
+			}
+		}
+		machineSet = func(name string, replicas int32) machinev1alpha1.MachineSet {
+			return machinev1alpha1.MachineSet{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"name": "shoot--foo--bar-pool-1-z1"}},
+				Spec:       machinev1alpha1.MachineSetSpec{Replicas: replicas},
+			}
+		}
+		machine = func(name string, nodeReady corev1.ConditionStatus, lastOperation machinev1alpha1.LastOperation) machinev1alpha1.Machine {
+			return machinev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"name": "shoot--foo--bar-pool-1-z1"}},
+				Status: machinev1alpha1.MachineStatus{
+					Conditions:    []corev1.NodeCondition{{Type: corev1.NodeReady, Status: nodeReady}},
+					LastOperation: lastOperation,
+				},
+			}
+		}
+		createFailed = machinev1alpha1.LastOperation{
+			Description: "Cloud provider message - QuotaExceeded: instance limit exceeded",
+			State:       machinev1alpha1.MachineStateFailed,
+			Type:        machinev1alpha1.MachineOperationCreate,
+		}
+	)
+
+	Describe("#ComputeMachinesStatus", func() {
+		It("should aggregate the status of healthy machines", func() {
+			status := worker.ComputeMachinesStatus(
+				pools,
+				wantedMachineDeployments,
+				[]machinev1alpha1.MachineDeployment{machineDeployment(2, 2, 2, 2, 0)},
+				[]machinev1alpha1.MachineSet{machineSet("set-1", 2), machineSet("set-0", 0)},
+				[]machinev1alpha1.Machine{
+					machine("machine-1", corev1.ConditionTrue, machinev1alpha1.LastOperation{}),
+					machine("machine-2", corev1.ConditionTrue, machinev1alpha1.LastOperation{}),
+				},
+			)
+
+			Expect(status).To(Equal(worker.MachinesStatus{
+				MachineDeployments: []worker.MachineDeploymentStatus{
+					{
+						Name:         "shoot--foo--bar-pool-1-z1",
+						Pool:         "pool-1",
+						Zone:         "zone-1",
+						MachineClass: "shoot--foo--bar-pool-1-z1-abcde",
+						MachineType:  "large",
+						MachineImage: "coreos:2023.5.0",
+						Desired:      2,
+						Ready:        2,
+						Updated:      2,
+						Available:    2,
+						ReadyNodes:   2,
+					},
+				},
+			}))
+			Expect(status.MachineDeployments[0].Healthy()).To(BeTrue())
+			Expect(status.FailedMachinesError()).NotTo(HaveOccurred())
+		})
+
+		It("should detect rolling updates and failed machines", func() {
+			status := worker.ComputeMachinesStatus(
+				pools,
+				wantedMachineDeployments,
+				[]machinev1alpha1.MachineDeployment{machineDeployment(2, 1, 1, 1, 1)},
+				[]machinev1alpha1.MachineSet{machineSet("set-1", 1), machineSet("set-2", 1)},
+				[]machinev1alpha1.Machine{
+					machine("machine-1", corev1.ConditionTrue, machinev1alpha1.LastOperation{}),
+					machine("machine-2", corev1.ConditionFalse, createFailed),
+				},
+			)
+
+			Expect(status.MachineDeployments).To(HaveLen(1))
+			Expect(status.MachineDeployments[0].RollingUpdate).To(BeTrue())
+			Expect(status.MachineDeployments[0].ReadyNodes).To(Equal(int32(1)))
+			Expect(status.MachineDeployments[0].FailedMachines).To(Equal([]worker.FailedMachine{
+				{
+					Name:        "machine-2",
+					Operation:   machinev1alpha1.MachineOperationCreate,
+					Description: createFailed.Description,
+				},
+			}))
+			Expect(status.MachineDeployments[0].Healthy()).To(BeFalse())
+
+			err := status.FailedMachinesError()
+			Expect(err).To(MatchError("Machine machine-2 failed (operation Create): Cloud provider message - QuotaExceeded: instance limit exceeded"))
+			Expect(gardencorev1alpha1helper.ExtractErrorCodes(gardencorev1alpha1helper.DetermineError(err.Error()))).To(ConsistOf(gardencorev1alpha1.ErrorInfraQuotaExceeded))
+		})
+
+		It("should report wanted machine deployments that do not exist yet", func() {
+			status := worker.ComputeMachinesStatus(pools, wantedMachineDeployments, nil, nil, nil)
+
+			Expect(status.MachineDeployments).To(HaveLen(1))
+			Expect(status.MachineDeployments[0].Desired).To(BeZero())
+			Expect(status.MachineDeployments[0].RollingUpdate).To(BeFalse())
+		})
+	})
+
+	Describe("#State", func() {
+		It("should encode and decode the machines status", func() {
+			status := worker.ComputeMachinesStatus(
+				pools,
+				wantedMachineDeployments,
+				[]machinev1alpha1.MachineDeployment{machineDeployment(1, 0, 0, 0, 1)},
+				[]machinev1alpha1.MachineSet{machineSet("set-1", 1)},
+				[]machinev1alpha1.Machine{machine("machine-1", corev1.ConditionFalse, createFailed)},
+			)
+
+			state, err := status.State()
+			Expect(err).NotTo(HaveOccurred())
+
+			decoded, err := worker.MachinesStatusFromState(state)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded).To(Equal(&status))
+		})
+	})
+
+	Describe("#MachinesHealthyCondition", func() {
+		It("should be true if all machines are healthy", func() {
+			status := worker.MachinesStatus{MachineDeployments: []worker.MachineDeploymentStatus{{Name: "foo", Desired: 1, Available: 1, ReadyNodes: 1}}}
+
+			condition := worker.MachinesHealthyCondition(nil, status)
+
+			Expect(condition.Type).To(Equal(worker.ConditionTypeMachinesHealthy))
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(worker.ConditionReasonMachinesHealthy))
+		})
+
+		It("should be false and summarize the unhealthy machine deployments", func() {
+			status := worker.MachinesStatus{MachineDeployments: []worker.MachineDeploymentStatus{
+				{Name: "foo", Desired: 1, Available: 1, ReadyNodes: 1},
+				{Name: "bar", Pool: "pool-1", Zone: "zone-1", Desired: 2, Ready: 1, Updated: 1, Available: 1, Unavailable: 1, ReadyNodes: 1, RollingUpdate: true},
+			}}
+
+			condition := worker.MachinesHealthyCondition(nil, status)
+
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(worker.ConditionReasonMachinesUnavailable))
+			Expect(condition.Message).To(Equal(`bar (pool "pool-1", zone "zone-1"): 1/2 ready, 1/2 updated, 1/2 nodes ready, 1 unavailable, rolling update in progress`))
+		})
+
+		It("should be false if machines have failed", func() {
+			status := worker.MachinesStatus{MachineDeployments: []worker.MachineDeploymentStatus{
+				{Name: "foo", FailedMachines: []worker.FailedMachine{{Name: "machine-1"}}},
+				{Name: "bar", Desired: 1},
+			}}
+
+			condition := worker.MachinesHealthyCondition(nil, status)
+
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(worker.ConditionReasonMachinesFailed))
+		})
+	})
+
+	Describe("#RollingUpdateCondition", func() {
+		It("should be false if no rolling update is in progress", func() {
+			condition := worker.RollingUpdateCondition(nil, worker.MachinesStatus{MachineDeployments: []worker.MachineDeploymentStatus{{Name: "foo"}}})
+
+			Expect(condition.Type).To(Equal(worker.ConditionTypeRollingUpdate))
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(worker.ConditionReasonNoRollingUpdate))
+		})
+
+		It("should be true and name the machine deployments that are rolled out", func() {
+			condition := worker.RollingUpdateCondition(nil, worker.MachinesStatus{MachineDeployments: []worker.MachineDeploymentStatus{
+				{Name: "foo", RollingUpdate: true},
+				{Name: "bar"},
+			}})
+
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(worker.ConditionReasonRollingUpdateInProgress))
+			Expect(condition.Message).To(Equal("Rolling update in progress for machine deployments foo."))
+		})
+	})
+})
@@ -0,0 +1,223 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/utils"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ZoneWeightsAnnotation is the annotation of a worker pool that defines the weights by which its minimum, maximum,
+	// max surge and max unavailable are distributed over its zones, e.g. "eu-west-1a=2,eu-west-1b=1". Zones without
+	// a weight have a weight of 1.
+	ZoneWeightsAnnotation = "worker.extensions.gardener.cloud/zone-weights"
+
+	// PoolAnnotation is the annotation of a machine deployment that holds the name of its worker pool.
+	PoolAnnotation = "worker.extensions.gardener.cloud/pool"
+	// ZoneAnnotation is the annotation of a machine deployment that holds its zone.
+	ZoneAnnotation = "worker.extensions.gardener.cloud/zone"
+
+	// MaxDeploymentNameLength is the maximum length of the names returned by DeploymentName. The
+	// machine-controller-manager names the machines of a machine deployment after it, suffixed by the hash of the
+	// machine set (up to 10 characters) and 5 random characters. Machine names are used as label values and as VM
+	// names by some providers (e.g. GCP and OpenStack), hence they must not exceed 63 characters.
+	MaxDeploymentNameLength = 63 - 17
+
+	deploymentNameHashLength = 5
+)
+
+var invalidNameCharactersRegexp = regexp.MustCompile(`[^a-z0-9-]`)
+
+// DeploymentName returns the name of the machine deployment of the given worker pool in the given zone. It is
+// derived from the zone itself so that it does not change if other zones of the pool are added, removed or
+// reordered. Names longer than MaxDeploymentNameLength are truncated and suffixed by a hash of the full name to
+// keep them unique.
+func DeploymentName(namespace, poolName, zone string) string {
+	name := fmt.Sprintf("%s-%s-%s", namespace, poolName, invalidNameCharactersRegexp.ReplaceAllString(strings.ToLower(zone), "-"))
+	if len(name) <= MaxDeploymentNameLength {
+		return name
+	}
+
+	hash := utils.ComputeSHA256Hex([]byte(name))[:deploymentNameHashLength]
+	return fmt.Sprintf("%s-%s", strings.TrimRight(name[:MaxDeploymentNameLength-deploymentNameHashLength-1], "-"), hash)
+}
+
+// LegacyDeploymentName returns the name of the machine deployment of the given worker pool in the zone with the
+// given index, as machine deployments were named before they were named after their zones.
+func LegacyDeploymentName(namespace, poolName string, zoneIndex int) string {
+	return fmt.Sprintf("%s-%s-z%d", namespace, poolName, zoneIndex+1)
+}
+
+// DeploymentNames names the machine deployments of the worker pools of a Worker. Existing machine deployments keep
+// their names so that their machines are not rolled. Machine deployments that are still named after the index of
+// their zone are adopted until the generic worker actuator has annotated them with their pool and zone.
+type DeploymentNames struct {
+	namespace          string
+	machineDeployments []machinev1alpha1.MachineDeployment
+}
+
+// NewDeploymentNames lists the existing machine deployments in the given namespace and returns the names for the
+// machine deployments of the worker pools in the namespace.
+func NewDeploymentNames(ctx context.Context, c client.Client, namespace string) (*DeploymentNames, error) {
+	machineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := c.List(ctx, &client.ListOptions{Namespace: namespace}, machineDeployments); err != nil {
+		return nil, err
+	}
+	return &DeploymentNames{namespace, machineDeployments.Items}, nil
+}
+
+// Name returns the name of the machine deployment of the given worker pool in the given zone. The given zones are
+// the zones of the worker pool.
+func (n *DeploymentNames) Name(poolName string, zones []string, zone string) string {
+	for _, machineDeployment := range n.machineDeployments {
+		if machineDeployment.Annotations[PoolAnnotation] == poolName && machineDeployment.Annotations[ZoneAnnotation] == zone {
+			return machineDeployment.Name
+		}
+	}
+
+	for zoneIndex, poolZone := range zones {
+		if poolZone != zone {
+			continue
+		}
+		legacyName := LegacyDeploymentName(n.namespace, poolName, zoneIndex)
+		for _, machineDeployment := range n.machineDeployments {
+			if _, annotated := machineDeployment.Annotations[ZoneAnnotation]; machineDeployment.Name == legacyName && !annotated {
+				return legacyName
+			}
+		}
+	}
+
+	return DeploymentName(n.namespace, poolName, zone)
+}
+
+// ZoneDistribution distributes the minimum, maximum, max surge and max unavailable of a worker pool over its zones
+// in proportion to their weights. Without weights, the nodes are distributed as by DistributeOverZones so that the
+// machine deployments of existing worker pools keep their sizes. With weights, the distribution does not depend on
+// the order of the zones.
+type ZoneDistribution struct {
+	zones       []string
+	weights     map[string]int
+	totalWeight int
+}
+
+// NewZoneDistribution returns the distribution over the given zones of a worker pool with the weights defined by
+// the ZoneWeightsAnnotation in the given annotations of the pool.
+func NewZoneDistribution(zones []string, annotations map[string]string) (*ZoneDistribution, error) {
+	weights := make(map[string]int, len(zones))
+	for _, zone := range zones {
+		weights[zone] = 1
+	}
+
+	// Ties are broken in this order. Without weights, it is the order of the zones in the worker pool, like in
+	// DistributeOverZones, so that no nodes move between zones on upgrade.
+	orderedZones := make([]string, len(zones))
+	copy(orderedZones, zones)
+
+	if value, ok := annotations[ZoneWeightsAnnotation]; ok {
+		sort.Strings(orderedZones)
+
+		for _, pair := range strings.Split(value, ",") {
+			parts := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("annotation %s must be a comma-separated list of zone=weight pairs, got %q", ZoneWeightsAnnotation, value)
+			}
+
+			zone := strings.TrimSpace(parts[0])
+			if _, ok := weights[zone]; !ok {
+				return nil, fmt.Errorf("annotation %s defines a weight for zone %q which is not a zone of the worker pool", ZoneWeightsAnnotation, zone)
+			}
+
+			weight, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+			if err != nil || weight < 0 {
+				return nil, fmt.Errorf("annotation %s must define non-negative weights, got %q for zone %q", ZoneWeightsAnnotation, parts[1], zone)
+			}
+			weights[zone] = weight
+		}
+	}
+
+	var totalWeight int
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+	if totalWeight == 0 {
+		return nil, fmt.Errorf("annotation %s must define a positive weight for at least one zone", ZoneWeightsAnnotation)
+	}
+
+	return &ZoneDistribution{orderedZones, weights, totalWeight}, nil
+}
+
+// Distribute returns how many of the given number of nodes should be placed in the given zone. The nodes are
+// assigned one after the other to the zone with the highest quotient of its weight and 2n+1, where n is the number
+// of nodes it already got (Sainte-Laguë method). In case of a tie, the zone that comes first in the worker pool gets
+// the node, or the alphabetically first zone if weights are defined. Each zone hence gets its share of the nodes in
+// proportion to its weight, and a zone never gets fewer nodes out of a larger number of nodes, i.e., the distributed
+// minimum of a worker pool never exceeds its distributed maximum.
+func (d *ZoneDistribution) Distribute(zone string, size int) int {
+	nodes := make(map[string]int, len(d.zones))
+	for i := 0; i < size; i++ {
+		next := d.zones[0]
+		for _, z := range d.zones[1:] {
+			// Compare weights[z]/(2*nodes[z]+1) > weights[next]/(2*nodes[next]+1) without rounding errors.
+			if d.weights[z]*(2*nodes[next]+1) > d.weights[next]*(2*nodes[z]+1) {
+				next = z
+			}
+		}
+		nodes[next]++
+	}
+
+	return nodes[zone]
+}
+
+// DistributePercent distributes the given percentage value over the zones in relation to the given total value.
+// Zones that get exactly their share of the total value keep the initial percentage. Otherwise, the percentage is
+// adapted to the ratio between the value the zone gets and its share.
+func (d *ZoneDistribution) DistributePercent(zone string, percent string, total int) string {
+	percents, err := strconv.Atoi(percent[:len(percent)-1])
+	if err != nil {
+		panic(fmt.Sprintf("given value %q is not a percent value", percent))
+	}
+
+	var (
+		zoneTotal = d.Distribute(zone, total)
+		weight    = d.weights[zone]
+	)
+	if zoneTotal*d.totalWeight == total*weight {
+		return fmt.Sprintf("%d%%", percents)
+	}
+
+	ratio := float64(zoneTotal*d.totalWeight) / float64(total*weight)
+	// Optimistic rounding up, this will cause an actual max surge / max unavailable percentage to be a bit higher.
+	return fmt.Sprintf("%d%%", int(math.Ceil(ratio*float64(percents))))
+}
+
+// DistributePositiveIntOrPercent distributes the given int or percentage value over the zones in relation to the
+// given total value, see Distribute and DistributePercent.
+func (d *ZoneDistribution) DistributePositiveIntOrPercent(zone string, intOrPercent intstr.IntOrString, total int) intstr.IntOrString {
+	if intOrPercent.Type == intstr.String {
+		return intstr.FromString(d.DistributePercent(zone, intOrPercent.StrVal, total))
+	}
+	return intstr.FromInt(d.Distribute(zone, int(intOrPercent.IntVal)))
+}
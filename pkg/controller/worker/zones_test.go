@@ -0,0 +1,222 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/golang/mock/gomock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Zones", func() {
+	const namespace = "shoot--foo--bar"
+
+	DescribeTable("#DeploymentName",
+		func(zone, expected string) {
+			Expect(worker.DeploymentName(namespace, "pool", zone)).To(Equal(expected))
+		},
+
+		Entry("zone name", "eu-west-1a", "shoot--foo--bar-pool-eu-west-1a"),
+		Entry("zone number", "1", "shoot--foo--bar-pool-1"),
+		Entry("zone with invalid characters", "AZ_1", "shoot--foo--bar-pool-az-1"),
+	)
+
+	Describe("#DeploymentName with long names", func() {
+		const (
+			longNamespace = "shoot--my-project--my-cluster1"
+			longPool      = "cpu-worker-pool"
+		)
+
+		It("should bound the length of the name", func() {
+			name := worker.DeploymentName(longNamespace, longPool, "northamerica-northeast1-a")
+
+			Expect(len(name)).To(BeNumerically("<=", worker.MaxDeploymentNameLength))
+			Expect(name).To(HavePrefix("shoot--my-project--my-cluster1-cpu-"))
+			Expect(name).To(MatchRegexp(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`))
+		})
+
+		It("should return distinct names for zones with the same prefix", func() {
+			Expect(worker.DeploymentName(longNamespace, longPool, "northamerica-northeast1-a")).
+				NotTo(Equal(worker.DeploymentName(longNamespace, longPool, "northamerica-northeast1-b")))
+		})
+
+		It("should return the same name for the same zone", func() {
+			Expect(worker.DeploymentName(longNamespace, longPool, "northamerica-northeast1-a")).
+				To(Equal(worker.DeploymentName(longNamespace, longPool, "northamerica-northeast1-a")))
+		})
+	})
+
+	Describe("#DeploymentNames", func() {
+		var (
+			ctrl *gomock.Controller
+			c    *mockclient.MockClient
+
+			zones = []string{"zone-a", "zone-b"}
+
+			machineDeployment = func(name string, annotations map[string]string) machinev1alpha1.MachineDeployment {
+				return machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations}}
+			}
+			deploymentNames = func(machineDeployments ...machinev1alpha1.MachineDeployment) *worker.DeploymentNames {
+				c.EXPECT().
+					List(context.TODO(), &client.ListOptions{Namespace: namespace}, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{})).
+					DoAndReturn(func(_ context.Context, _ *client.ListOptions, list *machinev1alpha1.MachineDeploymentList) error {
+						list.Items = machineDeployments
+						return nil
+					})
+
+				names, err := worker.NewDeploymentNames(context.TODO(), c, namespace)
+				Expect(err).NotTo(HaveOccurred())
+				return names
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			c = mockclient.NewMockClient(ctrl)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should name new machine deployments after their zones", func() {
+			names := deploymentNames()
+
+			Expect(names.Name("pool", zones, "zone-b")).To(Equal("shoot--foo--bar-pool-zone-b"))
+		})
+
+		It("should adopt machine deployments named after the index of their zone", func() {
+			names := deploymentNames(machineDeployment("shoot--foo--bar-pool-z2", nil))
+
+			Expect(names.Name("pool", zones, "zone-a")).To(Equal("shoot--foo--bar-pool-zone-a"))
+			Expect(names.Name("pool", zones, "zone-b")).To(Equal("shoot--foo--bar-pool-z2"))
+		})
+
+		It("should keep the names of annotated machine deployments if the zones are reordered", func() {
+			names := deploymentNames(
+				machineDeployment("shoot--foo--bar-pool-z1", map[string]string{worker.PoolAnnotation: "pool", worker.ZoneAnnotation: "zone-a"}),
+				machineDeployment("shoot--foo--bar-pool-zone-b", map[string]string{worker.PoolAnnotation: "pool", worker.ZoneAnnotation: "zone-b"}),
+			)
+
+			reordered := []string{"zone-c", "zone-b", "zone-a"}
+			Expect(names.Name("pool", reordered, "zone-c")).To(Equal("shoot--foo--bar-pool-zone-c"))
+			Expect(names.Name("pool", reordered, "zone-b")).To(Equal("shoot--foo--bar-pool-zone-b"))
+			Expect(names.Name("pool", reordered, "zone-a")).To(Equal("shoot--foo--bar-pool-z1"))
+		})
+	})
+
+	Describe("#ZoneDistribution", func() {
+		DescribeTable("#Distribute",
+			func(zones []string, annotations map[string]string, size int, expected map[string]int) {
+				distribution, err := worker.NewZoneDistribution(zones, annotations)
+				Expect(err).NotTo(HaveOccurred())
+
+				distributed := map[string]int{}
+				for _, zone := range zones {
+					distributed[zone] = distribution.Distribute(zone, size)
+				}
+				Expect(distributed).To(Equal(expected))
+			},
+
+			Entry("even size", []string{"a", "b"}, nil, 4, map[string]int{"a": 2, "b": 2}),
+			Entry("uneven size", []string{"a", "b", "c"}, nil, 4, map[string]int{"a": 2, "b": 1, "c": 1}),
+			Entry("uneven size in the order of the zones", []string{"c", "b", "a"}, nil, 4, map[string]int{"a": 1, "b": 1, "c": 2}),
+			Entry("weights", []string{"a", "b"}, map[string]string{worker.ZoneWeightsAnnotation: "a=3, b=1"}, 8, map[string]int{"a": 6, "b": 2}),
+			Entry("weights with remainder", []string{"a", "b", "c"}, map[string]string{worker.ZoneWeightsAnnotation: "c=2"}, 5, map[string]int{"a": 1, "b": 1, "c": 3}),
+			Entry("weights independent of the order", []string{"c", "b", "a"}, map[string]string{worker.ZoneWeightsAnnotation: "c=1"}, 4, map[string]int{"a": 2, "b": 1, "c": 1}),
+			Entry("zero weight", []string{"a", "b"}, map[string]string{worker.ZoneWeightsAnnotation: "a=0"}, 3, map[string]int{"a": 0, "b": 3}),
+			Entry("no zone", []string{""}, nil, 3, map[string]int{"": 3}),
+		)
+
+		It("should keep the sizes of existing machine deployments on upgrade if the zones are not in alphabetical order", func() {
+			zones := []string{"zone-b", "zone-c", "zone-a"}
+
+			distribution, err := worker.NewZoneDistribution(zones, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			for zoneIndex, zone := range zones {
+				for _, size := range []int{1, 2, 3, 4, 5, 7} {
+					Expect(distribution.Distribute(zone, size)).To(Equal(worker.DistributeOverZones(zoneIndex, size, len(zones))), "zone %q, size %d", zone, size)
+				}
+				Expect(distribution.DistributePositiveIntOrPercent(zone, intstr.FromString("25%"), 5)).
+					To(Equal(worker.DistributePositiveIntOrPercent(zoneIndex, intstr.FromString("25%"), len(zones), 5)), "zone %q", zone)
+			}
+		})
+
+		DescribeTable("#Distribute with a minimum and maximum",
+			func(zones []string, annotations map[string]string, minimum, maximum int) {
+				distribution, err := worker.NewZoneDistribution(zones, annotations)
+				Expect(err).NotTo(HaveOccurred())
+
+				var distributedMinimum, distributedMaximum int
+				for _, zone := range zones {
+					zoneMinimum, zoneMaximum := distribution.Distribute(zone, minimum), distribution.Distribute(zone, maximum)
+					Expect(zoneMinimum).To(BeNumerically("<=", zoneMaximum), "zone %q", zone)
+
+					distributedMinimum += zoneMinimum
+					distributedMaximum += zoneMaximum
+				}
+				Expect(distributedMinimum).To(Equal(minimum))
+				Expect(distributedMaximum).To(Equal(maximum))
+			},
+
+			Entry("uneven weights (1)", []string{"a", "b", "c"}, map[string]string{worker.ZoneWeightsAnnotation: "a=1,b=3,c=3"}, 3, 4),
+			Entry("uneven weights (2)", []string{"a", "b", "c"}, map[string]string{worker.ZoneWeightsAnnotation: "a=1,b=3,c=3"}, 10, 11),
+			Entry("uneven weights (3)", []string{"a", "b", "c"}, map[string]string{worker.ZoneWeightsAnnotation: "a=1,b=3,c=5"}, 4, 5),
+			Entry("uneven weights (4)", []string{"a", "b", "c"}, map[string]string{worker.ZoneWeightsAnnotation: "a=5,b=1,c=4"}, 5, 6),
+			Entry("uneven weights (5)", []string{"a", "b", "c", "d"}, map[string]string{worker.ZoneWeightsAnnotation: "a=2,b=7,c=1,d=3"}, 1, 50),
+			Entry("equal weights", []string{"a", "b", "c"}, nil, 2, 7),
+		)
+
+		DescribeTable("#DistributePositiveIntOrPercent",
+			func(zones []string, annotations map[string]string, zone string, intOrPercent intstr.IntOrString, total int, expected intstr.IntOrString) {
+				distribution, err := worker.NewZoneDistribution(zones, annotations)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(distribution.DistributePositiveIntOrPercent(zone, intOrPercent, total)).To(Equal(expected))
+			},
+
+			Entry("int", []string{"a", "b"}, nil, "a", intstr.FromInt(3), 0, intstr.FromInt(2)),
+			Entry("percent of even total", []string{"a", "b"}, nil, "a", intstr.FromString("25%"), 4, intstr.FromString("25%")),
+			Entry("percent of uneven total", []string{"a", "b"}, nil, "a", intstr.FromString("25%"), 5, intstr.FromString("30%")),
+			Entry("percent of weighted total", []string{"a", "b"}, map[string]string{worker.ZoneWeightsAnnotation: "a=3"}, "b", intstr.FromString("50%"), 8, intstr.FromString("50%")),
+		)
+
+		DescribeTable("#NewZoneDistribution with invalid weights",
+			func(value string) {
+				_, err := worker.NewZoneDistribution([]string{"a", "b"}, map[string]string{worker.ZoneWeightsAnnotation: value})
+				Expect(err).To(HaveOccurred())
+			},
+
+			Entry("no pair", "a"),
+			Entry("unknown zone", "c=1"),
+			Entry("weight not a number", "a=x"),
+			Entry("negative weight", "a=-1"),
+			Entry("no positive weight", "a=0,b=0"),
+		)
+	})
+})
@@ -15,6 +15,8 @@
 package chartrenderer
 
 import (
+	"github.com/gardener/gardener-extensions/pkg/util/chart"
+
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"k8s.io/client-go/rest"
 )
@@ -29,5 +31,38 @@ func (f FactoryFunc) NewForConfig(config *rest.Config) (chartrenderer.Interface,
 
 // DefaultFactory returns the default Factory.
 func DefaultFactory() Factory {
-	return FactoryFunc(chartrenderer.NewForConfig)
+	return FactoryFunc(NewForConfig)
+}
+
+// NewForConfig creates a new chartrenderer.Interface for the given config. Charts contained in a registered
+// chart source are rendered from there instead of the file system.
+func NewForConfig(config *rest.Config) (chartrenderer.Interface, error) {
+	renderer, err := chartrenderer.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithSource(renderer, chart.DefaultSource()), nil
+}
+
+type sourceRenderer struct {
+	chartrenderer.Interface
+	source chart.Source
+}
+
+// NewWithSource returns a chartrenderer.Interface that renders the charts contained in the given source from there,
+// and all other charts from the file system using the given renderer.
+func NewWithSource(renderer chartrenderer.Interface, source chart.Source) chartrenderer.Interface {
+	return &sourceRenderer{renderer, source}
+}
+
+// Render implements chartrenderer.Interface.
+func (r *sourceRenderer) Render(chartPath, releaseName, namespace string, values map[string]interface{}) (*chartrenderer.RenderedChart, error) {
+	archive, ok, err := r.source.Archive(chartPath)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Interface.Render(chartPath, releaseName, namespace, values)
+	}
+	return r.Interface.RenderArchive(archive, releaseName, namespace, values)
 }
@@ -0,0 +1,367 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// terraformerServiceAccountName is the name of the service account the Gardener terraformer runs with.
+	terraformerServiceAccountName = "terraformer"
+	// planPodSuffix is the suffix of the pods that run a Terraform plan.
+	planPodSuffix = ".tf-plan"
+	// planResourceSuffix is the suffix of the configuration ConfigMap and the variables Secret
+	// that are created by the plan initializer.
+	planResourceSuffix = "-plan"
+
+	// planExitCodeNoChanges is the exit code of `terraform plan -detailed-exitcode` if there is no diff.
+	planExitCodeNoChanges = 0
+	// planExitCodeChanges is the exit code of `terraform plan -detailed-exitcode` if there is a diff.
+	planExitCodeChanges = 2
+
+	// planPollInterval is the interval in which the result of a running Terraform plan pod is checked.
+	planPollInterval = 15 * time.Second
+)
+
+// HasChanges returns true if the plan contains at least one resource that is added, changed or destroyed.
+func (p *Plan) HasChanges() bool {
+	return p.ToAdd > 0 || p.ToChange > 0 || p.ToDestroy > 0
+}
+
+// String returns the Terraform summary of the plan.
+func (p *Plan) String() string {
+	return fmt.Sprintf("Plan: %d to add, %d to change, %d to destroy.", p.ToAdd, p.ToChange, p.ToDestroy)
+}
+
+// Plan implements Terraformer.
+//
+// It runs `terraform plan` against the configuration of the plan initializer and the current state
+// in a dedicated pod, without touching any of the resources managed by Terraform or the configuration
+// and state of the last apply. Plan does not wait for the pod: the first call starts it and returns a
+// RequeueAfterError, which is returned by all further calls until the pod has terminated. The next call
+// returns the parsed result and removes the pod and the plan configuration.
+func (t *terraformer) Plan() (plan *Plan, err error) {
+	defer func(start time.Time) {
+		metrics.ObserveTerraformerOperation(t.purpose, metrics.OperationPlan, start, err)
+	}(time.Now())
+
+	if !t.planConfigurationDefined {
+		return nil, errors.New("Terraformer plan configuration has not been defined, cannot plan the Terraform scripts")
+	}
+
+	ctx := context.TODO()
+	pod := &corev1.Pod{}
+	if err := t.client.Get(ctx, kutil.Key(t.namespace, t.planPodName()), pod); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err := t.createPlanPod(ctx); err != nil {
+			t.cleanupPlanConfiguration(ctx)
+			return nil, err
+		}
+		t.logger.Infof("Started Terraform plan pod '%s'", t.planPodName())
+		return nil, &controllererror.RequeueAfterError{RequeueAfter: planPollInterval}
+	}
+
+	exitCode, terminated, err := planPodExitCode(pod)
+	if err != nil {
+		t.cleanupPlan(ctx, pod)
+		return nil, err
+	}
+	if !terminated {
+		t.logger.Infof("Waiting for Terraform plan pod '%s' to be completed...", pod.Name)
+		return nil, &controllererror.RequeueAfterError{RequeueAfter: planPollInterval}
+	}
+	defer t.cleanupPlan(ctx, pod)
+
+	logs, err := kubernetes.GetPodLogs(t.coreV1Client.Pods(t.namespace), pod.Name, &corev1.PodLogOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the logs of Terraform plan pod '%s': %v", pod.Name, err)
+	}
+
+	switch exitCode {
+	case planExitCodeNoChanges:
+		return &Plan{}, nil
+	case planExitCodeChanges:
+		return ParsePlan(string(logs))
+	default:
+		return nil, fmt.Errorf("Terraform plan pod '%s' failed with exit code %d:\n\n%s", pod.Name, exitCode, string(logs))
+	}
+}
+
+// planPodName returns the name of the pod that runs the Terraform plan.
+func (t *terraformer) planPodName() string {
+	return t.prefix() + planPodSuffix
+}
+
+// createPlanPod creates the pod that runs the Terraform plan.
+func (t *terraformer) createPlanPod(ctx context.Context) error {
+	if err := t.ensureServiceAccount(ctx); err != nil {
+		return err
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: t.namespace,
+			Name:      t.planPodName(),
+			Labels: map[string]string{
+				"networking.gardener.cloud/to-dns":              "allowed",
+				"networking.gardener.cloud/to-private-networks": "allowed",
+				"networking.gardener.cloud/to-public-networks":  "allowed",
+				"networking.gardener.cloud/to-seed-apiserver":   "allowed",
+			},
+		},
+		Spec: t.planPodSpec(),
+	}
+	return t.client.Create(ctx, pod)
+}
+
+// cleanupPlan deletes the given Terraform plan pod and the plan configuration, so that the next plan starts over.
+func (t *terraformer) cleanupPlan(ctx context.Context, pod *corev1.Pod) {
+	if err := t.client.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+		t.logger.Errorf("Could not delete Terraform plan pod '%s': %s", pod.Name, err.Error())
+	}
+	t.cleanupPlanConfiguration(ctx)
+}
+
+// cleanupPlanConfiguration deletes the configuration ConfigMap and the variables Secret created by the plan initializer.
+func (t *terraformer) cleanupPlanConfiguration(ctx context.Context) {
+	prefix := t.prefix()
+	for _, obj := range []runtime.Object{
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: prefix + common.TerraformerConfigSuffix + planResourceSuffix}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: prefix + common.TerraformerVariablesSuffix + planResourceSuffix}},
+	} {
+		if err := t.client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			t.logger.Errorf("Could not delete Terraform plan configuration: %s", err.Error())
+		}
+	}
+}
+
+// ensureServiceAccount makes sure the service account of the Terraform pods exists. It is usually
+// created by the first Terraform apply, but a plan might be requested before that.
+func (t *terraformer) ensureServiceAccount(ctx context.Context) error {
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: terraformerServiceAccountName}}
+	return kutil.CreateOrUpdate(ctx, t.client, serviceAccount, func() error {
+		return nil
+	})
+}
+
+func (t *terraformer) planPodSpec() corev1.PodSpec {
+	const (
+		tfVolume      = "tf"
+		tfVarsVolume  = "tfvars"
+		tfStateVolume = "tfstate"
+	)
+
+	var (
+		activeDeadlineSeconds         = int64(1800)
+		terminationGracePeriodSeconds = int64(30)
+		stateOptional                 = true
+		prefix                        = t.prefix()
+		env                           = []corev1.EnvVar{
+			{Name: "MAX_BACKOFF_SEC", Value: "60"},
+			{Name: "MAX_TIME_SEC", Value: "1800"},
+			{Name: "TF_STATE_CONFIG_MAP_NAME", Value: prefix + common.TerraformerStateSuffix},
+		}
+	)
+
+	for k, v := range t.variablesEnvironment {
+		env = append(env, corev1.EnvVar{Name: k, Value: v})
+	}
+
+	return corev1.PodSpec{
+		RestartPolicy:         corev1.RestartPolicyNever,
+		ActiveDeadlineSeconds: &activeDeadlineSeconds,
+		Containers: []corev1.Container{
+			{
+				Name:            "terraform",
+				Image:           t.image,
+				ImagePullPolicy: corev1.PullIfNotPresent,
+				Command:         []string{"sh", "-c", "sh /terraform.sh validate"},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("50m"),
+						corev1.ResourceMemory: resource.MustParse("200Mi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("200m"),
+						corev1.ResourceMemory: resource.MustParse("512Mi"),
+					},
+				},
+				Env: env,
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: tfVolume, MountPath: "/tf"},
+					{Name: tfVarsVolume, MountPath: "/tfvars"},
+					{Name: tfStateVolume, MountPath: "/tf-state-in"},
+				},
+			},
+		},
+		ServiceAccountName:            terraformerServiceAccountName,
+		TerminationGracePeriodSeconds: &terminationGracePeriodSeconds,
+		Volumes: []corev1.Volume{
+			{
+				Name: tfVolume,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: prefix + common.TerraformerConfigSuffix + planResourceSuffix},
+					},
+				},
+			},
+			{
+				Name: tfVarsVolume,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: prefix + common.TerraformerVariablesSuffix + planResourceSuffix,
+					},
+				},
+			},
+			{
+				Name: tfStateVolume,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: prefix + common.TerraformerStateSuffix},
+						// The state does not exist before the first apply, in which case everything is planned to be added.
+						Optional: &stateOptional,
+					},
+				},
+			},
+		},
+	}
+}
+
+// planPodExitCode returns the exit code of the container of the given Terraform plan pod and whether the pod
+// has terminated at all.
+func planPodExitCode(pod *corev1.Pod) (int32, bool, error) {
+	if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+		return 0, false, nil
+	}
+
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if terminated := containerStatus.State.Terminated; terminated != nil {
+			return terminated.ExitCode, true, nil
+		}
+	}
+	return 0, false, fmt.Errorf("Terraform plan pod '%s' has completed but its container did not report an exit code", pod.Name)
+}
+
+var (
+	ansiEscapeRegexp = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	summaryRegexp    = regexp.MustCompile(`Plan: (\d+) to add, (\d+) to change, (\d+) to destroy\.`)
+
+	// resourceHeaderRegexp matches the resource headers as printed by Terraform >= 0.12, e.g.
+	// `  # aws_vpc.vpc will be created`.
+	resourceHeaderRegexp = regexp.MustCompile(`^\s*# (\S+) (?:is tainted, so )?(will be created|will be updated in-place|must be replaced|will be destroyed|will be read during apply)`)
+	// resourceLineRegexp matches the resource lines as printed by Terraform < 0.12, e.g.
+	// `  + aws_vpc.vpc` or `-/+ aws_instance.bastion (new resource required)`.
+	resourceLineRegexp = regexp.MustCompile(`^\s{0,2}(-/\+|\+/-|<=|\+|-|~) (\S+)`)
+
+	headerActions = map[string]ChangeAction{
+		"will be created":           ChangeActionCreate,
+		"will be updated in-place":  ChangeActionUpdate,
+		"must be replaced":          ChangeActionReplace,
+		"will be destroyed":         ChangeActionDelete,
+		"will be read during apply": ChangeActionRead,
+	}
+	symbolActions = map[string]ChangeAction{
+		"+":   ChangeActionCreate,
+		"~":   ChangeActionUpdate,
+		"-/+": ChangeActionReplace,
+		"+/-": ChangeActionReplace,
+		"-":   ChangeActionDelete,
+		"<=":  ChangeActionRead,
+	}
+)
+
+// ParsePlan parses the human readable output of `terraform plan` into a Plan. It understands the
+// output format of Terraform 0.11 as well as of Terraform 0.12.
+func ParsePlan(output string) (*Plan, error) {
+	output = ansiEscapeRegexp.ReplaceAllString(output, "")
+
+	var (
+		plan             = &Plan{}
+		summaryFound     bool
+		headerChanges    []ResourceChange
+		lineChanges      []ResourceChange
+		inResourceChange bool
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if match := summaryRegexp.FindStringSubmatch(line); match != nil {
+			plan.ToAdd, _ = strconv.Atoi(match[1])
+			plan.ToChange, _ = strconv.Atoi(match[2])
+			plan.ToDestroy, _ = strconv.Atoi(match[3])
+			summaryFound = true
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "No changes.") {
+			summaryFound = true
+			continue
+		}
+
+		if strings.HasPrefix(line, "Terraform will perform the following actions:") {
+			inResourceChange = true
+			continue
+		}
+
+		if match := resourceHeaderRegexp.FindStringSubmatch(line); match != nil {
+			headerChanges = append(headerChanges, ResourceChange{Address: match[1], Action: headerActions[match[2]]})
+			continue
+		}
+
+		if inResourceChange {
+			if match := resourceLineRegexp.FindStringSubmatch(line); match != nil {
+				lineChanges = append(lineChanges, ResourceChange{Address: match[2], Action: symbolActions[match[1]]})
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if !summaryFound {
+		return nil, errors.New("could not find the plan summary in the Terraform output")
+	}
+
+	plan.ResourceChanges = headerChanges
+	if len(plan.ResourceChanges) == 0 {
+		plan.ResourceChanges = lineChanges
+	}
+	return plan, nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	terraform011Output = `Refreshing Terraform state in-memory prior to plan...
+
+aws_vpc.vpc: Refreshing state... (ID: vpc-123456)
+
+------------------------------------------------------------------------
+
+An execution plan has been generated and is shown below.
+Resource actions are indicated with the following symbols:
+  + create
+  ~ update in-place
+  - destroy
+-/+ destroy and then create replacement
+
+Terraform will perform the following actions:
+
+  + aws_subnet.nodes_z1
+      id:                      <computed>
+      cidr_block:              "10.250.16.0/19"
+
+  ~ aws_vpc.vpc
+      enable_dns_hostnames:    "false" => "true"
+
+-/+ aws_instance.bastion (new resource required)
+      id:                      "i-123456" => <computed> (forces new resource)
+
+  - aws_eip.eip_natgw_z2
+
+
+Plan: 2 to add, 1 to change, 2 to destroy.
+
+------------------------------------------------------------------------
+`
+
+	terraform012Output = "Refreshing Terraform state in-memory prior to plan...\n" +
+		"\n" +
+		"Terraform will perform the following actions:\n" +
+		"\n" +
+		"  # aws_subnet.nodes_z1 will be created\n" +
+		"  + resource \"aws_subnet\" \"nodes_z1\" {\n" +
+		"      + cidr_block = \"10.250.16.0/19\"\n" +
+		"    }\n" +
+		"\n" +
+		"  # aws_instance.bastion is tainted, so must be replaced\n" +
+		"-/+ resource \"aws_instance\" \"bastion\" {\n" +
+		"    }\n" +
+		"\n" +
+		"  # aws_eip.eip_natgw_z2 will be destroyed\n" +
+		"  - resource \"aws_eip\" \"eip_natgw_z2\" {\n" +
+		"    }\n" +
+		"\n" +
+		"\x1b[0m\x1b[1mPlan:\x1b[0m 2 to add, 0 to change, 2 to destroy.\x1b[0m\n"
+)
+
+var _ = Describe("Plan", func() {
+	Describe("#ParsePlan", func() {
+		It("should parse the Terraform 0.11 output", func() {
+			plan, err := ParsePlan(terraform011Output)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(Equal(&Plan{
+				ToAdd:     2,
+				ToChange:  1,
+				ToDestroy: 2,
+				ResourceChanges: []ResourceChange{
+					{Address: "aws_subnet.nodes_z1", Action: ChangeActionCreate},
+					{Address: "aws_vpc.vpc", Action: ChangeActionUpdate},
+					{Address: "aws_instance.bastion", Action: ChangeActionReplace},
+					{Address: "aws_eip.eip_natgw_z2", Action: ChangeActionDelete},
+				},
+			}))
+			Expect(plan.HasChanges()).To(BeTrue())
+		})
+
+		It("should parse the Terraform 0.12 output", func() {
+			plan, err := ParsePlan(terraform012Output)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(Equal(&Plan{
+				ToAdd:     2,
+				ToChange:  0,
+				ToDestroy: 2,
+				ResourceChanges: []ResourceChange{
+					{Address: "aws_subnet.nodes_z1", Action: ChangeActionCreate},
+					{Address: "aws_instance.bastion", Action: ChangeActionReplace},
+					{Address: "aws_eip.eip_natgw_z2", Action: ChangeActionDelete},
+				},
+			}))
+			Expect(plan.String()).To(Equal("Plan: 2 to add, 0 to change, 2 to destroy."))
+		})
+
+		It("should return an empty plan if there are no changes", func() {
+			plan, err := ParsePlan("No changes. Infrastructure is up-to-date.\n")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(Equal(&Plan{}))
+			Expect(plan.HasChanges()).To(BeFalse())
+		})
+
+		It("should fail if the output does not contain a plan summary", func() {
+			_, err := ParsePlan("Error: Invalid provider configuration\n")
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -15,6 +15,12 @@
 package terraformer
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
+
 	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/sirupsen/logrus"
 	"k8s.io/client-go/kubernetes/typed/core/v1"
@@ -24,26 +30,54 @@ import (
 
 type terraformer struct {
 	tf *gardenerterraformer.Terraformer
+
+	logger       logrus.FieldLogger
+	client       client.Client
+	coreV1Client v1.CoreV1Interface
+
+	purpose   string
+	namespace string
+	name      string
+	image     string
+
+	variablesEnvironment     map[string]string
+	planConfigurationDefined bool
 }
 
 // SetVariablesEnvironment implements Terraformer.
 func (t *terraformer) SetVariablesEnvironment(tfVarsEnvironment map[string]string) Interface {
-	return &terraformer{t.tf.SetVariablesEnvironment(tfVarsEnvironment)}
+	t.tf = t.tf.SetVariablesEnvironment(tfVarsEnvironment)
+	t.variablesEnvironment = tfVarsEnvironment
+	return t
 }
 
 // InitializeWith implements Terraformer.
 func (t *terraformer) InitializeWith(initializer Initializer) Interface {
-	return &terraformer{t.tf.InitializeWith(initializer.Initialize)}
+	t.planConfigurationDefined = false
+	t.tf = t.tf.InitializeWith(func(config *gardenerterraformer.InitializerConfig) error {
+		if err := initializer.Initialize(config); err != nil {
+			return err
+		}
+		_, t.planConfigurationDefined = initializer.(*planInitializer)
+		return nil
+	})
+	return t
 }
 
 // Apply implements Terraformer.
 func (t *terraformer) Apply() error {
-	return t.tf.Apply()
+	start := time.Now()
+	err := t.tf.Apply()
+	metrics.ObserveTerraformerOperation(t.purpose, metrics.OperationApply, start, err)
+	return err
 }
 
 // Destroy implements Terraformer.
 func (t *terraformer) Destroy() error {
-	return t.tf.Destroy()
+	start := time.Now()
+	err := t.tf.Destroy()
+	metrics.ObserveTerraformerOperation(t.purpose, metrics.OperationDestroy, start, err)
+	return err
 }
 
 // GetStateOutputVariables implements Terraformer.
@@ -56,6 +90,11 @@ func (t *terraformer) ConfigExists() (bool, error) {
 	return t.tf.ConfigExists()
 }
 
+// prefix returns the prefix of all resources that belong to this terraformer.
+func (t *terraformer) prefix() string {
+	return fmt.Sprintf("%s.%s", t.name, t.purpose)
+}
+
 type initializerFunc func(config *gardenerterraformer.InitializerConfig) error
 
 // Initialize implements Initializer.
@@ -63,21 +102,57 @@ func (f initializerFunc) Initialize(config *gardenerterraformer.InitializerConfi
 	return f(config)
 }
 
+// planInitializer is an Initializer that stores the configuration and variables in the plan resources
+// instead of the ones used by Apply and Destroy. The state is only read by the plan, hence it is not initialized.
+type planInitializer struct {
+	client    client.Client
+	main      string
+	variables string
+	tfVars    []byte
+}
+
+// Initialize implements Initializer.
+func (i *planInitializer) Initialize(config *gardenerterraformer.InitializerConfig) error {
+	ctx := context.TODO()
+	if _, err := gardenerterraformer.CreateOrUpdateConfigurationConfigMap(ctx, i.client, config.Namespace, config.ConfigurationName+planResourceSuffix, i.main, i.variables); err != nil {
+		return err
+	}
+
+	_, err := gardenerterraformer.CreateOrUpdateTFVarsSecret(ctx, i.client, config.Namespace, config.VariablesName+planResourceSuffix, i.tfVars)
+	return err
+}
+
 type factory struct{}
 
 // NewForConfig implements Factory.
-func (factory) NewForConfig(logger logrus.FieldLogger, config *rest.Config, purpose, namespace, name, image string) (Interface, error) {
-	tf, err := gardenerterraformer.NewForConfig(logger, config, purpose, namespace, name, image)
+func (f factory) NewForConfig(logger logrus.FieldLogger, config *rest.Config, purpose, namespace, name, image string) (Interface, error) {
+	c, err := client.New(config, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	coreV1Client, err := v1.NewForConfig(config)
 	if err != nil {
 		return nil, err
 	}
 
-	return &terraformer{tf}, nil
+	return f.New(logger, c, coreV1Client, purpose, namespace, name, image), nil
 }
 
 // New implements Factory.
 func (factory) New(logger logrus.FieldLogger, client client.Client, coreV1Client v1.CoreV1Interface, purpose, namespace, name, image string) Interface {
-	return &terraformer{gardenerterraformer.New(logger, client, coreV1Client, purpose, namespace, name, image)}
+	return &terraformer{
+		tf: gardenerterraformer.New(logger, client, coreV1Client, purpose, namespace, name, image),
+
+		logger:       logger,
+		client:       client,
+		coreV1Client: coreV1Client,
+
+		purpose:   purpose,
+		namespace: namespace,
+		name:      name,
+		image:     image,
+	}
 }
 
 // DefaultInitializer implements Factory.
@@ -85,6 +160,14 @@ func (factory) DefaultInitializer(c client.Client, main, variables string, tfVar
 	return initializerFunc(gardenerterraformer.DefaultInitializer(c, main, variables, tfVars))
 }
 
+// PlanInitializer implements Factory.
+//
+// Contrary to the DefaultInitializer, it leaves the configuration, variables and state of the last
+// Apply untouched, i.e. the returned Initializer can only be used to Plan.
+func (factory) PlanInitializer(c client.Client, main, variables string, tfVars []byte) Initializer {
+	return &planInitializer{c, main, variables, tfVars}
+}
+
 // DefaultFactory returns the default factory.
 func DefaultFactory() Factory {
 	return factory{}
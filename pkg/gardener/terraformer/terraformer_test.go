@@ -0,0 +1,159 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer_test
+
+import (
+	"context"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	. "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Terraformer", func() {
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#PlanInitializer", func() {
+		It("should only create the plan configuration and variables", func() {
+			var (
+				notFound = apierrors.NewNotFound(schema.GroupResource{}, "")
+				config   = &gardenerterraformer.InitializerConfig{
+					Namespace:         "shoot--foo--bar",
+					ConfigurationName: "bar.infra.tf-config",
+					VariablesName:     "bar.infra.tf-vars",
+					StateName:         "bar.infra.tf-state",
+					InitializeState:   true,
+				}
+			)
+
+			gomock.InOrder(
+				c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: "shoot--foo--bar", Name: "bar.infra.tf-config-plan"}, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).Return(notFound),
+				c.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, configMap *corev1.ConfigMap) error {
+					Expect(configMap.Name).To(Equal("bar.infra.tf-config-plan"))
+					Expect(configMap.Data).To(Equal(map[string]string{
+						gardenerterraformer.MainKey:      "main",
+						gardenerterraformer.VariablesKey: "variables",
+					}))
+					return nil
+				}),
+				c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: "shoot--foo--bar", Name: "bar.infra.tf-vars-plan"}, gomock.AssignableToTypeOf(&corev1.Secret{})).Return(notFound),
+				c.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(func(_ context.Context, secret *corev1.Secret) error {
+					Expect(secret.Name).To(Equal("bar.infra.tf-vars-plan"))
+					Expect(secret.Data).To(Equal(map[string][]byte{gardenerterraformer.TFVarsKey: []byte("tfvars")}))
+					return nil
+				}),
+			)
+
+			initializer := DefaultFactory().PlanInitializer(c, "main", "variables", []byte("tfvars"))
+			Expect(initializer.Initialize(config)).To(Succeed())
+		})
+	})
+
+	Describe("#Plan", func() {
+		var (
+			notFound = apierrors.NewNotFound(schema.GroupResource{}, "")
+			podKey   = client.ObjectKey{Namespace: "shoot--foo--bar", Name: "bar.infra.tf-plan"}
+
+			newPlanTerraformer = func() Interface {
+				c.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.ConfigMap{})).Return(notFound).AnyTimes()
+				c.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).Return(notFound).AnyTimes()
+				c.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&corev1.ConfigMap{})).AnyTimes()
+				c.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).AnyTimes()
+
+				return DefaultFactory().
+					New(logrus.New(), c, nil, "infra", "shoot--foo--bar", "bar", "terraformer").
+					InitializeWith(DefaultFactory().PlanInitializer(c, "main", "variables", []byte("tfvars")))
+			}
+			expectPending = func(err error) {
+				requeueAfter, ok := err.(*controllererror.RequeueAfterError)
+				Expect(ok).To(BeTrue())
+				Expect(requeueAfter.Cause).To(BeNil())
+			}
+		)
+
+		It("should start the plan pod without waiting for it", func() {
+			tf := newPlanTerraformer()
+
+			gomock.InOrder(
+				c.EXPECT().Get(gomock.Any(), podKey, gomock.AssignableToTypeOf(&corev1.Pod{})).Return(notFound),
+				c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: "shoot--foo--bar", Name: "terraformer"}, gomock.AssignableToTypeOf(&corev1.ServiceAccount{})).Return(notFound),
+				c.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&corev1.ServiceAccount{})),
+				c.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Pod{})).DoAndReturn(func(_ context.Context, pod *corev1.Pod) error {
+					Expect(pod.Name).To(Equal(podKey.Name))
+					return nil
+				}),
+			)
+
+			_, err := tf.Plan()
+			expectPending(err)
+		})
+
+		It("should not wait for a running plan pod", func() {
+			tf := newPlanTerraformer()
+
+			c.EXPECT().Get(gomock.Any(), podKey, gomock.AssignableToTypeOf(&corev1.Pod{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, pod *corev1.Pod) error {
+				pod.Name = podKey.Name
+				pod.Status.Phase = corev1.PodRunning
+				return nil
+			})
+
+			_, err := tf.Plan()
+			expectPending(err)
+		})
+
+		It("should clean up and fail if the plan pod completed without exit code", func() {
+			tf := newPlanTerraformer()
+
+			gomock.InOrder(
+				c.EXPECT().Get(gomock.Any(), podKey, gomock.AssignableToTypeOf(&corev1.Pod{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, pod *corev1.Pod) error {
+					pod.Name = podKey.Name
+					pod.Status.Phase = corev1.PodFailed
+					return nil
+				}),
+				c.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Pod{})),
+				c.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&corev1.ConfigMap{})),
+				c.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})),
+			)
+
+			_, err := tf.Plan()
+			Expect(err).To(HaveOccurred())
+			_, pending := err.(*controllererror.RequeueAfterError)
+			Expect(pending).To(BeFalse())
+		})
+	})
+})
@@ -30,6 +30,7 @@ type Interface interface {
 	Destroy() error
 	GetStateOutputVariables(variables ...string) (map[string]string, error)
 	ConfigExists() (bool, error)
+	Plan() (*Plan, error)
 }
 
 // Factory is a factory that can produce Interface and Initializer.
@@ -37,9 +38,46 @@ type Factory interface {
 	NewForConfig(logger logrus.FieldLogger, config *rest.Config, purpose, namespace, name, image string) (Interface, error)
 	New(logger logrus.FieldLogger, client client.Client, coreV1Client corev1client.CoreV1Interface, purpose, namespace, name, image string) Interface
 	DefaultInitializer(c client.Client, main, variables string, tfVars []byte) Initializer
+	PlanInitializer(c client.Client, main, variables string, tfVars []byte) Initializer
 }
 
 // Initializer can initialize an Interface.
 type Initializer interface {
 	Initialize(config *gardenerterraformer.InitializerConfig) error
 }
+
+// ChangeAction is an action Terraform plans to perform on a resource.
+type ChangeAction string
+
+const (
+	// ChangeActionCreate is the action of creating a new resource.
+	ChangeActionCreate ChangeAction = "create"
+	// ChangeActionUpdate is the action of updating an existing resource in-place.
+	ChangeActionUpdate ChangeAction = "update"
+	// ChangeActionReplace is the action of destroying and re-creating an existing resource.
+	ChangeActionReplace ChangeAction = "replace"
+	// ChangeActionDelete is the action of destroying an existing resource.
+	ChangeActionDelete ChangeAction = "delete"
+	// ChangeActionRead is the action of reading a data source.
+	ChangeActionRead ChangeAction = "read"
+)
+
+// ResourceChange is a change Terraform plans to perform on a single resource.
+type ResourceChange struct {
+	// Address is the address of the resource, e.g. `aws_vpc.vpc`.
+	Address string
+	// Action is the action that is planned for the resource.
+	Action ChangeAction
+}
+
+// Plan is the structured result of a Terraform plan.
+type Plan struct {
+	// ToAdd is the number of resources that will be created.
+	ToAdd int
+	// ToChange is the number of resources that will be updated in-place.
+	ToChange int
+	// ToDestroy is the number of resources that will be destroyed.
+	ToDestroy int
+	// ResourceChanges are the planned changes of the single resources.
+	ResourceChanges []ResourceChange
+}
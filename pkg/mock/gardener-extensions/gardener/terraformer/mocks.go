@@ -114,6 +114,21 @@ func (mr *MockInterfaceMockRecorder) InitializeWith(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitializeWith", reflect.TypeOf((*MockInterface)(nil).InitializeWith), arg0)
 }
 
+// Plan mocks base method
+func (m *MockInterface) Plan() (*terraformer.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan")
+	ret0, _ := ret[0].(*terraformer.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan
+func (mr *MockInterfaceMockRecorder) Plan() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockInterface)(nil).Plan))
+}
+
 // SetVariablesEnvironment mocks base method
 func (m *MockInterface) SetVariablesEnvironment(arg0 map[string]string) terraformer.Interface {
 	m.ctrl.T.Helper()
@@ -230,3 +245,17 @@ func (mr *MockFactoryMockRecorder) NewForConfig(arg0, arg1, arg2, arg3, arg4, ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewForConfig", reflect.TypeOf((*MockFactory)(nil).NewForConfig), arg0, arg1, arg2, arg3, arg4, arg5)
 }
+
+// PlanInitializer mocks base method
+func (m *MockFactory) PlanInitializer(arg0 client.Client, arg1, arg2 string, arg3 []byte) terraformer.Initializer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanInitializer", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(terraformer.Initializer)
+	return ret0
+}
+
+// PlanInitializer indicates an expected call of PlanInitializer
+func (mr *MockFactoryMockRecorder) PlanInitializer(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanInitializer", reflect.TypeOf((*MockFactory)(nil).PlanInitializer), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chart
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gobuffalo/packr/v2"
+)
+
+// Source is a source of Helm charts.
+type Source interface {
+	// Archive returns the chart at the given path as gzipped tarball. It returns false if the source does not
+	// contain the chart.
+	Archive(chartPath string) ([]byte, bool, error)
+}
+
+type boxSource struct {
+	path string
+	box  *packr.Box
+
+	lock     sync.Mutex
+	archives map[string][]byte
+}
+
+// NewBoxSource returns a Source for the charts embedded in the given packr box. The root of the box corresponds to
+// the given path, e.g. "controllers/provider-aws/charts".
+func NewBoxSource(path string, box *packr.Box) Source {
+	return &boxSource{
+		path:     filepath.Clean(path),
+		box:      box,
+		archives: make(map[string][]byte),
+	}
+}
+
+// Archive implements Source.
+func (s *boxSource) Archive(chartPath string) ([]byte, bool, error) {
+	name, err := filepath.Rel(s.path, filepath.Clean(chartPath))
+	if err != nil || name == "." || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+		return nil, false, nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if archive, ok := s.archives[name]; ok {
+		return archive, true, nil
+	}
+
+	var (
+		buf       bytes.Buffer
+		gzw       = gzip.NewWriter(&buf)
+		tw        = tar.NewWriter(gzw)
+		prefix    = name + string(filepath.Separator)
+		chartName = filepath.Base(name)
+		found     bool
+	)
+
+	if err := s.box.Walk(func(file string, _ packr.File) error {
+		if !strings.HasPrefix(file, prefix) {
+			return nil
+		}
+
+		data, err := s.box.Find(file)
+		if err != nil {
+			return err
+		}
+
+		if err := tw.WriteHeader(&tar.Header{
+			Name: path.Join(chartName, filepath.ToSlash(strings.TrimPrefix(file, prefix))),
+			Mode: 0644,
+			Size: int64(len(data)),
+		}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return err
+		}
+
+		found = true
+		return nil
+	}); err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, false, err
+	}
+	if err := gzw.Close(); err != nil {
+		return nil, false, err
+	}
+
+	s.archives[name] = buf.Bytes()
+	return s.archives[name], true, nil
+}
+
+var (
+	sourcesLock sync.RWMutex
+	sources     []Source
+)
+
+// RegisterSource registers the given Source. The charts it contains are rendered from there instead of the file
+// system by the chart renderers and appliers of this repository, so that binaries do not depend on chart files.
+func RegisterSource(source Source) {
+	sourcesLock.Lock()
+	defer sourcesLock.Unlock()
+	sources = append(sources, source)
+}
+
+type registeredSources struct{}
+
+// DefaultSource returns a Source containing the charts of all registered sources.
+func DefaultSource() Source {
+	return registeredSources{}
+}
+
+// Archive implements Source.
+func (registeredSources) Archive(chartPath string) ([]byte, bool, error) {
+	sourcesLock.RLock()
+	defer sourcesLock.RUnlock()
+
+	for _, source := range sources {
+		archive, ok, err := source.Archive(chartPath)
+		if err != nil || ok {
+			return archive, ok, err
+		}
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chart_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"path/filepath"
+
+	extensionschartrenderer "github.com/gardener/gardener-extensions/pkg/gardener/chartrenderer"
+	. "github.com/gardener/gardener-extensions/pkg/util/chart"
+
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gobuffalo/packr/v2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/helm/pkg/chartutil"
+	"k8s.io/helm/pkg/engine"
+)
+
+var _ = Describe("Source", func() {
+	var (
+		chartsPath = filepath.Join("pkg", "util", "chart", "testdata", "charts")
+		box        = packr.New("test-charts", "./testdata/charts")
+	)
+
+	files := func(archive []byte) []string {
+		gzr, err := gzip.NewReader(bytes.NewReader(archive))
+		Expect(err).NotTo(HaveOccurred())
+
+		var (
+			tr    = tar.NewReader(gzr)
+			names []string
+		)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				return names
+			}
+			Expect(err).NotTo(HaveOccurred())
+			names = append(names, header.Name)
+		}
+	}
+
+	Describe("#NewBoxSource", func() {
+		It("should archive the chart including symlinked subcharts", func() {
+			source := NewBoxSource(chartsPath, box)
+
+			archive, ok, err := source.Archive(filepath.Join(chartsPath, "test"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(files(archive)).To(ConsistOf(
+				"test/Chart.yaml",
+				"test/values.yaml",
+				"test/templates/configmap.yaml",
+				"test/charts/utils-labels/Chart.yaml",
+				"test/charts/utils-labels/templates/_labels.tpl",
+			))
+		})
+
+		It("should not contain charts outside of its path", func() {
+			source := NewBoxSource(chartsPath, box)
+
+			for _, chartPath := range []string{
+				chartsPath,
+				filepath.Join(chartsPath, "missing"),
+				filepath.Join("controllers", "provider-aws", "charts", "test"),
+			} {
+				archive, ok, err := source.Archive(chartPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ok).To(BeFalse())
+				Expect(archive).To(BeNil())
+			}
+		})
+	})
+
+	Describe("#DefaultSource", func() {
+		It("should contain the charts of the registered sources", func() {
+			chartPath := filepath.Join(chartsPath, "test")
+
+			_, ok, err := DefaultSource().Archive(chartPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+
+			RegisterSource(NewBoxSource(chartsPath, box))
+
+			_, ok, err = DefaultSource().Archive(chartPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+		})
+	})
+
+	Describe("#NewWithSource", func() {
+		It("should render the chart from the source", func() {
+			renderer := extensionschartrenderer.NewWithSource(
+				chartrenderer.New(engine.New(), &chartutil.Capabilities{}),
+				NewBoxSource(chartsPath, box),
+			)
+
+			rendered, err := renderer.Render(filepath.Join(chartsPath, "test"), "test", "default", map[string]interface{}{"data": "baz"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rendered.FileContent("configmap.yaml")).To(Equal(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test
+  namespace: default
+  labels:
+    app: test
+data:
+  foo: baz
+`))
+		})
+	})
+})
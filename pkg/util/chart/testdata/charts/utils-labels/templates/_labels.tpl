@@ -0,0 +1,3 @@
+{{- define "utils-labels.labels" -}}
+app: test
+{{- end -}}
@@ -0,0 +1,38 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	extensionschartrenderer "github.com/gardener/gardener-extensions/pkg/gardener/chartrenderer"
+
+	gardenerkubernetes "github.com/gardener/gardener/pkg/client/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// NewChartApplierForConfig creates a new chart applier for the given config. Charts contained in a registered
+// chart source are rendered from there instead of the file system.
+func NewChartApplierForConfig(config *rest.Config) (gardenerkubernetes.ChartApplier, error) {
+	renderer, err := extensionschartrenderer.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	applier, err := gardenerkubernetes.NewApplierForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return gardenerkubernetes.NewChartApplier(renderer, applier), nil
+}
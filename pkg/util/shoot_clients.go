@@ -17,6 +17,9 @@ package util
 import (
 	"context"
 
+	extensionschartrenderer "github.com/gardener/gardener-extensions/pkg/gardener/chartrenderer"
+	"github.com/gardener/gardener-extensions/pkg/util/chart"
+
 	corev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	gardener "github.com/gardener/gardener/pkg/client/kubernetes"
@@ -92,7 +95,7 @@ func NewClientsForShoot(ctx context.Context, c client.Client, namespace string,
 	if err != nil {
 		return nil, err
 	}
-	shootChartApplier, err := gardener.NewChartApplierForConfig(shootRESTConfig)
+	shootChartApplier, err := NewChartApplierForConfig(shootRESTConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -112,5 +115,5 @@ func NewChartRendererForShoot(version string) (chartrenderer.Interface, error) {
 	if err != nil {
 		return nil, err
 	}
-	return chartrenderer.New(engine.New(), &chartutil.Capabilities{KubeVersion: v}), nil
+	return extensionschartrenderer.NewWithSource(chartrenderer.New(engine.New(), &chartutil.Capabilities{KubeVersion: v}), chart.DefaultSource()), nil
 }
@@ -0,0 +1,99 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Bucket is an in-memory bucket of an object store.
+type Bucket struct {
+	// Region is the region the bucket was created in.
+	Region string
+	// Objects maps object keys to their content.
+	Objects map[string][]byte
+}
+
+// Store is an in-memory object store. It can be used as fake for the storage clients of the
+// provider backup actuators in tests.
+type Store struct {
+	lock    sync.Mutex
+	buckets map[string]*Bucket
+}
+
+// New creates a new, empty in-memory Store.
+func New() *Store {
+	return &Store{buckets: make(map[string]*Bucket)}
+}
+
+// Bucket returns the bucket with the given name, or nil if it does not exist.
+func (s *Store) Bucket(name string) *Bucket {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.buckets[name]
+}
+
+// PutObject stores the given content under the given key in the bucket.
+func (s *Store) PutObject(bucket, key string, content []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	b, ok := s.buckets[bucket]
+	if !ok {
+		return fmt.Errorf("bucket %q does not exist", bucket)
+	}
+	b.Objects[key] = content
+	return nil
+}
+
+// CreateBucketIfNotExists creates the bucket with the given name in the given region if it does not exist yet.
+func (s *Store) CreateBucketIfNotExists(_ context.Context, bucket, region string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.buckets[bucket]; !ok {
+		s.buckets[bucket] = &Bucket{Region: region, Objects: make(map[string][]byte)}
+	}
+	return nil
+}
+
+// DeleteBucketIfExists deletes the bucket with the given name including all its objects if it exists.
+func (s *Store) DeleteBucketIfExists(_ context.Context, bucket string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.buckets, bucket)
+	return nil
+}
+
+// DeleteObjectsWithPrefix deletes all objects of the given bucket whose key starts with the given prefix.
+func (s *Store) DeleteObjectsWithPrefix(_ context.Context, bucket, prefix string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	b, ok := s.buckets[bucket]
+	if !ok {
+		return nil
+	}
+	for key := range b.Objects {
+		if strings.HasPrefix(key, prefix) {
+			delete(b.Objects, key)
+		}
+	}
+	return nil
+}
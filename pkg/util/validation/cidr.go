@@ -0,0 +1,86 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateCIDR validates that the given value is a network in CIDR notation.
+func ValidateCIDR(cidr string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(cidr) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "field is required"))
+		return allErrs
+	}
+
+	if _, _, err := net.ParseCIDR(cidr); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, cidr, "must be a valid CIDR"))
+	}
+
+	return allErrs
+}
+
+// ValidateCIDRIsSubset validates that the given CIDR is contained in the given network.
+// CIDRs that cannot be parsed are ignored, they are reported by ValidateCIDR.
+func ValidateCIDRIsSubset(cidr string, fldPath *field.Path, network, networkName string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	subnet, superset, ok := parseCIDRs(cidr, network)
+	if !ok {
+		return allErrs
+	}
+
+	subnetOnes, _ := subnet.Mask.Size()
+	supersetOnes, _ := superset.Mask.Size()
+	if !superset.Contains(subnet.IP) || subnetOnes < supersetOnes {
+		allErrs = append(allErrs, field.Invalid(fldPath, cidr, fmt.Sprintf("must be a subset of the %s (%s)", networkName, network)))
+	}
+
+	return allErrs
+}
+
+// ValidateCIDRDisjoint validates that the given CIDR does not overlap with the given other network.
+// CIDRs that cannot be parsed are ignored, they are reported by ValidateCIDR.
+func ValidateCIDRDisjoint(cidr string, fldPath *field.Path, other, otherName string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	a, b, ok := parseCIDRs(cidr, other)
+	if !ok {
+		return allErrs
+	}
+
+	if a.Contains(b.IP) || b.Contains(a.IP) {
+		allErrs = append(allErrs, field.Invalid(fldPath, cidr, fmt.Sprintf("must not overlap with the %s (%s)", otherName, other)))
+	}
+
+	return allErrs
+}
+
+func parseCIDRs(a, b string) (*net.IPNet, *net.IPNet, bool) {
+	_, netA, err := net.ParseCIDR(a)
+	if err != nil {
+		return nil, nil, false
+	}
+	_, netB, err := net.ParseCIDR(b)
+	if err != nil {
+		return nil, nil, false
+	}
+	return netA, netB, true
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("CIDR", func() {
+	fldPath := field.NewPath("cidr")
+
+	DescribeTable("#ValidateCIDR",
+		func(cidr string, matcher OmegaMatcher) {
+			Expect(ValidateCIDR(cidr, fldPath)).To(matcher)
+		},
+
+		Entry("valid CIDR", "10.250.0.0/16", BeEmpty()),
+		Entry("empty CIDR", "", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("cidr"),
+		})))),
+		Entry("invalid CIDR", "10.250.0.0", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("cidr"),
+		})))),
+	)
+
+	DescribeTable("#ValidateCIDRIsSubset",
+		func(cidr, network string, matcher OmegaMatcher) {
+			Expect(ValidateCIDRIsSubset(cidr, fldPath, network, "network")).To(matcher)
+		},
+
+		Entry("subset", "10.250.0.0/19", "10.250.0.0/16", BeEmpty()),
+		Entry("equal", "10.250.0.0/16", "10.250.0.0/16", BeEmpty()),
+		Entry("disjoint", "10.251.0.0/19", "10.250.0.0/16", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("cidr"),
+		})))),
+		Entry("superset", "10.0.0.0/8", "10.250.0.0/16", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("cidr"),
+		})))),
+		Entry("invalid CIDR", "foo", "10.250.0.0/16", BeEmpty()),
+	)
+
+	DescribeTable("#ValidateCIDRDisjoint",
+		func(cidr, other string, matcher OmegaMatcher) {
+			Expect(ValidateCIDRDisjoint(cidr, fldPath, other, "other network")).To(matcher)
+		},
+
+		Entry("disjoint", "10.250.0.0/16", "100.96.0.0/11", BeEmpty()),
+		Entry("subset", "100.96.0.0/16", "100.96.0.0/11", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("cidr"),
+		})))),
+		Entry("superset", "100.0.0.0/8", "100.96.0.0/11", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("cidr"),
+		})))),
+		Entry("invalid CIDR", "foo", "100.96.0.0/11", BeEmpty()),
+	)
+})
@@ -0,0 +1,221 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+const (
+	// DataKeyCABundle is the key in the secret data holding the CA bundle, i.e. the current CA certificate and,
+	// after a rotation of the CA, the previous CA certificate until it expires.
+	DataKeyCABundle = "bundle.crt"
+
+	// RotationThreshold is the remaining validity below which the certificates are rotated. It has to be larger than the
+	// six months of remaining validity the webhook server requires, otherwise the server replaces the certificates in its
+	// certificate directory with self-generated ones that are not stored in the secret.
+	RotationThreshold = 365 * 24 * time.Hour
+	// RotationCheckInterval is the interval in which the certificates are checked for rotation.
+	RotationCheckInterval = 24 * time.Hour
+
+	// The file names in the certificate directory of the webhook server.
+	caKeyFileName      = "ca-key.pem"
+	caCertFileName     = "ca-cert.pem"
+	serverKeyFileName  = "key.pem"
+	serverCertFileName = "cert.pem"
+
+	maxRetries = 3
+)
+
+var logger = log.Log.WithName("webhook-certificates")
+
+// Ensure makes sure that the secret <namespace>/<name> contains a CA and a serving certificate for the given
+// DNS name signed by this CA that are still valid for longer than the RotationThreshold. Otherwise, new certificates
+// are generated and stored in the secret. Finally, the certificates are written to the given certificate directory,
+// from where the webhook server serves them and injects the CA bundle into the webhook configurations.
+// As the certificates are stored in a secret, all replicas of the webhook server use the same certificates.
+func Ensure(ctx context.Context, c client.Client, namespace, name, certDir, dnsName string) error {
+	var (
+		secret *corev1.Secret
+		err    error
+	)
+
+	for i := 0; i < maxRetries; i++ {
+		secret, err = ensureSecret(ctx, c, namespace, name, dnsName)
+		// Another replica of the webhook server might have created or updated the secret concurrently.
+		if !apierrors.IsAlreadyExists(err) && !apierrors.IsConflict(err) {
+			break
+		}
+	}
+	if err != nil {
+		return errors.Wrapf(err, "could not ensure webhook server certificates in secret %s/%s", namespace, name)
+	}
+
+	return writeCertDir(certDir, secret.Data)
+}
+
+// NewRotator creates a new manager.Runnable that checks the certificates of the webhook server every
+// RotationCheckInterval and rotates them before they expire, see Ensure.
+func NewRotator(c client.Client, namespace, name, certDir, dnsName string) manager.Runnable {
+	return manager.RunnableFunc(func(stopCh <-chan struct{}) error {
+		ctx := util.ContextFromStopChannel(stopCh)
+		wait.Until(func() {
+			if err := Ensure(ctx, c, namespace, name, certDir, dnsName); err != nil {
+				logger.Error(err, "Could not rotate the webhook server certificates")
+			}
+		}, RotationCheckInterval, stopCh)
+		return nil
+	})
+}
+
+func ensureSecret(ctx context.Context, c client.Client, namespace, name, dnsName string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, kutil.Key(namespace, name), secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+
+	data, changed, err := ComputeSecretData(secret.Data, dnsName, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return secret, nil
+	}
+
+	logger.Info("Generating new webhook server certificates", "secret", namespace+"/"+name)
+	secret.Type = corev1.SecretTypeOpaque
+	secret.Data = data
+	if len(secret.ResourceVersion) == 0 {
+		return secret, c.Create(ctx, secret)
+	}
+	return secret, c.Update(ctx, secret)
+}
+
+// ComputeSecretData computes the data of the certificates secret from the given existing data. It returns whether the
+// data has changed, i.e. whether the CA or the serving certificate for the given DNS name had to be (re-)generated
+// because they were missing, invalid, or about to expire at the given time.
+func ComputeSecretData(data map[string][]byte, dnsName string, now time.Time) (map[string][]byte, bool, error) {
+	var (
+		rotateBefore = now.Add(RotationThreshold)
+		changed      bool
+	)
+
+	ca, err := secrets.LoadCertificate("", data[secrets.DataKeyPrivateKeyCA], data[secrets.DataKeyCertificateCA])
+	if err != nil || ca.Certificate.NotAfter.Before(rotateBefore) {
+		ca, err = (&secrets.CertificateSecretConfig{
+			CommonName: "webhook-ca",
+			CertType:   secrets.CACert,
+		}).GenerateCertificate()
+		if err != nil {
+			return nil, false, errors.Wrap(err, "could not generate CA certificate")
+		}
+		changed = true
+	}
+
+	server, err := secrets.LoadCertificate("", data[secrets.DataKeyPrivateKey], data[secrets.DataKeyCertificate])
+	if changed || err != nil || server.Certificate.NotAfter.Before(rotateBefore) ||
+		server.Certificate.VerifyHostname(dnsName) != nil || server.Certificate.CheckSignatureFrom(ca.Certificate) != nil {
+		server, err = (&secrets.CertificateSecretConfig{
+			CommonName: dnsName,
+			DNSNames:   []string{dnsName},
+			CertType:   secrets.ServerCert,
+			SigningCA:  ca,
+		}).GenerateCertificate()
+		if err != nil {
+			return nil, false, errors.Wrap(err, "could not generate server certificate")
+		}
+		changed = true
+	}
+
+	// Keep trusting the previous CA certificates until they expire, so that the replicas of the webhook server
+	// that still serve a certificate signed by them keep working until they pick up the rotated certificates.
+	bundle := ca.CertificatePEM
+	for _, cert := range decodeCertificates(data[DataKeyCABundle]) {
+		if certPEM := utils.EncodeCertificate(cert.Raw); !bytes.Equal(certPEM, ca.CertificatePEM) && cert.NotAfter.After(now) {
+			bundle = append(bundle, certPEM...)
+		}
+	}
+	if !bytes.Equal(bundle, data[DataKeyCABundle]) {
+		changed = true
+	}
+
+	return map[string][]byte{
+		secrets.DataKeyCertificateCA: ca.CertificatePEM,
+		secrets.DataKeyPrivateKeyCA:  ca.PrivateKeyPEM,
+		DataKeyCABundle:              bundle,
+		secrets.DataKeyCertificate:   server.CertificatePEM,
+		secrets.DataKeyPrivateKey:    server.PrivateKeyPEM,
+	}, changed, nil
+}
+
+// decodeCertificates decodes all PEM-encoded certificates in the given data, skipping those that cannot be parsed.
+func decodeCertificates(data []byte) []*x509.Certificate {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs
+		}
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			certs = append(certs, cert)
+		}
+	}
+}
+
+// writeCertDir writes the certificates in the given secret data to the given certificate directory,
+// using the file names the webhook server expects.
+func writeCertDir(certDir string, data map[string][]byte) error {
+	if err := os.MkdirAll(certDir, 0700); err != nil {
+		return errors.Wrapf(err, "could not create webhook server certificate directory %s", certDir)
+	}
+
+	for fileName, content := range map[string][]byte{
+		caKeyFileName:      data[secrets.DataKeyPrivateKeyCA],
+		caCertFileName:     data[DataKeyCABundle],
+		serverKeyFileName:  data[secrets.DataKeyPrivateKey],
+		serverCertFileName: data[secrets.DataKeyCertificate],
+	} {
+		if err := ioutil.WriteFile(filepath.Join(certDir, fileName), content, 0600); err != nil {
+			return errors.Wrapf(err, "could not write webhook server certificate file %s", fileName)
+		}
+	}
+	return nil
+}
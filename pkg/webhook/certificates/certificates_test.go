@@ -0,0 +1,108 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificates_test
+
+import (
+	"crypto/x509"
+	"time"
+
+	. "github.com/gardener/gardener-extensions/pkg/webhook/certificates"
+
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Certificates", func() {
+	const dnsName = "gardener-extension-provider-foo.garden.svc"
+
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Now()
+	})
+
+	decode := func(certPEM []byte) *x509.Certificate {
+		cert, err := utils.DecodeCertificate(certPEM)
+		Expect(err).NotTo(HaveOccurred())
+		return cert
+	}
+
+	Describe("#ComputeSecretData", func() {
+		It("should generate a CA and a serving certificate if there are none", func() {
+			data, changed, err := ComputeSecretData(nil, dnsName, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+
+			ca := decode(data[secrets.DataKeyCertificateCA])
+			Expect(ca.IsCA).To(BeTrue())
+			Expect(data[DataKeyCABundle]).To(Equal(data[secrets.DataKeyCertificateCA]))
+
+			server := decode(data[secrets.DataKeyCertificate])
+			Expect(server.VerifyHostname(dnsName)).To(Succeed())
+			Expect(server.CheckSignatureFrom(ca)).To(Succeed())
+		})
+
+		It("should keep valid certificates", func() {
+			data, _, err := ComputeSecretData(nil, dnsName, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			newData, changed, err := ComputeSecretData(data, dnsName, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeFalse())
+			Expect(newData).To(Equal(data))
+		})
+
+		It("should regenerate the serving certificate but keep the CA if the DNS name changed", func() {
+			data, _, err := ComputeSecretData(nil, dnsName, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			newData, changed, err := ComputeSecretData(data, "foo.bar.svc", now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+			Expect(newData[secrets.DataKeyCertificateCA]).To(Equal(data[secrets.DataKeyCertificateCA]))
+			Expect(newData[DataKeyCABundle]).To(Equal(data[DataKeyCABundle]))
+			Expect(decode(newData[secrets.DataKeyCertificate]).VerifyHostname("foo.bar.svc")).To(Succeed())
+		})
+
+		It("should rotate the certificates before they expire and keep trusting the previous CA", func() {
+			data, _, err := ComputeSecretData(nil, dnsName, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			oldCA := decode(data[secrets.DataKeyCertificateCA])
+			later := oldCA.NotAfter.Add(-RotationThreshold / 2)
+
+			newData, changed, err := ComputeSecretData(data, dnsName, later)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+			Expect(newData[secrets.DataKeyCertificateCA]).NotTo(Equal(data[secrets.DataKeyCertificateCA]))
+			Expect(newData[secrets.DataKeyCertificate]).NotTo(Equal(data[secrets.DataKeyCertificate]))
+			Expect(newData[DataKeyCABundle]).To(Equal(append(newData[secrets.DataKeyCertificateCA], data[secrets.DataKeyCertificateCA]...)))
+		})
+
+		It("should drop expired CA certificates from the bundle", func() {
+			data, _, err := ComputeSecretData(nil, dnsName, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			later := decode(data[secrets.DataKeyCertificateCA]).NotAfter.Add(time.Hour)
+
+			newData, changed, err := ComputeSecretData(data, dnsName, later)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+			Expect(newData[DataKeyCABundle]).To(Equal(newData[secrets.DataKeyCertificateCA]))
+		})
+	})
+})
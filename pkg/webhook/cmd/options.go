@@ -15,13 +15,17 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	extensionwebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	"github.com/gardener/gardener-extensions/pkg/webhook/certificates"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -42,6 +46,9 @@ const (
 	ServiceSelectorsFlag = "webhook-config-service-selectors"
 	// HostFlag is the name of the command line flag to specify the webhook config host for 'url' mode.
 	HostFlag = "webhook-config-host"
+	// CertSecretFlag is the name of the command line flag to specify the name of the secret in the webhook config namespace
+	// that stores the generated webhook server certificates.
+	CertSecretFlag = "webhook-server-cert-secret"
 
 	// DisableFlag is the name of the command line flag to disable individual webhooks.
 	DisableFlag = "disable-webhooks"
@@ -53,6 +60,9 @@ const (
 	URLMode     = "url"
 )
 
+// defaultCertDir is the certificate directory the webhook server uses if none is specified.
+var defaultCertDir = filepath.Join("k8s-webhook-server", "cert")
+
 // ServerOptions are command line options that can be set for ServerConfig.
 type ServerOptions struct {
 	// Port is the webhook server port.
@@ -69,6 +79,8 @@ type ServerOptions struct {
 	ServiceSelectors string
 	// Host is the webhook config host for 'url' mode.
 	Host string
+	// CertSecret is the name of the secret in the webhook config namespace that stores the generated webhook server certificates.
+	CertSecret string
 
 	config *ServerConfig
 }
@@ -81,6 +93,19 @@ type ServerConfig struct {
 	CertDir string
 	// BootstrapOptions contains the options for bootstrapping the webhook server.
 	BootstrapOptions *webhook.BootstrapOptions
+	// Certificates contains the configuration for generating and rotating the webhook server certificates.
+	// If nil, the certificates are expected in the certificate directory.
+	Certificates *CertificatesConfig
+}
+
+// CertificatesConfig is the configuration for generating and rotating the webhook server certificates.
+type CertificatesConfig struct {
+	// SecretNamespace is the namespace of the secret that stores the certificates.
+	SecretNamespace string
+	// SecretName is the name of the secret that stores the certificates.
+	SecretName string
+	// DNSName is the DNS name the serving certificate is generated for.
+	DNSName string
 }
 
 // Complete implements Completer.Complete.
@@ -90,10 +115,21 @@ func (w *ServerOptions) Complete() error {
 		return err
 	}
 
+	certificates, err := w.buildCertificatesConfig()
+	if err != nil {
+		return err
+	}
+
+	certDir := w.CertDir
+	if certificates != nil && len(certDir) == 0 {
+		certDir = defaultCertDir
+	}
+
 	w.config = &ServerConfig{
 		Port:             w.Port,
-		CertDir:          w.CertDir,
+		CertDir:          certDir,
 		BootstrapOptions: bootstrapOptions,
+		Certificates:     certificates,
 	}
 	return nil
 }
@@ -121,6 +157,8 @@ func (w *ServerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&w.Namespace, NamespaceFlag, w.Namespace, "The webhook config namespace for 'service' mode.")
 	fs.StringVar(&w.ServiceSelectors, ServiceSelectorsFlag, w.ServiceSelectors, "The webhook config service selectors as JSON for 'service' mode.")
 	fs.StringVar(&w.Host, HostFlag, w.Host, "The webhook config host for 'url' mode.")
+	fs.StringVar(&w.CertSecret, CertSecretFlag, w.CertSecret, "The name of the secret in the webhook config namespace that stores the webhook server certificates. "+
+		"If set, the certificates are generated, rotated before they expire, and written to the certificate directory.")
 }
 
 func (w *ServerOptions) buildBootstrapOptions() (*webhook.BootstrapOptions, error) {
@@ -132,7 +170,8 @@ func (w *ServerOptions) buildBootstrapOptions() (*webhook.BootstrapOptions, erro
 		}
 
 		return &webhook.BootstrapOptions{
-			MutatingWebhookConfigName: w.Name,
+			MutatingWebhookConfigName:   w.Name,
+			ValidatingWebhookConfigName: w.Name,
 			Service: &webhook.Service{
 				Name:      w.Name,
 				Namespace: w.Namespace,
@@ -142,8 +181,9 @@ func (w *ServerOptions) buildBootstrapOptions() (*webhook.BootstrapOptions, erro
 
 	case URLMode:
 		return &webhook.BootstrapOptions{
-			MutatingWebhookConfigName: w.Name,
-			Host:                      &w.Host,
+			MutatingWebhookConfigName:   w.Name,
+			ValidatingWebhookConfigName: w.Name,
+			Host:                        &w.Host,
 		}, nil
 
 	default:
@@ -151,6 +191,31 @@ func (w *ServerOptions) buildBootstrapOptions() (*webhook.BootstrapOptions, erro
 	}
 }
 
+func (w *ServerOptions) buildCertificatesConfig() (*CertificatesConfig, error) {
+	if len(w.CertSecret) == 0 {
+		return nil, nil
+	}
+	if len(w.Namespace) == 0 {
+		return nil, errors.Errorf("webhook config namespace must be specified to store the webhook server certificates in secret '%s'", w.CertSecret)
+	}
+
+	var dnsName string
+	switch w.Mode {
+	case ServiceMode:
+		dnsName = fmt.Sprintf("%s.%s.svc", w.Name, w.Namespace)
+	case URLMode:
+		dnsName = w.Host
+	default:
+		return nil, errors.Errorf("invalid webhook config mode '%s'", w.Mode)
+	}
+
+	return &CertificatesConfig{
+		SecretNamespace: w.Namespace,
+		SecretName:      w.CertSecret,
+		DNSName:         dnsName,
+	}, nil
+}
+
 // NameToFactory binds a specific name to a webhook's factory function.
 type NameToFactory struct {
 	Name string
@@ -275,5 +340,20 @@ func (c *AddToManagerConfig) AddToManager(mgr manager.Manager) error {
 		return errors.Wrapf(err, "could not create webhooks")
 	}
 
+	if certs := c.Server.Certificates; certs != nil && len(webhooks) > 0 {
+		// The cache of the manager is not started yet, hence a direct client is used.
+		cl, err := client.New(mgr.GetConfig(), client.Options{})
+		if err != nil {
+			return errors.Wrapf(err, "could not create client for the webhook server certificates")
+		}
+
+		if err := certificates.Ensure(context.TODO(), cl, certs.SecretNamespace, certs.SecretName, c.Server.CertDir, certs.DNSName); err != nil {
+			return err
+		}
+		if err := mgr.Add(certificates.NewRotator(cl, certs.SecretNamespace, certs.SecretName, c.Server.CertDir, certs.DNSName)); err != nil {
+			return errors.Wrapf(err, "could not add webhook server certificates rotator")
+		}
+	}
+
 	return extensionwebhook.NewServerBuilder(c.serverName, c.Server.Options(), webhooks...).AddToManager(mgr)
 }
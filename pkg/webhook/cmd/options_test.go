@@ -54,6 +54,7 @@ var _ = Describe("Options", func() {
 			namespace        = "default"
 			serviceSelectors = `{"app":"kubernetes"}`
 			host             = "bar"
+			certSecret       = "foo-certs"
 		)
 
 		Describe("#Completed", func() {
@@ -94,7 +95,8 @@ var _ = Describe("Options", func() {
 					Port:    port,
 					CertDir: certDir,
 					BootstrapOptions: &webhook.BootstrapOptions{
-						MutatingWebhookConfigName: name,
+						MutatingWebhookConfigName:   name,
+						ValidatingWebhookConfigName: name,
 						Service: &webhook.Service{
 							Name:      name,
 							Namespace: namespace,
@@ -105,6 +107,53 @@ var _ = Describe("Options", func() {
 			})
 		})
 
+		Describe("#Completed", func() {
+			It("should yield correct ServerConfig with certificates config after completion in service mode", func() {
+				command := test.NewCommandBuilder(name).
+					Flags(
+						test.IntFlag(PortFlag, port),
+						test.StringFlag(ModeFlag, ServiceMode),
+						test.StringFlag(NameFlag, name),
+						test.StringFlag(NamespaceFlag, namespace),
+						test.StringFlag(ServiceSelectorsFlag, serviceSelectors),
+						test.StringFlag(CertSecretFlag, certSecret),
+					).
+					Command().
+					Slice()
+				fs := pflag.NewFlagSet(name, pflag.ExitOnError)
+				opts := ServerOptions{}
+
+				// Parse command into options
+				opts.AddFlags(fs)
+				err := fs.Parse(command)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(opts.CertSecret).To(Equal(certSecret))
+
+				// Complete the options
+				err = opts.Complete()
+				Expect(err).NotTo(HaveOccurred())
+
+				// Check Completed result
+				Expect(opts.Completed().CertDir).To(Equal(defaultCertDir))
+				Expect(opts.Completed().Certificates).To(Equal(&CertificatesConfig{
+					SecretNamespace: namespace,
+					SecretName:      certSecret,
+					DNSName:         name + "." + namespace + ".svc",
+				}))
+			})
+
+			It("should fail to complete with certificates config but without namespace", func() {
+				opts := ServerOptions{
+					Mode:       URLMode,
+					Name:       name,
+					Host:       host,
+					CertSecret: certSecret,
+				}
+
+				Expect(opts.Complete()).To(HaveOccurred())
+			})
+		})
+
 		Describe("#Completed", func() {
 			It("should yield correct ServerConfig after completion in url mode", func() {
 				command := test.NewCommandBuilder(name).
@@ -142,8 +191,9 @@ var _ = Describe("Options", func() {
 					Port:    port,
 					CertDir: certDir,
 					BootstrapOptions: &webhook.BootstrapOptions{
-						MutatingWebhookConfigName: name,
-						Host:                      &h,
+						MutatingWebhookConfigName:   name,
+						ValidatingWebhookConfigName: name,
+						Host:                        &h,
 					},
 				}))
 			})
@@ -17,6 +17,9 @@ package controlplane
 import (
 	"context"
 	"net/http"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/metrics"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
@@ -95,7 +98,9 @@ func (h *handler) Handle(ctx context.Context, req types.Request) types.Response
 	h.logger.Info("Mutating resource", "kind", ar.Kind.String(), "namespace", accessor.GetNamespace(),
 		"name", accessor.GetName(), "operation", ar.Operation)
 	newObj := obj.DeepCopyObject()
+	start := time.Now()
 	err = h.mutator.Mutate(ctx, newObj)
+	metrics.ObserveWebhookMutation(ar.Kind.Kind, start, err)
 	if err != nil {
 		return admission.ErrorResponse(http.StatusInternalServerError,
 			errors.Wrapf(err, "could not mutate %s %s/%s", ar.Kind.Kind, accessor.GetNamespace(), accessor.GetName()))
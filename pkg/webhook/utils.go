@@ -53,6 +53,9 @@ const (
 	ShootKind Kind = "shoot"
 	// A backup webhook is applied only to those shoot namespaces that have the correct Backup provider label.
 	BackupKind Kind = "backup"
+	// A validation webhook is applied only to those shoot namespaces that have the correct Shoot provider label.
+	// It validates the Shoot-derived resources of the provider instead of mutating them.
+	ValidationKind Kind = "validation"
 )
 
 // FactoryAggregator aggregates various Factory functions.
@@ -126,6 +129,17 @@ func (s *ServerBuilder) AddToManager(mgr manager.Manager) error {
 // with the given kind, provider, and name, applicable to objects of all given types,
 // executing the given handler, and bound to the given manager.
 func NewWebhook(mgr manager.Manager, kind Kind, provider, name string, types []runtime.Object, handler admission.Handler) (*admission.Webhook, error) {
+	return newWebhook(mgr, kind, provider, name, types, handler, true)
+}
+
+// NewValidatingWebhook creates a new validating webhook for create and update operations
+// with the given kind, provider, and name, applicable to objects of all given types,
+// executing the given handler, and bound to the given manager.
+func NewValidatingWebhook(mgr manager.Manager, kind Kind, provider, name string, types []runtime.Object, handler admission.Handler) (*admission.Webhook, error) {
+	return newWebhook(mgr, kind, provider, name, types, handler, false)
+}
+
+func newWebhook(mgr manager.Manager, kind Kind, provider, name string, types []runtime.Object, handler admission.Handler, mutating bool) (*admission.Webhook, error) {
 	// Build namespace selector from the webhook kind and provider
 	namespaceSelector, err := buildSelector(kind, provider)
 	if err != nil {
@@ -143,10 +157,15 @@ func NewWebhook(mgr manager.Manager, kind Kind, provider, name string, types []r
 	}
 
 	// Build webhook
-	return builder.NewWebhookBuilder().
+	b := builder.NewWebhookBuilder().
 		Name(name + "." + provider + "." + NameSuffix).
-		Path("/" + name).
-		Mutating().
+		Path("/" + name)
+	if mutating {
+		b = b.Mutating()
+	} else {
+		b = b.Validating()
+	}
+	return b.
 		FailurePolicy(admissionregistrationv1beta1.Fail).
 		NamespaceSelector(namespaceSelector).
 		Rules(rules...).
@@ -162,7 +181,7 @@ func buildSelector(kind Kind, provider string) (*metav1.LabelSelector, error) {
 	switch kind {
 	case SeedKind:
 		key = SeedProviderLabel
-	case ShootKind:
+	case ShootKind, ValidationKind:
 		key = ShootProviderLabel
 	case BackupKind:
 		key = BackupProviderLabel
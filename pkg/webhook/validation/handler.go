@@ -0,0 +1,160 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission/types"
+)
+
+// newHandler creates a new handler for the given types, using the given validator, and logger.
+func newHandler(mgr manager.Manager, types []runtime.Object, validator Validator, logger logr.Logger) (*handler, error) {
+	// Build a map of the given types keyed by their GVKs
+	typesMap, err := buildTypesMap(mgr, types)
+	if err != nil {
+		return nil, err
+	}
+
+	// Inject the scheme into the validator
+	if _, err := inject.SchemeInto(mgr.GetScheme(), validator); err != nil {
+		return nil, errors.Wrap(err, "could not inject the scheme into the validator")
+	}
+
+	// Create and return a handler
+	return &handler{
+		typesMap:  typesMap,
+		validator: validator,
+		logger:    logger.WithName("handler"),
+	}, nil
+}
+
+type handler struct {
+	typesMap  map[metav1.GroupVersionKind]runtime.Object
+	validator Validator
+	decoder   types.Decoder
+	logger    logr.Logger
+}
+
+// InjectDecoder injects the given decoder into the handler.
+func (h *handler) InjectDecoder(d types.Decoder) error {
+	h.decoder = d
+	return nil
+}
+
+// InjectClient injects the given client into the validator.
+// TODO Replace this with the more generic InjectFunc when controller runtime supports it
+func (h *handler) InjectClient(client client.Client) error {
+	if _, err := inject.ClientInto(client, h.validator); err != nil {
+		return errors.Wrap(err, "could not inject the client into the validator")
+	}
+	return nil
+}
+
+// Handle handles the given admission request.
+func (h *handler) Handle(ctx context.Context, req types.Request) types.Response {
+	ar := req.AdmissionRequest
+
+	// Decode object
+	t, ok := h.typesMap[ar.Kind]
+	if !ok {
+		return admission.ErrorResponse(http.StatusBadRequest, errors.Errorf("unexpected request kind %s", ar.Kind.String()))
+	}
+	obj := t.DeepCopyObject()
+	if err := h.decoder.Decode(req, obj); err != nil {
+		return admission.ErrorResponse(http.StatusBadRequest, errors.Wrapf(err, "could not decode request %v", ar))
+	}
+
+	// Decode old object, if any
+	var oldObj runtime.Object
+	if ar.Operation == admissionv1beta1.Update {
+		oldObj = t.DeepCopyObject()
+		oldReq := types.Request{AdmissionRequest: &admissionv1beta1.AdmissionRequest{Object: ar.OldObject}}
+		if err := h.decoder.Decode(oldReq, oldObj); err != nil {
+			return admission.ErrorResponse(http.StatusBadRequest, errors.Wrapf(err, "could not decode old object of request %v", ar))
+		}
+	}
+
+	// Get object accessor
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return admission.ErrorResponse(http.StatusBadRequest, errors.Wrapf(err, "could not get accessor for %v", obj))
+	}
+
+	// Objects in deletion must not be blocked, otherwise their finalizers could never be removed
+	if accessor.GetDeletionTimestamp() != nil {
+		return admission.ValidationResponse(true, "")
+	}
+
+	// Validate the resource
+	h.logger.Info("Validating resource", "kind", ar.Kind.String(), "namespace", accessor.GetNamespace(),
+		"name", accessor.GetName(), "operation", ar.Operation)
+	if err := h.validator.Validate(ctx, obj, oldObj); err != nil {
+		return denyResponse(err)
+	}
+
+	return admission.ValidationResponse(true, "")
+}
+
+// denyResponse returns a response denying the request because of the given validation error.
+// The API server only shows the status message to the user, so the error is returned as message. If the error
+// carries a status already (e.g. an `Invalid` error with the list of invalid fields), that status is used as is.
+func denyResponse(err error) types.Response {
+	status := metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  metav1.StatusReasonInvalid,
+		Message: err.Error(),
+	}
+	if apiStatus, ok := err.(apierrors.APIStatus); ok {
+		status = apiStatus.Status()
+	}
+
+	return types.Response{
+		Response: &admissionv1beta1.AdmissionResponse{
+			Allowed: false,
+			Result:  &status,
+		},
+	}
+}
+
+// buildTypesMap builds a map of the given types keyed by their GroupVersionKind, using the scheme from the given Manager.
+func buildTypesMap(mgr manager.Manager, types []runtime.Object) (map[metav1.GroupVersionKind]runtime.Object, error) {
+	typesMap := make(map[metav1.GroupVersionKind]runtime.Object)
+	for _, t := range types {
+		// Get GVK from the type
+		gvk, err := apiutil.GVKForObject(t, mgr.GetScheme())
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get GroupVersionKind from object %v", t)
+		}
+
+		// Add the type to the types map
+		typesMap[metav1.GroupVersionKind(gvk)] = t
+	}
+	return typesMap, nil
+}
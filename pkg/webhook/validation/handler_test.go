@@ -0,0 +1,261 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	mockmanager "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/manager"
+	mocktypes "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/webhook/admission/types"
+	mockvalidation "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/webhook/validation"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission/types"
+)
+
+var _ = Describe("Handler", func() {
+	const (
+		name      = "foo"
+		namespace = "default"
+	)
+
+	var (
+		ctrl    *gomock.Controller
+		mgr     *mockmanager.MockManager
+		decoder *mocktypes.MockDecoder
+
+		objTypes = []runtime.Object{&corev1.Service{}}
+		svc      = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+		}
+		oldSvc = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP},
+		}
+
+		gvk    = metav1.GroupVersionKind{Group: "", Version: "v1", Kind: "Service"}
+		newRaw = runtime.RawExtension{Raw: []byte("new")}
+		oldRaw = runtime.RawExtension{Raw: []byte("old")}
+
+		createReq = types.Request{
+			AdmissionRequest: &admissionv1beta1.AdmissionRequest{
+				Kind:      gvk,
+				Name:      name,
+				Namespace: namespace,
+				Operation: admissionv1beta1.Create,
+				Object:    newRaw,
+			},
+		}
+		updateReq = types.Request{
+			AdmissionRequest: &admissionv1beta1.AdmissionRequest{
+				Kind:      gvk,
+				Name:      name,
+				Namespace: namespace,
+				Operation: admissionv1beta1.Update,
+				Object:    newRaw,
+				OldObject: oldRaw,
+			},
+		}
+		oldReq = types.Request{
+			AdmissionRequest: &admissionv1beta1.AdmissionRequest{
+				Object: oldRaw,
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		// Build scheme
+		scheme := runtime.NewScheme()
+		_ = corev1.AddToScheme(scheme)
+
+		// Create mock manager
+		mgr = mockmanager.NewMockManager(ctrl)
+		mgr.EXPECT().GetScheme().Return(scheme).AnyTimes()
+
+		// Create mock decoder
+		decoder = mocktypes.NewMockDecoder(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#Handle", func() {
+		It("should return an allowing response if the created resource is valid", func() {
+			decoder.EXPECT().Decode(createReq, &corev1.Service{}).DoAndReturn(decoderDecode(svc))
+
+			// Create mock validator
+			validator := mockvalidation.NewMockValidator(ctrl)
+			validator.EXPECT().Validate(context.TODO(), svc, nil).Return(nil)
+
+			// Create handler
+			h, err := newHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			h.decoder = decoder
+
+			// Call Handle and check response
+			resp := h.Handle(context.TODO(), createReq)
+			Expect(resp).To(Equal(types.Response{
+				Response: &admissionv1beta1.AdmissionResponse{
+					Allowed: true,
+				},
+			}))
+		})
+
+		It("should pass the old object to the validator on updates", func() {
+			gomock.InOrder(
+				decoder.EXPECT().Decode(updateReq, &corev1.Service{}).DoAndReturn(decoderDecode(svc)),
+				decoder.EXPECT().Decode(oldReq, &corev1.Service{}).DoAndReturn(decoderDecode(oldSvc)),
+			)
+
+			// Create mock validator
+			validator := mockvalidation.NewMockValidator(ctrl)
+			validator.EXPECT().Validate(context.TODO(), svc, oldSvc).Return(nil)
+
+			// Create handler
+			h, err := newHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			h.decoder = decoder
+
+			// Call Handle and check response
+			resp := h.Handle(context.TODO(), updateReq)
+			Expect(resp).To(Equal(types.Response{
+				Response: &admissionv1beta1.AdmissionResponse{
+					Allowed: true,
+				},
+			}))
+		})
+
+		It("should return an allowing response without validation if the resource is in deletion", func() {
+			now := metav1.Now()
+			deletedSvc := svc.DeepCopy()
+			deletedSvc.DeletionTimestamp = &now
+			gomock.InOrder(
+				decoder.EXPECT().Decode(updateReq, &corev1.Service{}).DoAndReturn(decoderDecode(deletedSvc)),
+				decoder.EXPECT().Decode(oldReq, &corev1.Service{}).DoAndReturn(decoderDecode(oldSvc)),
+			)
+
+			// Create mock validator
+			validator := mockvalidation.NewMockValidator(ctrl)
+
+			// Create handler
+			h, err := newHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			h.decoder = decoder
+
+			// Call Handle and check response
+			resp := h.Handle(context.TODO(), updateReq)
+			Expect(resp).To(Equal(types.Response{
+				Response: &admissionv1beta1.AdmissionResponse{
+					Allowed: true,
+				},
+			}))
+		})
+
+		It("should return a denying response if the resource is invalid", func() {
+			decoder.EXPECT().Decode(createReq, &corev1.Service{}).DoAndReturn(decoderDecode(svc))
+
+			// Create mock validator
+			validator := mockvalidation.NewMockValidator(ctrl)
+			validator.EXPECT().Validate(context.TODO(), svc, nil).Return(errors.New("test error"))
+
+			// Create handler
+			h, err := newHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			h.decoder = decoder
+
+			// Call Handle and check response
+			resp := h.Handle(context.TODO(), createReq)
+			Expect(resp).To(Equal(types.Response{
+				Response: &admissionv1beta1.AdmissionResponse{
+					Allowed: false,
+					Result: &metav1.Status{
+						Status:  metav1.StatusFailure,
+						Code:    http.StatusUnprocessableEntity,
+						Reason:  metav1.StatusReasonInvalid,
+						Message: "test error",
+					},
+				},
+			}))
+		})
+
+		It("should return the status of the validation error if it has one", func() {
+			decoder.EXPECT().Decode(createReq, &corev1.Service{}).DoAndReturn(decoderDecode(svc))
+
+			// Create mock validator
+			invalidErr := apierrors.NewInvalid(schema.GroupKind{Kind: "Service"}, name, field.ErrorList{
+				field.Invalid(field.NewPath("spec", "type"), corev1.ServiceTypeLoadBalancer, "test error"),
+			})
+			validator := mockvalidation.NewMockValidator(ctrl)
+			validator.EXPECT().Validate(context.TODO(), svc, nil).Return(invalidErr)
+
+			// Create handler
+			h, err := newHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			h.decoder = decoder
+
+			// Call Handle and check response
+			resp := h.Handle(context.TODO(), createReq)
+			status := invalidErr.Status()
+			Expect(resp).To(Equal(types.Response{
+				Response: &admissionv1beta1.AdmissionResponse{
+					Allowed: false,
+					Result:  &status,
+				},
+			}))
+		})
+
+		It("should return an error response if the request kind is unexpected", func() {
+			// Create mock validator
+			validator := mockvalidation.NewMockValidator(ctrl)
+
+			// Create handler
+			h, err := newHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			h.decoder = decoder
+
+			// Call Handle and check response
+			req := types.Request{
+				AdmissionRequest: &admissionv1beta1.AdmissionRequest{
+					Kind: metav1.GroupVersionKind{Group: "", Version: "v1", Kind: "ConfigMap"},
+				},
+			}
+			resp := h.Handle(context.TODO(), req)
+			Expect(resp.Response.Allowed).To(BeFalse())
+			Expect(resp.Response.Result.Code).To(Equal(int32(http.StatusBadRequest)))
+		})
+	})
+})
+
+func decoderDecode(obj runtime.Object) func(types.Request, runtime.Object) error {
+	return func(_ types.Request, into runtime.Object) error {
+		*into.(*corev1.Service) = *obj.(*corev1.Service)
+		return nil
+	}
+}
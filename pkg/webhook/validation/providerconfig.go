@@ -0,0 +1,49 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ProviderConfigChanged returns whether the given provider config differs from the given old one. Validators should
+// only validate changed provider configs, so that updates of e.g. finalizers, annotations or the status are not blocked
+// by a provider config that was accepted before.
+func ProviderConfigChanged(config, oldConfig *runtime.RawExtension) bool {
+	return !apiequality.Semantic.DeepEqual(config, oldConfig)
+}
+
+// FindWorkerPool returns the pool with the given name of the given worker, or nil if there is no such pool or no worker.
+func FindWorkerPool(worker *extensionsv1alpha1.Worker, name string) *extensionsv1alpha1.WorkerPool {
+	if worker == nil {
+		return nil
+	}
+	for i, pool := range worker.Spec.Pools {
+		if pool.Name == name {
+			return &worker.Spec.Pools[i]
+		}
+	}
+	return nil
+}
+
+// WorkerPoolProviderConfigChanged returns whether the provider config of the given pool differs from the one of the pool
+// with the same name of the given old worker. Pools that are new, e.g. because there is no old worker, are considered
+// to be changed.
+func WorkerPoolProviderConfigChanged(pool extensionsv1alpha1.WorkerPool, oldWorker *extensionsv1alpha1.Worker) bool {
+	oldPool := FindWorkerPool(oldWorker, pool.Name)
+	return oldPool == nil || ProviderConfigChanged(pool.ProviderConfig, oldPool.ProviderConfig)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/webhook/validation"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("ProviderConfig", func() {
+	var (
+		config      = &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+		otherConfig = &runtime.RawExtension{Raw: []byte(`{"foo":"baz"}`)}
+
+		worker = func(pools ...extensionsv1alpha1.WorkerPool) *extensionsv1alpha1.Worker {
+			return &extensionsv1alpha1.Worker{Spec: extensionsv1alpha1.WorkerSpec{Pools: pools}}
+		}
+		pool = func(name string, config *runtime.RawExtension) extensionsv1alpha1.WorkerPool {
+			return extensionsv1alpha1.WorkerPool{Name: name, ProviderConfig: config}
+		}
+	)
+
+	Describe("#ProviderConfigChanged", func() {
+		It("should return false for equal provider configs", func() {
+			Expect(ProviderConfigChanged(config, config.DeepCopy())).To(BeFalse())
+		})
+
+		It("should return false if both provider configs are nil", func() {
+			Expect(ProviderConfigChanged(nil, nil)).To(BeFalse())
+		})
+
+		It("should return true for different provider configs", func() {
+			Expect(ProviderConfigChanged(config, otherConfig)).To(BeTrue())
+		})
+
+		It("should return true if a provider config was added", func() {
+			Expect(ProviderConfigChanged(config, nil)).To(BeTrue())
+		})
+	})
+
+	Describe("#FindWorkerPool", func() {
+		It("should return the pool with the given name", func() {
+			w := worker(pool("pool-1", config), pool("pool-2", otherConfig))
+			Expect(FindWorkerPool(w, "pool-2")).To(Equal(&w.Spec.Pools[1]))
+		})
+
+		It("should return nil if there is no pool with the given name", func() {
+			Expect(FindWorkerPool(worker(pool("pool-1", config)), "pool-2")).To(BeNil())
+		})
+
+		It("should return nil if there is no worker", func() {
+			Expect(FindWorkerPool(nil, "pool-1")).To(BeNil())
+		})
+	})
+
+	Describe("#WorkerPoolProviderConfigChanged", func() {
+		It("should return false if the provider config of the pool is unchanged", func() {
+			oldWorker := worker(pool("pool-2", otherConfig), pool("pool-1", config))
+			Expect(WorkerPoolProviderConfigChanged(pool("pool-1", config.DeepCopy()), oldWorker)).To(BeFalse())
+		})
+
+		It("should return true if the provider config of the pool changed", func() {
+			oldWorker := worker(pool("pool-1", otherConfig))
+			Expect(WorkerPoolProviderConfigChanged(pool("pool-1", config), oldWorker)).To(BeTrue())
+		})
+
+		It("should return true if the pool is new", func() {
+			oldWorker := worker(pool("pool-2", config))
+			Expect(WorkerPoolProviderConfigChanged(pool("pool-1", config), oldWorker)).To(BeTrue())
+		})
+
+		It("should return true if there is no old worker", func() {
+			Expect(WorkerPoolProviderConfigChanged(pool("pool-1", config), nil)).To(BeTrue())
+		})
+	})
+})